@@ -1,18 +1,29 @@
 package main
 
 import (
+	"flag"
 	"log"
 
 	"ticket-score-service/internal/app"
+	"ticket-score-service/internal/swagger"
 )
 
 func main() {
+	enableSwagger := flag.Bool("enable-swagger", false, "Serve Swagger UI for the generated OpenAPI specs")
+	flag.Parse()
+
 	application, err := app.New()
 	if err != nil {
 		log.Fatalf("Failed to create application: %v", err)
 	}
 	defer application.Shutdown()
 
+	if *enableSwagger {
+		if err := application.EnableSwagger(swagger.DefaultSpecDir); err != nil {
+			log.Printf("Swagger UI disabled: %v", err)
+		}
+	}
+
 	if err := application.Run(); err != nil {
 		log.Fatalf("Failed to run application: %v", err)
 	}
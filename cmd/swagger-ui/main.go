@@ -0,0 +1,16 @@
+package main
+
+import (
+	"log"
+
+	"ticket-score-service/internal/config"
+	"ticket-score-service/internal/swagger"
+)
+
+func main() {
+	cfg := config.New()
+
+	if err := swagger.Serve(cfg.SwaggerPort, swagger.DefaultSpecDir); err != nil {
+		log.Fatalf("Failed to serve Swagger UI: %v", err)
+	}
+}
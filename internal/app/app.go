@@ -1,100 +1,390 @@
 package app
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"log"
+	"log/slog"
 	"net"
+	"net/http"
+	"os"
+	"time"
 
+	grpcprometheus "github.com/grpc-ecosystem/go-grpc-prometheus"
+	"go.opentelemetry.io/otel"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/reflection"
 
+	"ticket-score-service/internal/concurrency"
 	"ticket-score-service/internal/config"
 	"ticket-score-service/internal/database"
+	"ticket-score-service/internal/interceptor"
+	"ticket-score-service/internal/logger"
+	"ticket-score-service/internal/metrics"
 	"ticket-score-service/internal/repository"
 	"ticket-score-service/internal/server"
 	"ticket-score-service/internal/service"
+	"ticket-score-service/internal/swagger"
+	activityPb "ticket-score-service/proto/generated/activity_analytics"
+	integrityPb "ticket-score-service/proto/generated/data_integrity"
 	overallQualityPb "ticket-score-service/proto/generated/overall_quality"
 	periodComparisonPb "ticket-score-service/proto/generated/period_comparison"
 	ratingPb "ticket-score-service/proto/generated/rating_analytics"
+	ratingsQueryPb "ticket-score-service/proto/generated/ratings_query"
+	revieweePb "ticket-score-service/proto/generated/reviewee_analytics"
+	reviewerPb "ticket-score-service/proto/generated/reviewer_analytics"
+	snapshotPb "ticket-score-service/proto/generated/score_snapshots"
 	ticketPb "ticket-score-service/proto/generated/ticket_scores"
 )
 
+// Interceptors holds the interceptor chains for the internal and external listeners
+type Interceptors struct {
+	InternalUnary  []grpc.UnaryServerInterceptor
+	InternalStream []grpc.StreamServerInterceptor
+	ExternalUnary  []grpc.UnaryServerInterceptor
+	ExternalStream []grpc.StreamServerInterceptor
+}
+
 // App represents the application with all its dependencies
 type App struct {
-	config   *config.Config
-	db       *database.DB
-	server   *grpc.Server
-	listener net.Listener
+	config           *config.Config
+	db               *database.DB
+	limiter          *concurrency.GlobalConcurrencyLimiter
+	stopWatcher      context.CancelFunc
+	healthServer     *health.Server
+	internalServer   *grpc.Server
+	externalServer   *grpc.Server
+	internalListener net.Listener
+	externalListener net.Listener
+	metricsServer    *http.Server
+	metricsListener  net.Listener
+	swaggerServer    *http.Server // Nil unless EnableSwagger was called
+	swaggerListener  net.Listener
 }
 
+// httpShutdownTimeout bounds how long Shutdown waits for in-flight metrics and Swagger UI requests
+const httpShutdownTimeout = 5 * time.Second
+
 // New creates a new application instance with all dependencies initialized
 func New() (*App, error) {
+	return NewWithInterceptors(Interceptors{})
+}
+
+// NewWithInterceptors creates a new application instance serving internal and external
+// traffic on separate ports, each with its own interceptor chain
+func NewWithInterceptors(interceptors Interceptors) (*App, error) {
 	// Load configuration
 	cfg := config.New()
+	if err := cfg.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid configuration: %w", err)
+	}
+
+	// Tunable settings are reloaded from the config file while running
+	watcher := config.NewWatcher(cfg, cfg.ConfigFilePath, time.Duration(cfg.ConfigReloadIntervalSeconds)*time.Second)
+	if err := watcher.Reload(); err != nil {
+		return nil, fmt.Errorf("invalid configuration: %w", err)
+	}
 
 	// Initialize database
-	db, err := database.New(cfg.DatabasePath)
+	db, err := database.NewWithRetry(cfg.DatabasePath, cfg.MaxConnectRetries, time.Duration(cfg.ConnectRetryIntervalMs)*time.Millisecond)
 	if err != nil {
 		return nil, err
 	}
 
+	if err := db.Migrate(context.Background(), repository.CreateWeeklyScoreSnapshotsTable, repository.CreateScoringRulesTable, database.CreateSnapshotLocksTable); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed to migrate database: %w", err)
+	}
+
 	// Initialize repositories
-	categoryRepo := repository.NewRatingCategoryRepository(db.GetConnection())
-	ratingsRepo := repository.NewRatingsRepository(db.GetConnection())
-
-	// Initialize services
-	ticketScoreService := service.NewTicketScoreService()
-	analyticsService := service.NewRatingAnalyticsService(categoryRepo, ratingsRepo, ticketScoreService)
-	ticketScoresService := service.NewTicketScoresService(categoryRepo, ratingsRepo, ticketScoreService)
-	overallQualityService := service.NewOverallQualityService(ratingsRepo, categoryRepo)
+	// Queries go through db so they wait for any migration in progress
+	var conn database.WrappedDB = db
+	if cfg.TracingEnabled {
+		conn = database.NewTracedDB(db, otel.Tracer("ticket-score-service/database"), slog.Default())
+	}
+	categoryRepo := repository.NewRatingCategoryRepository(conn)
+	ratingsRepo := repository.NewRatingsRepository(conn)
+	snapshotRepo := repository.NewSnapshotRepository(conn)
+	integrityRepo := repository.NewIntegrityRepository(conn)
+	ticketRepo := repository.NewTicketRepository(conn)
+	scoringRuleRepo := repository.NewScoringRuleRepository(conn)
+
+	scoringRules, err := scoringRuleRepo.GetScoringRules(context.Background())
+	if err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed to load scoring rules: %w", err)
+	}
+
+	// Initialize services, sharing one goroutine limit between them
+	limiter := concurrency.NewGlobalConcurrencyLimiter(cfg.GlobalMaxGoroutines)
+	ticketScoreService := service.NewTicketScoreService(
+		service.WithMaxRating(cfg.MaxRating),
+		service.WithScorePrecision(cfg.ScorePrecision))
+	analyticsService := service.NewRatingAnalyticsService(categoryRepo, ratingsRepo, ticketScoreService,
+		service.WithAggregationThresholdDays(cfg.AggregationThresholdDays),
+		service.WithAnalyticsScorePrecision(cfg.ScorePrecision))
+	ticketScoresService := service.NewTicketScoresService(categoryRepo, ratingsRepo, ticketScoreService,
+		service.WithMaxCategoryConcurrency(cfg.MaxCategoryConcurrency),
+		service.WithTicketScoresPrecision(cfg.ScorePrecision),
+		service.WithTicketScoresConcurrencyLimiter(limiter),
+		service.WithRuleEngine(service.NewRuleEngine(scoringRules)))
+	overallQualityService, err := service.NewOverallQualityService(ratingsRepo, categoryRepo, analyticsService,
+		service.WithOverallQualityMaxRating(cfg.MaxRating),
+		service.WithOverallQualityAggregationThresholdDays(cfg.AggregationThresholdDays),
+		service.WithOverallQualityConcurrencyLimiter(limiter),
+		service.WithCacheStaleDays(cfg.CacheStaleDays),
+		service.WithConfigSource(watcher))
+	if err != nil {
+		db.Close()
+		return nil, fmt.Errorf("invalid overall quality configuration: %w", err)
+	}
+	analyticsService.OnRatingsImported(overallQualityService.InvalidateCache)
 	periodComparisonService := service.NewPeriodComparisonService(overallQualityService)
+	snapshotService := service.NewSnapshotService(analyticsService, snapshotRepo, service.WithLocker(db))
+	activityService := service.NewActivityAnalyticsService(ratingsRepo, cfg.MaxRating)
+	revieweeService := service.NewRevieweeAnalyticsService(categoryRepo, ratingsRepo, ticketRepo, ticketScoreService, analyticsService,
+		service.WithRevieweeScorePrecision(cfg.ScorePrecision))
+	reviewerService := service.NewReviewerAnalyticsService(categoryRepo, ratingsRepo, ticketScoreService, analyticsService,
+		service.WithReviewerScorePrecision(cfg.ScorePrecision))
+	subjectGroupService := service.NewSubjectGroupAnalyticsService(categoryRepo, ratingsRepo, ticketRepo, ticketScoreService,
+		service.WithSubjectGroupScorePrecision(cfg.ScorePrecision))
+	ratingsQueryService := service.NewRatingsQueryService(ratingsRepo)
+	integrityService := service.NewDataIntegrityService(integrityRepo, cfg.MaxRating)
+	dataQualityService := service.NewDataQualityService(integrityRepo)
+
+	var abTestService *service.ABTestScoreService
+	if cfg.ABTestEnabled {
+		algorithmA, algorithmB, err := cfg.ABTestAlgorithmPair()
+		if err != nil {
+			db.Close()
+			return nil, err
+		}
+		abTestService, err = service.NewABTestScoreService(algorithmA, algorithmB, cfg.MaxRating,
+			service.WithABTestScorePrecision(cfg.ScorePrecision))
+		if err != nil {
+			db.Close()
+			return nil, fmt.Errorf("invalid A/B test configuration: %w", err)
+		}
+	}
+
+	// Report NOT_SERVING while a migration is in progress
+	healthServer := health.NewServer()
+	db.OnReadyChange(func(ready bool) {
+		if ready {
+			healthServer.SetServingStatus("", healthpb.HealthCheckResponse_SERVING)
+		} else {
+			healthServer.SetServingStatus("", healthpb.HealthCheckResponse_NOT_SERVING)
+		}
+	})
+
+	// Create gRPC servers
+	registerServices := func(grpcServer *grpc.Server) {
+		reflection.Register(grpcServer)
+		healthpb.RegisterHealthServer(grpcServer, healthServer)
+
+		analyticsServer := server.NewRatingAnalyticsServer(analyticsService)
+		ratingPb.RegisterRatingAnalyticsServiceServer(grpcServer, analyticsServer)
+
+		ticketScoresServer := server.NewTicketScoresServer(ticketScoresService, ticketScoreService,
+			server.WithABTestService(abTestService))
+		ticketPb.RegisterTicketScoresServiceServer(grpcServer, ticketScoresServer)
+
+		overallQualityServer := server.NewOverallQualityServer(overallQualityService,
+			server.WithSubjectGroupService(subjectGroupService))
+		overallQualityPb.RegisterOverallQualityServiceServer(grpcServer, overallQualityServer)
+
+		periodComparisonServer := server.NewPeriodComparisonServer(periodComparisonService)
+		periodComparisonPb.RegisterPeriodComparisonServiceServer(grpcServer, periodComparisonServer)
+
+		snapshotServer := server.NewScoreSnapshotsServer(snapshotService)
+		snapshotPb.RegisterScoreSnapshotsServiceServer(grpcServer, snapshotServer)
 
-	// Create gRPC server
-	grpcServer := grpc.NewServer()
-	reflection.Register(grpcServer)
+		activityServer := server.NewActivityAnalyticsServer(activityService)
+		activityPb.RegisterActivityAnalyticsServiceServer(grpcServer, activityServer)
 
-	// Register services
-	analyticsServer := server.NewRatingAnalyticsServer(analyticsService)
-	ratingPb.RegisterRatingAnalyticsServiceServer(grpcServer, analyticsServer)
+		revieweeServer := server.NewRevieweeAnalyticsServer(revieweeService)
+		revieweePb.RegisterRevieweeAnalyticsServiceServer(grpcServer, revieweeServer)
+
+		reviewerServer := server.NewReviewerAnalyticsServer(reviewerService)
+		reviewerPb.RegisterReviewerAnalyticsServiceServer(grpcServer, reviewerServer)
+
+		ratingsQueryServer := server.NewRatingsQueryServer(ratingsQueryService)
+		ratingsQueryPb.RegisterRatingsQueryServiceServer(grpcServer, ratingsQueryServer)
+
+		integrityServer := server.NewDataIntegrityServer(integrityService,
+			server.WithDataQualityService(dataQualityService))
+		integrityPb.RegisterDataIntegrityServiceServer(grpcServer, integrityServer)
+	}
+
+	// Unless configured to wait, requests are rejected while a migration is in progress
+	gate := migrationGate{db: db, block: cfg.BlockOnMigration}
+
+	// Per-method limits are shared by both listeners
+	rateLimits, err := cfg.MethodRateLimits()
+	if err != nil {
+		db.Close()
+		return nil, fmt.Errorf("invalid configuration: %w", err)
+	}
+	rateLimiter := interceptor.NewRateLimiter(rateLimits)
+	timeout := interceptor.NewTimeout(func() time.Duration {
+		return time.Duration(watcher.Get().RequestTimeoutSeconds) * time.Second
+	})
+	errorLog := interceptor.NewErrorLog(logger.New(os.Stderr, cfg.LogErrorStacks))
 
-	ticketScoresServer := server.NewTicketScoresServer(ticketScoresService)
-	ticketPb.RegisterTicketScoresServiceServer(grpcServer, ticketScoresServer)
+	// Request counts and latencies are recorded first, so rejected requests are counted too
+	grpcprometheus.EnableHandlingTimeHistogram()
 
-	overallQualityServer := server.NewOverallQualityServer(overallQualityService)
-	overallQualityPb.RegisterOverallQualityServiceServer(grpcServer, overallQualityServer)
+	internalServer := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(append([]grpc.UnaryServerInterceptor{grpcprometheus.UnaryServerInterceptor, gate.unary, rateLimiter.Unary, timeout.Unary, errorLog.Unary}, interceptors.InternalUnary...)...),
+		grpc.ChainStreamInterceptor(append([]grpc.StreamServerInterceptor{grpcprometheus.StreamServerInterceptor, gate.stream, rateLimiter.Stream, timeout.Stream, errorLog.Stream}, interceptors.InternalStream...)...),
+	)
+	registerServices(internalServer)
+	grpcprometheus.Register(internalServer)
 
-	periodComparisonServer := server.NewPeriodComparisonServer(periodComparisonService)
-	periodComparisonPb.RegisterPeriodComparisonServiceServer(grpcServer, periodComparisonServer)
+	externalServer := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(append([]grpc.UnaryServerInterceptor{grpcprometheus.UnaryServerInterceptor, gate.unary, rateLimiter.Unary, timeout.Unary, errorLog.Unary}, interceptors.ExternalUnary...)...),
+		grpc.ChainStreamInterceptor(append([]grpc.StreamServerInterceptor{grpcprometheus.StreamServerInterceptor, gate.stream, rateLimiter.Stream, timeout.Stream, errorLog.Stream}, interceptors.ExternalStream...)...),
+	)
+	registerServices(externalServer)
+	grpcprometheus.Register(externalServer)
 
-	// Create listener
-	listener, err := net.Listen("tcp", ":"+cfg.Port)
+	// Create listeners
+	internalListener, err := net.Listen("tcp", ":"+cfg.InternalPort)
 	if err != nil {
 		db.Close()
 		return nil, err
 	}
 
+	externalListener, err := net.Listen("tcp", ":"+cfg.ExternalPort)
+	if err != nil {
+		internalListener.Close()
+		db.Close()
+		return nil, err
+	}
+
+	metricsListener, err := net.Listen("tcp", ":"+cfg.MetricsPort)
+	if err != nil {
+		externalListener.Close()
+		internalListener.Close()
+		db.Close()
+		return nil, err
+	}
+
+	watcherCtx, stopWatcher := context.WithCancel(context.Background())
+	go watcher.Run(watcherCtx)
+
 	return &App{
-		config:   cfg,
-		db:       db,
-		server:   grpcServer,
-		listener: listener,
+		config:           cfg,
+		db:               db,
+		limiter:          limiter,
+		stopWatcher:      stopWatcher,
+		healthServer:     healthServer,
+		internalServer:   internalServer,
+		externalServer:   externalServer,
+		internalListener: internalListener,
+		externalListener: externalListener,
+		metricsServer:    &http.Server{Handler: metrics.NewHandler()},
+		metricsListener:  metricsListener,
 	}, nil
 }
 
-// Run starts the application
+// EnableSwagger serves the Swagger UI for the specs in specDir on the configured swagger port once
+// Run is called
+func (a *App) EnableSwagger(specDir string) error {
+	handler, err := swagger.NewHandler(specDir)
+	if err != nil {
+		return err
+	}
+	listener, err := net.Listen("tcp", ":"+a.config.SwaggerPort)
+	if err != nil {
+		return err
+	}
+
+	a.swaggerServer = &http.Server{Handler: handler}
+	a.swaggerListener = listener
+	return nil
+}
+
+// Run starts both servers and blocks until one of them stops
 func (a *App) Run() error {
 	log.Printf("Connected to database: %s", a.config.DatabasePath)
-	log.Printf("Server listening on port %s", a.config.Port)
+	log.Printf("Internal server listening on %s", a.internalListener.Addr())
+	log.Printf("External server listening on %s", a.externalListener.Addr())
 
-	return a.server.Serve(a.listener)
+	// The metrics and Swagger UI servers are auxiliary, so they don't stop the application
+	log.Printf("Metrics listening on %s", a.metricsListener.Addr())
+	go serveHTTP("Metrics endpoint", a.metricsServer, a.metricsListener)
+	if a.swaggerServer != nil {
+		log.Printf("Swagger UI listening on %s", a.swaggerListener.Addr())
+		go serveHTTP("Swagger UI", a.swaggerServer, a.swaggerListener)
+	}
+
+	errChan := make(chan error, 2)
+	go func() { errChan <- a.internalServer.Serve(a.internalListener) }()
+	go func() { errChan <- a.externalServer.Serve(a.externalListener) }()
+
+	return <-errChan
+}
+
+// serveHTTP serves HTTP requests on listener until the server is shut down, logging any other error
+func serveHTTP(name string, server *http.Server, listener net.Listener) {
+	if err := server.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		log.Printf("%s stopped: %v", name, err)
+	}
+}
+
+// InternalAddr returns the address of the internal listener
+func (a *App) InternalAddr() net.Addr {
+	return a.internalListener.Addr()
+}
+
+// ExternalAddr returns the address of the external listener
+func (a *App) ExternalAddr() net.Addr {
+	return a.externalListener.Addr()
+}
+
+// MetricsAddr returns the address of the metrics listener
+func (a *App) MetricsAddr() net.Addr {
+	return a.metricsListener.Addr()
 }
 
 // Shutdown gracefully shuts down the application
 func (a *App) Shutdown() {
-	if a.server != nil {
-		a.server.GracefulStop()
+	if a.stopWatcher != nil {
+		a.stopWatcher()
+	}
+	// Tell load balancers to stop routing here before draining in-flight requests
+	if a.healthServer != nil {
+		a.healthServer.Shutdown()
+	}
+	if a.internalServer != nil {
+		a.internalServer.GracefulStop()
+	}
+	if a.externalServer != nil {
+		a.externalServer.GracefulStop()
+	}
+	if a.internalListener != nil {
+		a.internalListener.Close()
+	}
+	if a.externalListener != nil {
+		a.externalListener.Close()
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), httpShutdownTimeout)
+	defer cancel()
+	for _, server := range []*http.Server{a.metricsServer, a.swaggerServer} {
+		if server != nil {
+			server.Shutdown(ctx)
+		}
 	}
-	if a.listener != nil {
-		a.listener.Close()
+	// Closes the listeners of servers that never started serving
+	for _, listener := range []net.Listener{a.metricsListener, a.swaggerListener} {
+		if listener != nil {
+			listener.Close()
+		}
 	}
 	if a.db != nil {
 		a.db.Close()
@@ -0,0 +1,374 @@
+package app
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"io"
+	"net/http"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+
+	overallQualityPb "ticket-score-service/proto/generated/overall_quality"
+	ticketPb "ticket-score-service/proto/generated/ticket_scores"
+)
+
+func TestSeparateListeners(t *testing.T) {
+	t.Setenv("INTERNAL_PORT", "0")
+	t.Setenv("EXTERNAL_PORT", "0")
+	t.Setenv("METRICS_PORT", "0")
+	t.Setenv("DATABASE_PATH", filepath.Join(t.TempDir(), "test.db"))
+
+	// Rejects every call, standing in for an auth check on external traffic
+	var externalCalls atomic.Int32
+	rejectAll := func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		externalCalls.Add(1)
+		return nil, status.Error(codes.Unauthenticated, "missing credentials")
+	}
+
+	application, err := NewWithInterceptors(Interceptors{
+		ExternalUnary: []grpc.UnaryServerInterceptor{rejectAll},
+	})
+	if err != nil {
+		t.Fatalf("Failed to create application: %v", err)
+	}
+
+	runErr := make(chan error, 1)
+	go func() { runErr <- application.Run() }()
+
+	if application.InternalAddr().String() == application.ExternalAddr().String() {
+		t.Fatalf("Expected separate listeners, both on %s", application.InternalAddr())
+	}
+
+	call := func(t *testing.T, addr string) error {
+		t.Helper()
+
+		conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+		if err != nil {
+			t.Fatalf("Failed to create client: %v", err)
+		}
+		defer conn.Close()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		// An empty request fails validation, so no database access is needed
+		client := overallQualityPb.NewOverallQualityServiceClient(conn)
+		_, err = client.GetOverallQualityScore(ctx, &overallQualityPb.GetOverallQualityScoreRequest{})
+		return err
+	}
+
+	t.Run("internal server skips external interceptor", func(t *testing.T) {
+		err := call(t, application.InternalAddr().String())
+		if status.Code(err) != codes.InvalidArgument {
+			t.Errorf("Expected InvalidArgument from internal server, got %v", err)
+		}
+		if externalCalls.Load() != 0 {
+			t.Errorf("Expected external interceptor not to run, ran %d times", externalCalls.Load())
+		}
+	})
+
+	t.Run("external server applies its interceptor", func(t *testing.T) {
+		err := call(t, application.ExternalAddr().String())
+		if status.Code(err) != codes.Unauthenticated {
+			t.Errorf("Expected Unauthenticated from external server, got %v", err)
+		}
+		if externalCalls.Load() != 1 {
+			t.Errorf("Expected external interceptor to run once, ran %d times", externalCalls.Load())
+		}
+	})
+
+	application.Shutdown()
+
+	select {
+	case err := <-runErr:
+		if err != nil {
+			t.Errorf("Expected Run to return cleanly after Shutdown, got %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Error("Run did not return after Shutdown")
+	}
+}
+
+func TestHealthCheck(t *testing.T) {
+	t.Setenv("INTERNAL_PORT", "0")
+	t.Setenv("EXTERNAL_PORT", "0")
+	t.Setenv("METRICS_PORT", "0")
+	t.Setenv("DATABASE_PATH", filepath.Join(t.TempDir(), "test.db"))
+
+	application, err := New()
+	if err != nil {
+		t.Fatalf("Failed to create application: %v", err)
+	}
+	go application.Run()
+
+	conn, err := grpc.NewClient(application.ExternalAddr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+	defer conn.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	client := healthpb.NewHealthClient(conn)
+	health, err := client.Check(ctx, &healthpb.HealthCheckRequest{})
+	if err != nil {
+		t.Fatalf("Health check failed: %v", err)
+	}
+	if health.Status != healthpb.HealthCheckResponse_SERVING {
+		t.Errorf("Expected SERVING, got %v", health.Status)
+	}
+
+	// Watch stays open across Shutdown, so it sees the status change before the server stops
+	watchCtx, stopWatch := context.WithCancel(ctx)
+	defer stopWatch()
+	watch, err := client.Watch(watchCtx, &healthpb.HealthCheckRequest{})
+	if err != nil {
+		t.Fatalf("Failed to watch health: %v", err)
+	}
+	if health, err = watch.Recv(); err != nil || health.Status != healthpb.HealthCheckResponse_SERVING {
+		t.Fatalf("Expected SERVING, got %v (%v)", health.GetStatus(), err)
+	}
+
+	shutdown := make(chan struct{})
+	go func() {
+		application.Shutdown()
+		close(shutdown)
+	}()
+
+	if health, err = watch.Recv(); err != nil || health.Status != healthpb.HealthCheckResponse_NOT_SERVING {
+		t.Fatalf("Expected NOT_SERVING during shutdown, got %v (%v)", health.GetStatus(), err)
+	}
+
+	stopWatch()
+	select {
+	case <-shutdown:
+	case <-time.After(5 * time.Second):
+		t.Error("Shutdown did not return")
+	}
+}
+
+func TestMetricsServer(t *testing.T) {
+	t.Setenv("INTERNAL_PORT", "0")
+	t.Setenv("EXTERNAL_PORT", "0")
+	t.Setenv("METRICS_PORT", "0")
+	t.Setenv("DATABASE_PATH", filepath.Join(t.TempDir(), "test.db"))
+
+	application, err := New()
+	if err != nil {
+		t.Fatalf("Failed to create application: %v", err)
+	}
+	go application.Run()
+
+	url := "http://" + application.MetricsAddr().String() + "/metrics"
+	client := &http.Client{Timeout: 5 * time.Second}
+	// Run starts the metrics server concurrently, but its listener already accepts connections
+	resp, err := client.Get(url)
+	if err != nil {
+		t.Fatalf("Failed to get metrics: %v", err)
+	}
+	body, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK || !strings.Contains(string(body), "ticket_score_chunks_processed_total") {
+		t.Errorf("Expected the metrics, got status %d", resp.StatusCode)
+	}
+
+	application.Shutdown()
+
+	if resp, err := client.Get(url); err == nil {
+		resp.Body.Close()
+		t.Error("Expected the metrics server to stop on Shutdown")
+	}
+}
+
+func TestMigrationGate(t *testing.T) {
+	tests := []struct {
+		name             string
+		blockOnMigration string
+		expectedCode     codes.Code
+	}{
+		{name: "rejects requests during migration", blockOnMigration: "false", expectedCode: codes.Unavailable},
+		{name: "blocks requests until migration completes", blockOnMigration: "true", expectedCode: codes.OK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("INTERNAL_PORT", "0")
+			t.Setenv("EXTERNAL_PORT", "0")
+			t.Setenv("METRICS_PORT", "0")
+			t.Setenv("DATABASE_PATH", filepath.Join(t.TempDir(), "test.db"))
+			t.Setenv("BLOCK_ON_MIGRATION", tt.blockOnMigration)
+
+			application, err := New()
+			if err != nil {
+				t.Fatalf("Failed to create application: %v", err)
+			}
+			go application.Run()
+			defer application.Shutdown()
+
+			conn, err := grpc.NewClient(application.InternalAddr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+			if err != nil {
+				t.Fatalf("Failed to create client: %v", err)
+			}
+			defer conn.Close()
+
+			// A slow migration that creates the schema the request needs
+			started := make(chan struct{})
+			var migrationDone atomic.Bool
+			migrated := make(chan error, 1)
+			go func() {
+				migrated <- application.db.Migrate(context.Background(), func(ctx context.Context, conn *sql.DB) error {
+					close(started)
+					time.Sleep(200 * time.Millisecond)
+					_, err := conn.ExecContext(ctx, `CREATE TABLE ratings (id INTEGER PRIMARY KEY, rating INTEGER, ticket_id INTEGER,
+						rating_category_id INTEGER, reviewer_id INTEGER, reviewee_id INTEGER, created_at DATETIME)`)
+					migrationDone.Store(true)
+					return err
+				})
+			}()
+			<-started
+
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			health, err := healthpb.NewHealthClient(conn).Check(ctx, &healthpb.HealthCheckRequest{})
+			if err != nil {
+				t.Fatalf("Health check failed: %v", err)
+			}
+			if health.Status != healthpb.HealthCheckResponse_NOT_SERVING {
+				t.Errorf("Expected NOT_SERVING during migration, got %v", health.Status)
+			}
+
+			client := overallQualityPb.NewOverallQualityServiceClient(conn)
+			_, err = client.GetOverallQualityScore(ctx, &overallQualityPb.GetOverallQualityScoreRequest{
+				StartDate: "2019-10-01",
+				EndDate:   "2019-10-07",
+			})
+			if status.Code(err) != tt.expectedCode {
+				t.Fatalf("Expected %v, got %v", tt.expectedCode, err)
+			}
+			if tt.expectedCode == codes.OK && !migrationDone.Load() {
+				t.Error("Expected request to complete only after the migration")
+			}
+
+			if err := <-migrated; err != nil {
+				t.Fatalf("Migration failed: %v", err)
+			}
+
+			health, err = healthpb.NewHealthClient(conn).Check(ctx, &healthpb.HealthCheckRequest{})
+			if err != nil {
+				t.Fatalf("Health check failed: %v", err)
+			}
+			if health.Status != healthpb.HealthCheckResponse_SERVING {
+				t.Errorf("Expected SERVING after migration, got %v", health.Status)
+			}
+		})
+	}
+}
+
+func TestGlobalConcurrencyLimit(t *testing.T) {
+	const globalMaxGoroutines = 3
+
+	t.Setenv("INTERNAL_PORT", "0")
+	t.Setenv("EXTERNAL_PORT", "0")
+	t.Setenv("METRICS_PORT", "0")
+	t.Setenv("DATABASE_PATH", filepath.Join(t.TempDir(), "test.db"))
+	t.Setenv("GLOBAL_MAX_GOROUTINES", strconv.Itoa(globalMaxGoroutines))
+
+	application, err := New()
+	if err != nil {
+		t.Fatalf("Failed to create application: %v", err)
+	}
+	go application.Run()
+	defer application.Shutdown()
+
+	// Seed 10 tickets, so every request scores 10 tickets concurrently
+	err = application.db.Migrate(context.Background(), func(ctx context.Context, conn *sql.DB) error {
+		statements := []string{
+			`CREATE TABLE rating_categories (id INTEGER PRIMARY KEY, name TEXT NOT NULL, weight REAL NOT NULL)`,
+			`CREATE TABLE ratings (id INTEGER PRIMARY KEY, rating INTEGER, ticket_id INTEGER,
+				rating_category_id INTEGER, reviewer_id INTEGER, reviewee_id INTEGER, created_at DATETIME)`,
+			`INSERT INTO rating_categories (id, name, weight) VALUES (1, 'Spelling', 1), (2, 'Grammar', 0.5)`,
+		}
+		for _, statement := range statements {
+			if _, err := conn.ExecContext(ctx, statement); err != nil {
+				return err
+			}
+		}
+		createdAt := time.Date(2019, 10, 1, 12, 0, 0, 0, time.UTC)
+		for ticketID := 1; ticketID <= 10; ticketID++ {
+			for categoryID := 1; categoryID <= 2; categoryID++ {
+				if _, err := conn.ExecContext(ctx, `INSERT INTO ratings (rating, ticket_id, rating_category_id, reviewer_id, reviewee_id, created_at) VALUES (?, ?, ?, ?, ?, ?)`,
+					4, ticketID, categoryID, 1, 2, createdAt); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to seed database: %v", err)
+	}
+
+	conn, err := grpc.NewClient(application.InternalAddr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+	defer conn.Close()
+	client := ticketPb.NewTicketScoresServiceClient(conn)
+
+	const requests = 5
+	var wg sync.WaitGroup
+	for i := 0; i < requests; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+
+			stream, err := client.GetTicketScores(ctx, &ticketPb.GetTicketScoresRequest{StartDate: "2019-10-01", EndDate: "2019-10-02"})
+			if err != nil {
+				t.Errorf("Failed to start stream: %v", err)
+				return
+			}
+
+			received := 0
+			for {
+				_, err := stream.Recv()
+				if errors.Is(err, io.EOF) {
+					break
+				}
+				if err != nil {
+					t.Errorf("Stream failed: %v", err)
+					return
+				}
+				received++
+			}
+			if received != 10 {
+				t.Errorf("Expected 10 ticket scores, got %d", received)
+			}
+		}()
+	}
+	wg.Wait()
+
+	peak := application.limiter.Peak()
+	if peak == 0 {
+		t.Error("Expected requests to go through the global limiter")
+	}
+	if peak > globalMaxGoroutines {
+		t.Errorf("Expected at most %d goroutines across requests, got %d", globalMaxGoroutines, peak)
+	}
+}
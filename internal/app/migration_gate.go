@@ -0,0 +1,50 @@
+package app
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// readinessChecker reports whether the database can serve queries
+type readinessChecker interface {
+	IsReady() bool
+}
+
+// migrationGate rejects requests with codes.Unavailable while a migration is in progress.
+// When block is set, requests are let through and wait on the database instead.
+type migrationGate struct {
+	db    readinessChecker
+	block bool
+}
+
+// unary is the unary interceptor for the migration gate
+func (g migrationGate) unary(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	if err := g.check(info.FullMethod); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+// stream is the stream interceptor for the migration gate
+func (g migrationGate) stream(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if err := g.check(info.FullMethod); err != nil {
+		return err
+	}
+	return handler(srv, ss)
+}
+
+// check returns an Unavailable error if fullMethod must not run yet
+func (g migrationGate) check(fullMethod string) error {
+	// Health checks and reflection never touch the database
+	if strings.HasPrefix(fullMethod, "/grpc.health.") || strings.HasPrefix(fullMethod, "/grpc.reflection.") {
+		return nil
+	}
+	if g.block || g.db.IsReady() {
+		return nil
+	}
+	return status.Error(codes.Unavailable, "database migration in progress")
+}
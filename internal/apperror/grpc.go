@@ -0,0 +1,58 @@
+// Package apperror holds helpers for inspecting errors returned across the service layers
+package apperror
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// grpcStatusError is implemented by the errors created by the status package
+type grpcStatusError interface {
+	GRPCStatus() *status.Status
+}
+
+// UnwrapGRPCStatus finds the gRPC status error wrapped inside err, following errors.Unwrap and
+// errors.Join chains, and returns its status. Unlike status.FromError, which reports the message of
+// the whole wrapped error, the returned status keeps the original message.
+func UnwrapGRPCStatus(err error) (*status.Status, bool) {
+	for err != nil {
+		if statusErr, ok := err.(grpcStatusError); ok {
+			return statusErr.GRPCStatus(), true
+		}
+
+		// Joined errors are searched depth first, in order
+		if joined, ok := err.(interface{ Unwrap() []error }); ok {
+			for _, inner := range joined.Unwrap() {
+				if st, ok := UnwrapGRPCStatus(inner); ok {
+					return st, true
+				}
+			}
+			return nil, false
+		}
+
+		err = errors.Unwrap(err)
+	}
+
+	return nil, false
+}
+
+// ToGRPCError converts an error returned by a service into the error a gRPC handler returns, with
+// msg describing the failed operation. A gRPC status wrapped inside err keeps its code, context
+// cancellations and deadlines become Canceled and DeadlineExceeded, and anything else is Internal.
+func ToGRPCError(err error, msg string) error {
+	if st, ok := UnwrapGRPCStatus(err); ok {
+		return status.Errorf(st.Code(), "%s: %s", msg, st.Message())
+	}
+
+	code := codes.Internal
+	switch {
+	case errors.Is(err, context.Canceled):
+		code = codes.Canceled
+	case errors.Is(err, context.DeadlineExceeded):
+		code = codes.DeadlineExceeded
+	}
+	return status.Errorf(code, "%s: %v", msg, err)
+}
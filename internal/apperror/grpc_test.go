@@ -0,0 +1,119 @@
+package apperror
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestUnwrapGRPCStatus(t *testing.T) {
+	canceled := status.Error(codes.Canceled, "request canceled")
+
+	tests := []struct {
+		name            string
+		err             error
+		expectedOK      bool
+		expectedCode    codes.Code
+		expectedMessage string
+	}{
+		{
+			name:            "status error",
+			err:             canceled,
+			expectedOK:      true,
+			expectedCode:    codes.Canceled,
+			expectedMessage: "request canceled",
+		},
+		{
+			name:            "wrapped three levels deep",
+			err:             fmt.Errorf("failed to calculate score: %w", fmt.Errorf("chunk 2 failed: %w", fmt.Errorf("failed to get ratings: %w", canceled))),
+			expectedOK:      true,
+			expectedCode:    codes.Canceled,
+			expectedMessage: "request canceled",
+		},
+		{
+			name:            "joined with other errors",
+			err:             fmt.Errorf("chunk processing errors: %w", errors.Join(errors.New("chunk 1 failed"), fmt.Errorf("chunk 2 failed: %w", canceled))),
+			expectedOK:      true,
+			expectedCode:    codes.Canceled,
+			expectedMessage: "request canceled",
+		},
+		{
+			name:       "no status error",
+			err:        fmt.Errorf("failed to get ratings: %w", errors.New("database is locked")),
+			expectedOK: false,
+		},
+		{
+			name:       "nil error",
+			err:        nil,
+			expectedOK: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			st, ok := UnwrapGRPCStatus(tt.err)
+			if ok != tt.expectedOK {
+				t.Fatalf("Expected ok %v, got %v", tt.expectedOK, ok)
+			}
+			if !ok {
+				return
+			}
+			if st.Code() != tt.expectedCode {
+				t.Errorf("Expected code %v, got %v", tt.expectedCode, st.Code())
+			}
+			if st.Message() != tt.expectedMessage {
+				t.Errorf("Expected message %q, got %q", tt.expectedMessage, st.Message())
+			}
+		})
+	}
+}
+
+func TestToGRPCError(t *testing.T) {
+	tests := []struct {
+		name            string
+		err             error
+		expectedCode    codes.Code
+		expectedMessage string
+	}{
+		{
+			name:            "wrapped status keeps its code and message",
+			err:             fmt.Errorf("chunk 2 failed: %w", status.Error(codes.Unavailable, "database unavailable")),
+			expectedCode:    codes.Unavailable,
+			expectedMessage: "failed to get score: database unavailable",
+		},
+		{
+			name:            "context canceled",
+			err:             fmt.Errorf("failed to get ratings: %w", context.Canceled),
+			expectedCode:    codes.Canceled,
+			expectedMessage: "failed to get score: failed to get ratings: context canceled",
+		},
+		{
+			name:            "deadline exceeded",
+			err:             fmt.Errorf("chunk processing errors: %w", errors.Join(errors.New("chunk 1 failed"), context.DeadlineExceeded)),
+			expectedCode:    codes.DeadlineExceeded,
+			expectedMessage: "failed to get score: chunk processing errors: chunk 1 failed\ncontext deadline exceeded",
+		},
+		{
+			name:            "other errors are internal",
+			err:             errors.New("database is locked"),
+			expectedCode:    codes.Internal,
+			expectedMessage: "failed to get score: database is locked",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			st := status.Convert(ToGRPCError(tt.err, "failed to get score"))
+			if st.Code() != tt.expectedCode {
+				t.Errorf("Expected code %v, got %v", tt.expectedCode, st.Code())
+			}
+			if st.Message() != tt.expectedMessage {
+				t.Errorf("Expected message %q, got %q", tt.expectedMessage, st.Message())
+			}
+		})
+	}
+}
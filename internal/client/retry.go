@@ -0,0 +1,85 @@
+// Package client holds helpers for gRPC clients of the ticket score service
+package client
+
+import (
+	"context"
+	"math/rand/v2"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// jitterFraction is how far a backoff may randomly deviate from its nominal duration
+const jitterFraction = 0.2
+
+// RetryPolicy controls how failed unary calls are retried with exponential backoff
+type RetryPolicy struct {
+	MaxAttempts      int          // Total attempts including the first, at least 1
+	InitialBackoffMs int          // Wait before the first retry
+	MaxBackoffMs     int          // Upper bound of the wait before any retry
+	Multiplier       float64      // Growth of the wait after each retry, at least 1
+	RetryableCodes   []codes.Code // Status codes worth retrying
+}
+
+// DefaultRetryPolicy returns a policy retrying unavailable, overloaded and timed out calls up to four times
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts:      5,
+		InitialBackoffMs: 100,
+		MaxBackoffMs:     2000,
+		Multiplier:       2,
+		RetryableCodes:   []codes.Code{codes.Unavailable, codes.ResourceExhausted, codes.DeadlineExceeded},
+	}
+}
+
+// RetryUnaryInterceptor retries unary calls failing with a retryable code, waiting an exponentially
+// growing backoff with ±20% jitter between attempts. It stops early when the call's context is done.
+func RetryUnaryInterceptor(policy RetryPolicy) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		backoff := time.Duration(policy.InitialBackoffMs) * time.Millisecond
+		for attempt := 1; ; attempt++ {
+			err := invoker(ctx, method, req, reply, cc, opts...)
+			if err == nil || attempt >= policy.MaxAttempts || !policy.retryable(err) {
+				return err
+			}
+
+			timer := time.NewTimer(withJitter(backoff))
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return err
+			case <-timer.C:
+			}
+
+			backoff = policy.nextBackoff(backoff)
+		}
+	}
+}
+
+// retryable reports whether a failed call should be retried
+func (p RetryPolicy) retryable(err error) bool {
+	code := status.Code(err)
+	for _, retryable := range p.RetryableCodes {
+		if code == retryable {
+			return true
+		}
+	}
+	return false
+}
+
+// nextBackoff grows a backoff by the multiplier, capped at the maximum
+func (p RetryPolicy) nextBackoff(backoff time.Duration) time.Duration {
+	multiplier := max(p.Multiplier, 1)
+	next := time.Duration(float64(backoff) * multiplier)
+	if maxBackoff := time.Duration(p.MaxBackoffMs) * time.Millisecond; next > maxBackoff {
+		return maxBackoff
+	}
+	return next
+}
+
+// withJitter randomly moves a backoff by up to jitterFraction of its duration in either direction
+func withJitter(backoff time.Duration) time.Duration {
+	return time.Duration(float64(backoff) * (1 - jitterFraction + 2*jitterFraction*rand.Float64()))
+}
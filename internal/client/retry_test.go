@@ -0,0 +1,136 @@
+package client
+
+import (
+	"context"
+	"net"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// flakyHealthServer fails the first failures checks with code, then succeeds
+type flakyHealthServer struct {
+	healthpb.UnimplementedHealthServer
+	failures int32
+	code     codes.Code
+	attempts atomic.Int32
+}
+
+func (s *flakyHealthServer) Check(ctx context.Context, req *healthpb.HealthCheckRequest) (*healthpb.HealthCheckResponse, error) {
+	if s.attempts.Add(1) <= s.failures {
+		return nil, status.Error(s.code, "failing on purpose")
+	}
+	return &healthpb.HealthCheckResponse{Status: healthpb.HealthCheckResponse_SERVING}, nil
+}
+
+// dialFlakyServer starts a flaky server in memory and connects to it with the retry policy
+func dialFlakyServer(t *testing.T, server *flakyHealthServer, policy RetryPolicy) healthpb.HealthClient {
+	t.Helper()
+
+	listener := bufconn.Listen(1024 * 1024)
+	grpcServer := grpc.NewServer()
+	healthpb.RegisterHealthServer(grpcServer, server)
+	go grpcServer.Serve(listener)
+	t.Cleanup(grpcServer.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithUnaryInterceptor(RetryUnaryInterceptor(policy)),
+	)
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+
+	return healthpb.NewHealthClient(conn)
+}
+
+func TestRetryUnaryInterceptor(t *testing.T) {
+	// Backoffs of 20ms, 40ms and then 50ms (capped), each ±20%
+	policy := RetryPolicy{
+		MaxAttempts:      4,
+		InitialBackoffMs: 20,
+		MaxBackoffMs:     50,
+		Multiplier:       2,
+		RetryableCodes:   []codes.Code{codes.Unavailable, codes.ResourceExhausted, codes.DeadlineExceeded},
+	}
+
+	tests := []struct {
+		name             string
+		failures         int32
+		code             codes.Code
+		expectedCode     codes.Code
+		expectedAttempts int32
+		minWait          time.Duration
+		maxWait          time.Duration
+	}{
+		{name: "succeeds first time", failures: 0, code: codes.Unavailable, expectedCode: codes.OK, expectedAttempts: 1, maxWait: 0},
+		{name: "unavailable twice", failures: 2, code: codes.Unavailable, expectedCode: codes.OK, expectedAttempts: 3, minWait: 48 * time.Millisecond, maxWait: 72 * time.Millisecond},
+		{name: "resource exhausted three times", failures: 3, code: codes.ResourceExhausted, expectedCode: codes.OK, expectedAttempts: 4, minWait: 88 * time.Millisecond, maxWait: 132 * time.Millisecond},
+		{name: "deadline exceeded once", failures: 1, code: codes.DeadlineExceeded, expectedCode: codes.OK, expectedAttempts: 2, minWait: 16 * time.Millisecond, maxWait: 24 * time.Millisecond},
+		{name: "attempts exhausted", failures: 10, code: codes.Unavailable, expectedCode: codes.Unavailable, expectedAttempts: 4, minWait: 88 * time.Millisecond, maxWait: 132 * time.Millisecond},
+		{name: "not retryable", failures: 1, code: codes.InvalidArgument, expectedCode: codes.InvalidArgument, expectedAttempts: 1, maxWait: 0},
+	}
+
+	// Allowance for the calls themselves on a busy machine
+	const overhead = 100 * time.Millisecond
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := &flakyHealthServer{failures: tt.failures, code: tt.code}
+			client := dialFlakyServer(t, server, policy)
+
+			start := time.Now()
+			_, err := client.Check(context.Background(), &healthpb.HealthCheckRequest{})
+			elapsed := time.Since(start)
+
+			if status.Code(err) != tt.expectedCode {
+				t.Errorf("Expected code %v, got %v", tt.expectedCode, err)
+			}
+			if attempts := server.attempts.Load(); attempts != tt.expectedAttempts {
+				t.Errorf("Expected %d attempts, got %d", tt.expectedAttempts, attempts)
+			}
+			if elapsed < tt.minWait || elapsed > tt.maxWait+overhead {
+				t.Errorf("Expected a total wait between %v and %v, took %v", tt.minWait, tt.maxWait, elapsed)
+			}
+		})
+	}
+
+	t.Run("stops when the context is done", func(t *testing.T) {
+		server := &flakyHealthServer{failures: 10, code: codes.Unavailable}
+		client := dialFlakyServer(t, server, RetryPolicy{MaxAttempts: 10, InitialBackoffMs: 1000, MaxBackoffMs: 1000, Multiplier: 1, RetryableCodes: []codes.Code{codes.Unavailable}})
+
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+
+		start := time.Now()
+		if _, err := client.Check(ctx, &healthpb.HealthCheckRequest{}); status.Code(err) != codes.Unavailable {
+			t.Errorf("Expected Unavailable, got %v", err)
+		}
+		if elapsed := time.Since(start); elapsed > 500*time.Millisecond {
+			t.Errorf("Expected to stop at the deadline, took %v", elapsed)
+		}
+		if attempts := server.attempts.Load(); attempts != 1 {
+			t.Errorf("Expected 1 attempt, got %d", attempts)
+		}
+	})
+}
+
+func TestWithJitter(t *testing.T) {
+	backoff := 100 * time.Millisecond
+	for i := 0; i < 1000; i++ {
+		if jittered := withJitter(backoff); jittered < 80*time.Millisecond || jittered > 120*time.Millisecond {
+			t.Fatalf("Expected a backoff within 20%% of %v, got %v", backoff, jittered)
+		}
+	}
+}
@@ -0,0 +1,73 @@
+package concurrency
+
+import (
+	"context"
+	"sync/atomic"
+)
+
+// GlobalConcurrencyLimiter caps the number of database-bound goroutines running at once across
+// all services. A nil limiter imposes no limit.
+type GlobalConcurrencyLimiter struct {
+	slots chan struct{}
+	inUse atomic.Int32
+	peak  atomic.Int32
+}
+
+// NewGlobalConcurrencyLimiter creates a limiter allowing maxGoroutines slots to be held at once
+func NewGlobalConcurrencyLimiter(maxGoroutines int) *GlobalConcurrencyLimiter {
+	if maxGoroutines < 1 {
+		maxGoroutines = 1
+	}
+	return &GlobalConcurrencyLimiter{
+		slots: make(chan struct{}, maxGoroutines),
+	}
+}
+
+// Acquire blocks until a slot is free, returning ctx.Err() if ctx is done first.
+// Every successful Acquire must be paired with a Release.
+func (l *GlobalConcurrencyLimiter) Acquire(ctx context.Context) error {
+	if l == nil {
+		return nil
+	}
+
+	select {
+	case l.slots <- struct{}{}:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	inUse := l.inUse.Add(1)
+	for {
+		peak := l.peak.Load()
+		if inUse <= peak || l.peak.CompareAndSwap(peak, inUse) {
+			break
+		}
+	}
+	return nil
+}
+
+// Release frees a slot taken by Acquire
+func (l *GlobalConcurrencyLimiter) Release() {
+	if l == nil {
+		return
+	}
+
+	l.inUse.Add(-1)
+	<-l.slots
+}
+
+// Limit returns the maximum number of slots that can be held at once, or 0 for a nil limiter
+func (l *GlobalConcurrencyLimiter) Limit() int {
+	if l == nil {
+		return 0
+	}
+	return cap(l.slots)
+}
+
+// Peak returns the highest number of slots held at once so far
+func (l *GlobalConcurrencyLimiter) Peak() int {
+	if l == nil {
+		return 0
+	}
+	return int(l.peak.Load())
+}
@@ -0,0 +1,96 @@
+package concurrency
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestGlobalConcurrencyLimiter(t *testing.T) {
+	tests := []struct {
+		name          string
+		maxGoroutines int
+		workers       int
+		expectedLimit int
+	}{
+		{name: "fewer workers than slots", maxGoroutines: 10, workers: 3, expectedLimit: 10},
+		{name: "more workers than slots", maxGoroutines: 3, workers: 20, expectedLimit: 3},
+		{name: "non-positive limit allows one", maxGoroutines: 0, workers: 5, expectedLimit: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			limiter := NewGlobalConcurrencyLimiter(tt.maxGoroutines)
+			if limiter.Limit() != tt.expectedLimit {
+				t.Fatalf("Expected limit %d, got %d", tt.expectedLimit, limiter.Limit())
+			}
+
+			var current, peak atomic.Int32
+			var wg sync.WaitGroup
+			for i := 0; i < tt.workers; i++ {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					if err := limiter.Acquire(context.Background()); err != nil {
+						t.Errorf("Unexpected error: %v", err)
+						return
+					}
+					defer limiter.Release()
+
+					n := current.Add(1)
+					for {
+						p := peak.Load()
+						if n <= p || peak.CompareAndSwap(p, n) {
+							break
+						}
+					}
+					time.Sleep(5 * time.Millisecond)
+					current.Add(-1)
+				}()
+			}
+			wg.Wait()
+
+			if int(peak.Load()) > tt.expectedLimit {
+				t.Errorf("Expected at most %d concurrent holders, got %d", tt.expectedLimit, peak.Load())
+			}
+			if limiter.Peak() != int(peak.Load()) {
+				t.Errorf("Expected limiter peak %d, got %d", peak.Load(), limiter.Peak())
+			}
+		})
+	}
+}
+
+func TestGlobalConcurrencyLimiter_CanceledContext(t *testing.T) {
+	limiter := NewGlobalConcurrencyLimiter(1)
+	if err := limiter.Acquire(context.Background()); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := limiter.Acquire(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected deadline exceeded while the only slot is held, got %v", err)
+	}
+
+	limiter.Release()
+	if err := limiter.Acquire(context.Background()); err != nil {
+		t.Errorf("Expected slot to be free after release, got %v", err)
+	}
+}
+
+func TestGlobalConcurrencyLimiter_Nil(t *testing.T) {
+	var limiter *GlobalConcurrencyLimiter
+	if err := limiter.Acquire(context.Background()); err != nil {
+		t.Errorf("Expected nil limiter not to block, got %v", err)
+	}
+	limiter.Release()
+	if limiter.Peak() != 0 {
+		t.Errorf("Expected nil limiter peak 0, got %d", limiter.Peak())
+	}
+	if limiter.Limit() != 0 {
+		t.Errorf("Expected nil limiter limit 0, got %d", limiter.Limit())
+	}
+}
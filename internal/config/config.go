@@ -1,19 +1,181 @@
 package config
 
 import (
+	"errors"
+	"fmt"
 	"os"
+	"strconv"
+	"strings"
 )
 
 type Config struct {
-	Port         string
+	ExternalPort string // Port for external clients, falls back to PORT
+	InternalPort string // Port for internal microservices
 	DatabasePath string
+	SwaggerPort  string
+	MetricsPort  string // Port for the Prometheus /metrics endpoint
+
+	MaxConnectRetries      int // Extra attempts to connect to the database at startup
+	ConnectRetryIntervalMs int // Wait between database connection attempts
+
+	MaxCategoryConcurrency int // Categories scored concurrently per ticket
+	GlobalMaxGoroutines    int // Database-bound goroutines running at once across all services
+	ScorePrecision         int // Decimal places in formatted scores (0-2)
+	CacheStaleDays         int // Overall quality scores of periods that ended longer ago are cached, 0 disables
+
+	AggregationThresholdDays int // Category analytics of longer date ranges are scored by month or week instead of by day
+	MaxRating                int // Highest rating value, which scores 100%
+
+	// Reloaded from ConfigFilePath while running, see Watcher
+	ChunkSize             int // Ratings per chunk in overall quality calculations
+	MaxGoroutines         int // Chunks processed concurrently per overall quality calculation
+	RequestTimeoutSeconds int // Deadline for each request, 0 for none
+
+	ConfigFilePath              string // JSON file with runtime-tunable settings, empty to disable reloading
+	ConfigReloadIntervalSeconds int    // How often ConfigFilePath is checked for changes
+
+	ABTestEnabled    bool   // Serve GetTicketScoreABTest
+	ABTestAlgorithms string // The two scoring algorithms compared by GetTicketScoreABTest, comma-separated
+
+	TracingEnabled   bool // Trace and debug-log every SQL query
+	BlockOnMigration bool // Hold requests until a migration finishes instead of rejecting them
+	LogErrorStacks   bool // Include call stacks when logging service errors
+
+	RateLimits string // Requests per second by full gRPC method name, as comma-separated method=limit pairs
+
+	// Environment variables that could not be parsed, reported by Validate
+	envErrors []error
 }
 
+// New reads the configuration from the environment. Unset variables take their defaults; set ones
+// are used as given, so Validate can reject values that are out of range or not numbers.
 func New() *Config {
-	return &Config{
-		Port:         getEnv("PORT", "50051"),
+	env := &envReader{}
+	cfg := &Config{
+		ExternalPort: getEnv("EXTERNAL_PORT", getEnv("PORT", "50051")),
+		InternalPort: getEnv("INTERNAL_PORT", "50052"),
 		DatabasePath: getEnv("DATABASE_PATH", "./database.db"),
+		SwaggerPort:  getEnv("SWAGGER_PORT", "8081"),
+		MetricsPort:  getEnv("METRICS_PORT", "9090"),
+
+		MaxConnectRetries:      env.getInt("MAX_CONNECT_RETRIES", 0),
+		ConnectRetryIntervalMs: env.getInt("CONNECT_RETRY_INTERVAL_MS", 1000),
+
+		MaxCategoryConcurrency: env.getInt("MAX_CATEGORY_CONCURRENCY", 5),
+		GlobalMaxGoroutines:    env.getInt("GLOBAL_MAX_GOROUTINES", 50),
+		ScorePrecision:         env.getInt("SCORE_PRECISION", 0),
+		CacheStaleDays:         env.getInt("CACHE_STALE_DAYS", 0),
+
+		AggregationThresholdDays: env.getInt("AGGREGATION_THRESHOLD_DAYS", 30),
+		MaxRating:                env.getInt("MAX_RATING", 5),
+
+		ChunkSize:             env.getInt("CHUNK_SIZE", 1000),
+		MaxGoroutines:         env.getInt("MAX_GOROUTINES", 10),
+		RequestTimeoutSeconds: env.getInt("REQUEST_TIMEOUT_SECONDS", 0),
+
+		ConfigFilePath:              getEnv("CONFIG_FILE_PATH", ""),
+		ConfigReloadIntervalSeconds: env.getInt("CONFIG_RELOAD_INTERVAL_SECONDS", 30),
+
+		ABTestEnabled:    getEnvBool("AB_TEST_ENABLED"),
+		ABTestAlgorithms: getEnv("AB_TEST_ALGORITHMS", "weighted,bayesian"),
+
+		TracingEnabled:   getEnvBool("TRACING_ENABLED"),
+		BlockOnMigration: getEnvBool("BLOCK_ON_MIGRATION"),
+		LogErrorStacks:   getEnvBool("LOG_ERROR_STACKS"),
+
+		RateLimits: getEnv("RATE_LIMITS", ""),
+	}
+	cfg.envErrors = env.errs
+	return cfg
+}
+
+// Validate checks that the configuration values are usable
+func (c *Config) Validate() error {
+	if len(c.envErrors) > 0 {
+		return errors.Join(c.envErrors...)
+	}
+	if c.ScorePrecision < 0 || c.ScorePrecision > 2 {
+		return fmt.Errorf("score precision must be 0, 1 or 2, got %d", c.ScorePrecision)
+	}
+	if c.MaxConnectRetries < 0 {
+		return fmt.Errorf("max connect retries must not be negative, got %d", c.MaxConnectRetries)
+	}
+	if c.ConnectRetryIntervalMs < 0 {
+		return fmt.Errorf("connect retry interval must not be negative, got %d", c.ConnectRetryIntervalMs)
+	}
+	if c.MaxCategoryConcurrency <= 0 {
+		return fmt.Errorf("max category concurrency must be positive, got %d", c.MaxCategoryConcurrency)
+	}
+	if c.GlobalMaxGoroutines <= 0 {
+		return fmt.Errorf("global max goroutines must be positive, got %d", c.GlobalMaxGoroutines)
 	}
+	if c.CacheStaleDays < 0 {
+		return fmt.Errorf("cache stale days must not be negative, got %d", c.CacheStaleDays)
+	}
+	if c.AggregationThresholdDays < 0 {
+		return fmt.Errorf("aggregation threshold days must not be negative, got %d", c.AggregationThresholdDays)
+	}
+	if c.MaxRating <= 0 {
+		return fmt.Errorf("max rating must be positive, got %d", c.MaxRating)
+	}
+	if c.ChunkSize <= 0 {
+		return fmt.Errorf("chunk size must be positive, got %d", c.ChunkSize)
+	}
+	if c.MaxGoroutines <= 0 {
+		return fmt.Errorf("max goroutines must be positive, got %d", c.MaxGoroutines)
+	}
+	if c.RequestTimeoutSeconds < 0 {
+		return fmt.Errorf("request timeout must not be negative, got %d", c.RequestTimeoutSeconds)
+	}
+	if c.ConfigFilePath != "" && c.ConfigReloadIntervalSeconds <= 0 {
+		return fmt.Errorf("config reload interval must be positive, got %d", c.ConfigReloadIntervalSeconds)
+	}
+	if c.ABTestEnabled {
+		if _, _, err := c.ABTestAlgorithmPair(); err != nil {
+			return err
+		}
+	}
+	if _, err := c.MethodRateLimits(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ABTestAlgorithmPair splits ABTestAlgorithms into the two algorithms to compare
+func (c *Config) ABTestAlgorithmPair() (string, string, error) {
+	algorithms := strings.Split(c.ABTestAlgorithms, ",")
+	if len(algorithms) != 2 {
+		return "", "", fmt.Errorf("A/B test algorithms must name exactly two algorithms, got %q", c.ABTestAlgorithms)
+	}
+	algorithmA, algorithmB := strings.TrimSpace(algorithms[0]), strings.TrimSpace(algorithms[1])
+	if algorithmA == "" || algorithmB == "" {
+		return "", "", fmt.Errorf("A/B test algorithms must not be empty, got %q", c.ABTestAlgorithms)
+	}
+	return algorithmA, algorithmB, nil
+}
+
+// MethodRateLimits parses RateLimits, e.g. "/ticket_scores.TicketScoresService/GetTicketScores=2",
+// into requests per second keyed by method
+func (c *Config) MethodRateLimits() (map[string]int, error) {
+	limits := make(map[string]int)
+	if strings.TrimSpace(c.RateLimits) == "" {
+		return limits, nil
+	}
+	for _, pair := range strings.Split(c.RateLimits, ",") {
+		method, value, ok := strings.Cut(strings.TrimSpace(pair), "=")
+		if !ok || method == "" {
+			return nil, fmt.Errorf("rate limits must be method=limit pairs, got %q", pair)
+		}
+		rps, err := strconv.Atoi(value)
+		if err != nil {
+			return nil, fmt.Errorf("rate limit for %s must be a number, got %q", method, value)
+		}
+		if rps <= 0 {
+			return nil, fmt.Errorf("rate limit for %s must be positive, got %d", method, rps)
+		}
+		limits[method] = rps
+	}
+	return limits, nil
 }
 
 func getEnv(key, defaultValue string) string {
@@ -22,3 +184,29 @@ func getEnv(key, defaultValue string) string {
 	}
 	return defaultValue
 }
+
+// envReader reads numeric environment variables, collecting the ones that can't be parsed
+type envReader struct {
+	errs []error
+}
+
+// getInt returns the value of key, or defaultValue when it is unset. A value that is not a number
+// is recorded and replaced with defaultValue.
+func (r *envReader) getInt(key string, defaultValue int) int {
+	raw := os.Getenv(key)
+	if raw == "" {
+		return defaultValue
+	}
+	value, err := strconv.Atoi(raw)
+	if err != nil {
+		r.errs = append(r.errs, fmt.Errorf("%s must be a number, got %q", key, raw))
+		return defaultValue
+	}
+	return value
+}
+
+// getEnvBool returns the boolean value of key, or false when it is unset or not a boolean
+func getEnvBool(key string) bool {
+	value, _ := strconv.ParseBool(os.Getenv(key))
+	return value
+}
@@ -0,0 +1,221 @@
+package config
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name              string
+		scorePrecision    int
+		rateLimits        string
+		maxConnectRetries int
+		cacheStaleDays    int
+		aggregationDays   int
+		abTestEnabled     bool
+		abTestAlgorithms  string
+		expectedError     bool
+	}{
+		{name: "precision 0", scorePrecision: 0},
+		{name: "precision 1", scorePrecision: 1},
+		{name: "precision 2", scorePrecision: 2},
+		{name: "negative precision", scorePrecision: -1, expectedError: true},
+		{name: "precision too high", scorePrecision: 3, expectedError: true},
+		{name: "positive rate limit", rateLimits: "/a.Service/Method=2"},
+		{name: "zero rate limit", rateLimits: "/a.Service/Method=0", expectedError: true},
+		{name: "malformed rate limit", rateLimits: "/a.Service/Method=x", expectedError: true},
+		{name: "connect retries", maxConnectRetries: 3},
+		{name: "negative connect retries", maxConnectRetries: -1, expectedError: true},
+		{name: "cache stale days", cacheStaleDays: 7},
+		{name: "negative cache stale days", cacheStaleDays: -1, expectedError: true},
+		{name: "aggregation threshold days", aggregationDays: 14},
+		{name: "negative aggregation threshold days", aggregationDays: -1, expectedError: true},
+		{name: "two A/B test algorithms", abTestEnabled: true, abTestAlgorithms: "weighted, bayesian"},
+		{name: "one A/B test algorithm", abTestEnabled: true, abTestAlgorithms: "weighted", expectedError: true},
+		{name: "empty A/B test algorithm", abTestEnabled: true, abTestAlgorithms: "weighted,", expectedError: true},
+		{name: "A/B test algorithms ignored when disabled", abTestAlgorithms: "weighted"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &Config{
+				ScorePrecision:           tt.scorePrecision,
+				RateLimits:               tt.rateLimits,
+				MaxConnectRetries:        tt.maxConnectRetries,
+				CacheStaleDays:           tt.cacheStaleDays,
+				AggregationThresholdDays: tt.aggregationDays,
+				ABTestEnabled:            tt.abTestEnabled,
+				ABTestAlgorithms:         tt.abTestAlgorithms,
+				ChunkSize:                1000,
+				MaxGoroutines:            10,
+				MaxRating:                5,
+				MaxCategoryConcurrency:   5,
+				GlobalMaxGoroutines:      50,
+			}
+
+			err := cfg.Validate()
+			if tt.expectedError && err == nil {
+				t.Error("Expected error but got none")
+			}
+			if !tt.expectedError && err != nil {
+				t.Errorf("Unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestConfig_ValidateTuning(t *testing.T) {
+	tests := []struct {
+		name           string
+		chunkSize      int
+		maxGoroutines  int
+		requestTimeout int
+		maxRating      int
+		expectedError  bool
+	}{
+		{name: "valid", chunkSize: 1000, maxGoroutines: 10, requestTimeout: 30, maxRating: 5},
+		{name: "no request timeout", chunkSize: 1000, maxGoroutines: 10, requestTimeout: 0, maxRating: 5},
+		{name: "ten point rating scale", chunkSize: 1000, maxGoroutines: 10, maxRating: 10},
+		{name: "zero chunk size", chunkSize: 0, maxGoroutines: 10, maxRating: 5, expectedError: true},
+		{name: "zero goroutines", chunkSize: 1000, maxGoroutines: 0, maxRating: 5, expectedError: true},
+		{name: "negative request timeout", chunkSize: 1000, maxGoroutines: 10, requestTimeout: -1, maxRating: 5, expectedError: true},
+		{name: "zero max rating", chunkSize: 1000, maxGoroutines: 10, maxRating: 0, expectedError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &Config{
+				ChunkSize:              tt.chunkSize,
+				MaxGoroutines:          tt.maxGoroutines,
+				RequestTimeoutSeconds:  tt.requestTimeout,
+				MaxRating:              tt.maxRating,
+				MaxCategoryConcurrency: 5,
+				GlobalMaxGoroutines:    50,
+			}
+
+			err := cfg.Validate()
+			if tt.expectedError && err == nil {
+				t.Error("Expected error but got none")
+			}
+			if !tt.expectedError && err != nil {
+				t.Errorf("Unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestNew_InvalidNumbers(t *testing.T) {
+	tests := []struct {
+		name          string
+		key           string
+		value         string
+		expectedError bool
+	}{
+		{name: "negative max rating", key: "MAX_RATING", value: "-1", expectedError: true},
+		{name: "zero chunk size", key: "CHUNK_SIZE", value: "0", expectedError: true},
+		{name: "non-numeric chunk size", key: "CHUNK_SIZE", value: "many", expectedError: true},
+		{name: "zero category concurrency", key: "MAX_CATEGORY_CONCURRENCY", value: "0", expectedError: true},
+		{name: "zero global goroutines", key: "GLOBAL_MAX_GOROUTINES", value: "0", expectedError: true},
+		{name: "negative connect retry interval", key: "CONNECT_RETRY_INTERVAL_MS", value: "-5", expectedError: true},
+		{name: "zero connect retries", key: "MAX_CONNECT_RETRIES", value: "0"},
+		{name: "zero score precision", key: "SCORE_PRECISION", value: "0"},
+		{name: "zero cache stale days", key: "CACHE_STALE_DAYS", value: "0"},
+		{name: "zero request timeout", key: "REQUEST_TIMEOUT_SECONDS", value: "0"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv(tt.key, tt.value)
+
+			err := New().Validate()
+			if tt.expectedError && err == nil {
+				t.Error("Expected error but got none")
+			}
+			if !tt.expectedError && err != nil {
+				t.Errorf("Unexpected error: %v", err)
+			}
+		})
+	}
+
+	t.Run("reload interval with a config file", func(t *testing.T) {
+		t.Setenv("CONFIG_FILE_PATH", "config.json")
+		t.Setenv("CONFIG_RELOAD_INTERVAL_SECONDS", "0")
+		if err := New().Validate(); err == nil {
+			t.Error("Expected error but got none")
+		}
+	})
+}
+
+func TestNew_ScorePrecision(t *testing.T) {
+	t.Setenv("SCORE_PRECISION", "2")
+	if cfg := New(); cfg.ScorePrecision != 2 {
+		t.Errorf("Expected score precision 2, got %d", cfg.ScorePrecision)
+	}
+
+	t.Setenv("SCORE_PRECISION", "")
+	if cfg := New(); cfg.ScorePrecision != 0 {
+		t.Errorf("Expected default score precision 0, got %d", cfg.ScorePrecision)
+	}
+}
+
+func TestNew_ConnectRetries(t *testing.T) {
+	t.Setenv("MAX_CONNECT_RETRIES", "")
+	t.Setenv("CONNECT_RETRY_INTERVAL_MS", "")
+	if cfg := New(); cfg.MaxConnectRetries != 0 || cfg.ConnectRetryIntervalMs != 1000 {
+		t.Errorf("Expected defaults of 0 retries every 1000ms, got %d every %dms", cfg.MaxConnectRetries, cfg.ConnectRetryIntervalMs)
+	}
+
+	t.Setenv("MAX_CONNECT_RETRIES", "5")
+	t.Setenv("CONNECT_RETRY_INTERVAL_MS", "250")
+	if cfg := New(); cfg.MaxConnectRetries != 5 || cfg.ConnectRetryIntervalMs != 250 {
+		t.Errorf("Expected 5 retries every 250ms, got %d every %dms", cfg.MaxConnectRetries, cfg.ConnectRetryIntervalMs)
+	}
+}
+
+func TestNew_RateLimits(t *testing.T) {
+	tests := []struct {
+		name          string
+		value         string
+		expected      map[string]int
+		expectedError bool
+	}{
+		{name: "unset", value: "", expected: map[string]int{}},
+		{
+			name:  "several methods",
+			value: "/ticket_scores.TicketScoresService/GetTicketScores=2, /overall_quality.OverallQualityService/GetOverallQualityScore=10",
+			expected: map[string]int{
+				"/ticket_scores.TicketScoresService/GetTicketScores":            2,
+				"/overall_quality.OverallQualityService/GetOverallQualityScore": 10,
+			},
+		},
+		{name: "non-numeric limit", value: "/a.Service/Method=x,/a.Service/Ok=1", expectedError: true},
+		{name: "missing limit", value: "/a.Service/Other,/a.Service/Ok=1", expectedError: true},
+		{name: "missing method", value: "=3,/a.Service/Ok=1", expectedError: true},
+		{name: "negative limit", value: "/a.Service/Method=-1", expectedError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("RATE_LIMITS", tt.value)
+			cfg := New()
+
+			limits, err := cfg.MethodRateLimits()
+			if tt.expectedError {
+				if err == nil {
+					t.Errorf("Expected error but got limits %v", limits)
+				}
+				if cfg.Validate() == nil {
+					t.Error("Expected Validate to fail")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(limits, tt.expected) {
+				t.Errorf("Expected rate limits %v, got %v", tt.expected, limits)
+			}
+		})
+	}
+}
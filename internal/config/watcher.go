@@ -0,0 +1,113 @@
+package config
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// fileConfig holds the settings that can be changed in the config file while the service runs.
+// Settings missing from the file keep their startup values; other keys are ignored.
+type fileConfig struct {
+	ChunkSize             *int `json:"chunk_size"`
+	MaxGoroutines         *int `json:"max_goroutines"`
+	RequestTimeoutSeconds *int `json:"request_timeout_seconds"`
+}
+
+// Watcher polls a JSON config file and swaps in a new configuration whenever it changes.
+// Readers must call Get for every use instead of holding on to the returned config.
+type Watcher struct {
+	path     string
+	interval time.Duration
+	base     Config
+	current  atomic.Pointer[Config]
+
+	mu       sync.Mutex // serializes reloads
+	lastData []byte
+}
+
+// NewWatcher creates a watcher serving cfg until the file at path is loaded. An empty path
+// never changes the configuration.
+func NewWatcher(cfg *Config, path string, interval time.Duration) *Watcher {
+	w := &Watcher{
+		path:     path,
+		interval: interval,
+		base:     *cfg,
+	}
+	w.current.Store(cfg)
+	return w
+}
+
+// Get returns the current configuration, which must not be modified
+func (w *Watcher) Get() *Config {
+	return w.current.Load()
+}
+
+// Reload applies the config file if it changed since it was last read. An invalid file leaves
+// the current configuration in place.
+func (w *Watcher) Reload() error {
+	if w.path == "" {
+		return nil
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	data, err := os.ReadFile(w.path)
+	if err != nil {
+		return fmt.Errorf("failed to read config file: %w", err)
+	}
+	if w.lastData != nil && bytes.Equal(data, w.lastData) {
+		return nil
+	}
+	w.lastData = data
+
+	var file fileConfig
+	if err := json.Unmarshal(data, &file); err != nil {
+		return fmt.Errorf("failed to parse config file: %w", err)
+	}
+
+	cfg := w.base
+	if file.ChunkSize != nil {
+		cfg.ChunkSize = *file.ChunkSize
+	}
+	if file.MaxGoroutines != nil {
+		cfg.MaxGoroutines = *file.MaxGoroutines
+	}
+	if file.RequestTimeoutSeconds != nil {
+		cfg.RequestTimeoutSeconds = *file.RequestTimeoutSeconds
+	}
+	if err := cfg.Validate(); err != nil {
+		return fmt.Errorf("invalid config file: %w", err)
+	}
+
+	w.current.Store(&cfg)
+	return nil
+}
+
+// Run reloads the config file every interval until ctx is canceled
+func (w *Watcher) Run(ctx context.Context) {
+	if w.path == "" {
+		return
+	}
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if err := w.Reload(); err != nil {
+				log.Printf("Keeping current configuration: %v", err)
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
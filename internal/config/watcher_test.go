@@ -0,0 +1,120 @@
+package config
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func validConfig() *Config {
+	return &Config{ChunkSize: 1000, MaxGoroutines: 10, RequestTimeoutSeconds: 0, MaxRating: 5, MaxCategoryConcurrency: 5, GlobalMaxGoroutines: 50}
+}
+
+// waitFor polls until check passes or timeout elapses
+func waitFor(timeout time.Duration, check func() bool) bool {
+	deadline := time.Now().Add(timeout)
+	for time.Now().Before(deadline) {
+		if check() {
+			return true
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	return check()
+}
+
+func TestWatcher_PicksUpChanges(t *testing.T) {
+	const interval = 100 * time.Millisecond
+
+	path := filepath.Join(t.TempDir(), "config.json")
+	if err := os.WriteFile(path, []byte(`{"chunk_size": 500}`), 0o644); err != nil {
+		t.Fatalf("Failed to write config file: %v", err)
+	}
+
+	watcher := NewWatcher(validConfig(), path, interval)
+	if err := watcher.Reload(); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if cfg := watcher.Get(); cfg.ChunkSize != 500 || cfg.MaxGoroutines != 10 {
+		t.Fatalf("Expected chunk size 500 and 10 goroutines, got %d and %d", cfg.ChunkSize, cfg.MaxGoroutines)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go watcher.Run(ctx)
+
+	if err := os.WriteFile(path, []byte(`{"chunk_size": 250, "max_goroutines": 4, "request_timeout_seconds": 30}`), 0o644); err != nil {
+		t.Fatalf("Failed to write config file: %v", err)
+	}
+	if !waitFor(2*interval, func() bool { return watcher.Get().ChunkSize == 250 }) {
+		t.Fatalf("Expected chunk size 250 within two intervals, got %d", watcher.Get().ChunkSize)
+	}
+	if cfg := watcher.Get(); cfg.MaxGoroutines != 4 || cfg.RequestTimeoutSeconds != 30 {
+		t.Errorf("Expected 4 goroutines and a 30s timeout, got %d and %ds", cfg.MaxGoroutines, cfg.RequestTimeoutSeconds)
+	}
+
+	// Settings removed from the file go back to their startup values
+	if err := os.WriteFile(path, []byte(`{"max_goroutines": 2}`), 0o644); err != nil {
+		t.Fatalf("Failed to write config file: %v", err)
+	}
+	if !waitFor(2*interval, func() bool { return watcher.Get().MaxGoroutines == 2 }) {
+		t.Fatalf("Expected 2 goroutines within two intervals, got %d", watcher.Get().MaxGoroutines)
+	}
+	if cfg := watcher.Get(); cfg.ChunkSize != 1000 || cfg.RequestTimeoutSeconds != 0 {
+		t.Errorf("Expected startup chunk size 1000 and no timeout, got %d and %ds", cfg.ChunkSize, cfg.RequestTimeoutSeconds)
+	}
+}
+
+func TestWatcher_Reload(t *testing.T) {
+	tests := []struct {
+		name          string
+		content       string
+		expectedChunk int
+		expectedError bool
+	}{
+		{name: "valid file", content: `{"chunk_size": 50}`, expectedChunk: 50},
+		{name: "unknown keys are ignored", content: `{"chunk_size": 50, "database_path": "/tmp/other.db"}`, expectedChunk: 50},
+		{name: "invalid value", content: `{"chunk_size": 0}`, expectedChunk: 1000, expectedError: true},
+		{name: "malformed JSON", content: `{"chunk_size":`, expectedChunk: 1000, expectedError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "config.json")
+			if err := os.WriteFile(path, []byte(tt.content), 0o644); err != nil {
+				t.Fatalf("Failed to write config file: %v", err)
+			}
+
+			watcher := NewWatcher(validConfig(), path, time.Second)
+			err := watcher.Reload()
+			if tt.expectedError && err == nil {
+				t.Error("Expected error but got none")
+			}
+			if !tt.expectedError && err != nil {
+				t.Errorf("Unexpected error: %v", err)
+			}
+			if chunk := watcher.Get().ChunkSize; chunk != tt.expectedChunk {
+				t.Errorf("Expected chunk size %d, got %d", tt.expectedChunk, chunk)
+			}
+		})
+	}
+
+	t.Run("missing file", func(t *testing.T) {
+		watcher := NewWatcher(validConfig(), filepath.Join(t.TempDir(), "missing.json"), time.Second)
+		if err := watcher.Reload(); err == nil {
+			t.Error("Expected error but got none")
+		}
+	})
+
+	t.Run("no file configured", func(t *testing.T) {
+		cfg := validConfig()
+		watcher := NewWatcher(cfg, "", time.Second)
+		if err := watcher.Reload(); err != nil {
+			t.Errorf("Unexpected error: %v", err)
+		}
+		if watcher.Get() != cfg {
+			t.Error("Expected the startup configuration")
+		}
+	})
+}
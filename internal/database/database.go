@@ -4,6 +4,9 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"log"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	_ "github.com/mattn/go-sqlite3"
@@ -11,10 +14,47 @@ import (
 
 type DB struct {
 	conn *sql.DB
+
+	// migrationLock is held for writing during Migrate and for reading while each statement starts.
+	// It is not held while rows are read, see QueryContext.
+	migrationLock sync.RWMutex
+	migrating     atomic.Bool
+
+	readyListenersMu sync.Mutex
+	readyListeners   []func(ready bool)
 }
 
 func New(databasePath string) (*DB, error) {
-	conn, err := sql.Open("sqlite3", databasePath)
+	return NewWithRetry(databasePath, 0, 0)
+}
+
+// NewWithRetry opens and pings the database, retrying up to maxRetries more times with
+// retryInterval between attempts. It returns the last error once all attempts fail.
+func NewWithRetry(databasePath string, maxRetries int, retryInterval time.Duration) (*DB, error) {
+	return connect("sqlite3", databasePath, maxRetries, retryInterval)
+}
+
+// connect runs the open and ping sequence with the given driver until it succeeds or runs out of retries
+func connect(driverName, databasePath string, maxRetries int, retryInterval time.Duration) (*DB, error) {
+	var lastErr error
+	for attempt := 1; attempt <= maxRetries+1; attempt++ {
+		db, err := open(driverName, databasePath)
+		if err == nil {
+			return db, nil
+		}
+		lastErr = err
+		log.Printf("Database connection attempt %d of %d failed: %v", attempt, maxRetries+1, err)
+
+		if attempt <= maxRetries {
+			time.Sleep(retryInterval)
+		}
+	}
+	return nil, lastErr
+}
+
+// open opens a connection pool and checks that the database is reachable
+func open(driverName, databasePath string) (*DB, error) {
+	conn, err := sql.Open(driverName, databasePath)
 	if err != nil {
 		return nil, fmt.Errorf("failed to open database: %w", err)
 	}
@@ -59,3 +99,36 @@ func (db *DB) Close() error {
 func (db *DB) GetConnection() *sql.DB {
 	return db.conn
 }
+
+// BeginTx starts a new transaction, waiting for any migration in progress
+func (db *DB) BeginTx(ctx context.Context, opts *sql.TxOptions) (*sql.Tx, error) {
+	db.migrationLock.RLock()
+	defer db.migrationLock.RUnlock()
+
+	tx, err := db.conn.BeginTx(ctx, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	return tx, nil
+}
+
+// WithTransaction runs fn inside a transaction, committing if fn succeeds and rolling back otherwise
+func (db *DB) WithTransaction(ctx context.Context, fn func(*sql.Tx) error) error {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	if err := fn(tx); err != nil {
+		if rollbackErr := tx.Rollback(); rollbackErr != nil {
+			return fmt.Errorf("failed to roll back transaction: %v (original error: %w)", rollbackErr, err)
+		}
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,161 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/mattn/go-sqlite3"
+)
+
+func newTestDB(t *testing.T) *DB {
+	t.Helper()
+
+	db, err := New(filepath.Join(t.TempDir(), "test.db"))
+	if err != nil {
+		t.Fatalf("Failed to open database: %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+
+	if _, err := db.GetConnection().Exec(`CREATE TABLE flags (ticket_id INTEGER PRIMARY KEY)`); err != nil {
+		t.Fatalf("Failed to create table: %v", err)
+	}
+
+	return db
+}
+
+func countFlags(t *testing.T, db *DB) int {
+	t.Helper()
+
+	var count int
+	if err := db.GetConnection().QueryRow(`SELECT COUNT(*) FROM flags`).Scan(&count); err != nil {
+		t.Fatalf("Failed to count rows: %v", err)
+	}
+	return count
+}
+
+func TestWithTransaction(t *testing.T) {
+	t.Run("commits on success", func(t *testing.T) {
+		db := newTestDB(t)
+
+		err := db.WithTransaction(context.Background(), func(tx *sql.Tx) error {
+			for ticketID := 1; ticketID <= 3; ticketID++ {
+				if _, err := tx.Exec(`INSERT INTO flags (ticket_id) VALUES (?)`, ticketID); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		if count := countFlags(t, db); count != 3 {
+			t.Errorf("Expected 3 rows, got %d", count)
+		}
+	})
+
+	t.Run("rolls back every write when a later step fails", func(t *testing.T) {
+		db := newTestDB(t)
+		errFlag := errors.New("flag failed")
+
+		err := db.WithTransaction(context.Background(), func(tx *sql.Tx) error {
+			for ticketID := 1; ticketID <= 3; ticketID++ {
+				if ticketID == 3 {
+					return errFlag
+				}
+				if _, err := tx.Exec(`INSERT INTO flags (ticket_id) VALUES (?)`, ticketID); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		if !errors.Is(err, errFlag) {
+			t.Fatalf("Expected flag error, got %v", err)
+		}
+
+		if count := countFlags(t, db); count != 0 {
+			t.Errorf("Expected all writes to be rolled back, got %d rows", count)
+		}
+	})
+}
+
+func TestBeginTx(t *testing.T) {
+	db := newTestDB(t)
+
+	tx, err := db.BeginTx(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if _, err := tx.Exec(`INSERT INTO flags (ticket_id) VALUES (1)`); err != nil {
+		t.Fatalf("Failed to insert: %v", err)
+	}
+	if err := tx.Rollback(); err != nil {
+		t.Fatalf("Failed to roll back: %v", err)
+	}
+
+	if count := countFlags(t, db); count != 0 {
+		t.Errorf("Expected rollback to discard the insert, got %d rows", count)
+	}
+}
+
+// flakyDriver fails the first failures connection attempts, then opens SQLite connections
+type flakyDriver struct {
+	failures int32
+	opens    atomic.Int32
+}
+
+func (d *flakyDriver) Open(name string) (driver.Conn, error) {
+	if d.opens.Add(1) <= d.failures {
+		return nil, errors.New("database unavailable")
+	}
+	return (&sqlite3.SQLiteDriver{}).Open(name)
+}
+
+func TestConnect_Retries(t *testing.T) {
+	tests := []struct {
+		name             string
+		failures         int32
+		maxRetries       int
+		expectedAttempts int32
+		expectedError    bool
+	}{
+		{name: "first attempt succeeds", failures: 0, maxRetries: 3, expectedAttempts: 1},
+		{name: "succeeds after two failed pings", failures: 2, maxRetries: 3, expectedAttempts: 3},
+		{name: "succeeds on the last retry", failures: 2, maxRetries: 2, expectedAttempts: 3},
+		{name: "retries exhausted", failures: 5, maxRetries: 2, expectedAttempts: 3, expectedError: true},
+		{name: "no retries", failures: 1, maxRetries: 0, expectedAttempts: 1, expectedError: true},
+	}
+
+	for i, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			flaky := &flakyDriver{failures: tt.failures}
+			driverName := fmt.Sprintf("flaky-sqlite3-%d", i)
+			sql.Register(driverName, flaky)
+
+			db, err := connect(driverName, ":memory:", tt.maxRetries, time.Millisecond)
+			if tt.expectedError {
+				if err == nil {
+					db.Close()
+					t.Fatal("Expected error but got none")
+				}
+			} else {
+				if err != nil {
+					t.Fatalf("Unexpected error: %v", err)
+				}
+				db.Close()
+			}
+
+			if attempts := flaky.opens.Load(); attempts != tt.expectedAttempts {
+				t.Errorf("Expected %d connection attempts, got %d", tt.expectedAttempts, attempts)
+			}
+		})
+	}
+}
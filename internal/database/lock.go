@@ -0,0 +1,68 @@
+package database
+
+import (
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"encoding/hex"
+	"fmt"
+	"time"
+)
+
+// CreateSnapshotLocksTable creates the snapshot_locks table if it does not exist.
+// It is a Migration.
+func CreateSnapshotLocksTable(ctx context.Context, conn *sql.DB) error {
+	query := `CREATE TABLE IF NOT EXISTS snapshot_locks (
+				lock_key TEXT NOT NULL,
+				owner TEXT NOT NULL,
+				expires_at INTEGER NOT NULL,
+				UNIQUE (lock_key)
+			  )`
+
+	if _, err := conn.ExecContext(ctx, query); err != nil {
+		return fmt.Errorf("failed to create snapshot_locks table: %w", err)
+	}
+
+	return nil
+}
+
+// AcquireLock takes the lock named lockKey for ttlSeconds, shared by every process using the
+// database, and returns the owner token to release it with. It reports false if another holder's
+// lock has not expired yet. An expired lock is taken over, so a holder that dies without calling
+// ReleaseLock blocks others for at most ttlSeconds.
+func (db *DB) AcquireLock(ctx context.Context, lockKey string, ttlSeconds int) (string, bool, error) {
+	token := make([]byte, 16)
+	if _, err := rand.Read(token); err != nil {
+		return "", false, fmt.Errorf("failed to generate owner of lock %s: %w", lockKey, err)
+	}
+	owner := hex.EncodeToString(token)
+
+	now := time.Now().Unix()
+	query := `INSERT INTO snapshot_locks (lock_key, owner, expires_at) VALUES (?, ?, ?)
+			  ON CONFLICT (lock_key) DO UPDATE SET owner = excluded.owner, expires_at = excluded.expires_at
+			  WHERE snapshot_locks.expires_at <= ?`
+
+	result, err := db.ExecContext(ctx, query, lockKey, owner, now+int64(ttlSeconds), now)
+	if err != nil {
+		return "", false, fmt.Errorf("failed to acquire lock %s: %w", lockKey, err)
+	}
+
+	acquired, err := result.RowsAffected()
+	if err != nil {
+		return "", false, fmt.Errorf("failed to acquire lock %s: %w", lockKey, err)
+	}
+	if acquired != 1 {
+		return "", false, nil
+	}
+
+	return owner, true, nil
+}
+
+// ReleaseLock releases the lock named lockKey if owner still holds it. Releasing a lock that is
+// not held, or that expired and was taken over by another holder, does nothing.
+func (db *DB) ReleaseLock(ctx context.Context, lockKey, owner string) error {
+	if _, err := db.ExecContext(ctx, `DELETE FROM snapshot_locks WHERE lock_key = ? AND owner = ?`, lockKey, owner); err != nil {
+		return fmt.Errorf("failed to release lock %s: %w", lockKey, err)
+	}
+	return nil
+}
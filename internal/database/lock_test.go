@@ -0,0 +1,79 @@
+package database
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+)
+
+func TestAcquireLock(t *testing.T) {
+	ctx := context.Background()
+
+	db, err := New(filepath.Join(t.TempDir(), "test.db"))
+	if err != nil {
+		t.Fatalf("Failed to open database: %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+
+	if err := db.Migrate(ctx, CreateSnapshotLocksTable); err != nil {
+		t.Fatalf("Failed to migrate database: %v", err)
+	}
+
+	acquire := func(lockKey string, ttlSeconds int) (string, bool) {
+		t.Helper()
+
+		owner, acquired, err := db.AcquireLock(ctx, lockKey, ttlSeconds)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		return owner, acquired
+	}
+	release := func(lockKey, owner string) {
+		t.Helper()
+
+		if err := db.ReleaseLock(ctx, lockKey, owner); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+	}
+
+	owner, acquired := acquire("snapshot-2019-W41", 60)
+	if !acquired {
+		t.Fatal("Expected to acquire a free lock")
+	}
+	if _, acquired := acquire("snapshot-2019-W41", 60); acquired {
+		t.Error("Expected a held lock not to be acquired again")
+	}
+	if _, acquired := acquire("snapshot-2019-W42", 60); !acquired {
+		t.Error("Expected a lock with a different key to be acquired")
+	}
+
+	release("snapshot-2019-W41", owner)
+	if _, acquired := acquire("snapshot-2019-W41", 60); !acquired {
+		t.Error("Expected a released lock to be acquired")
+	}
+
+	t.Run("expired lock is taken over", func(t *testing.T) {
+		expiredOwner, acquired := acquire("snapshot-2019-W43", 0)
+		if !acquired {
+			t.Fatal("Expected to acquire a free lock")
+		}
+		if _, acquired := acquire("snapshot-2019-W43", 60); !acquired {
+			t.Error("Expected an expired lock to be acquired")
+		}
+		if _, acquired := acquire("snapshot-2019-W43", 60); acquired {
+			t.Error("Expected the taken over lock to be held")
+		}
+
+		// The previous holder releasing late must not free the new holder's lock
+		release("snapshot-2019-W43", expiredOwner)
+		if _, acquired := acquire("snapshot-2019-W43", 60); acquired {
+			t.Error("Expected the lock to stay held after its previous owner released it")
+		}
+	})
+
+	t.Run("releasing a free lock", func(t *testing.T) {
+		if err := db.ReleaseLock(ctx, "snapshot-2019-W44", "owner"); err != nil {
+			t.Errorf("Unexpected error: %v", err)
+		}
+	})
+}
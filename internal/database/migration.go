@@ -0,0 +1,84 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+)
+
+// Migration changes the database schema or data
+type Migration func(ctx context.Context, conn *sql.DB) error
+
+// Migrate runs migrations in order. Statements started through the DB wait until all migrations
+// finish, and Migrate waits for statements that are starting, but not for rows still being read.
+func (db *DB) Migrate(ctx context.Context, migrations ...Migration) error {
+	db.migrationLock.Lock()
+	defer db.migrationLock.Unlock()
+
+	db.setMigrating(true)
+	defer db.setMigrating(false)
+
+	for i, migrate := range migrations {
+		if err := migrate(ctx, db.conn); err != nil {
+			return fmt.Errorf("failed to run migration %d: %w", i+1, err)
+		}
+	}
+
+	return nil
+}
+
+// IsReady reports whether the database can serve queries, i.e. no migration is in progress
+func (db *DB) IsReady() bool {
+	return !db.migrating.Load()
+}
+
+// OnReadyChange registers fn to be called whenever IsReady changes
+func (db *DB) OnReadyChange(fn func(ready bool)) {
+	db.readyListenersMu.Lock()
+	defer db.readyListenersMu.Unlock()
+
+	db.readyListeners = append(db.readyListeners, fn)
+}
+
+// setMigrating updates the migration state and notifies ready listeners
+func (db *DB) setMigrating(migrating bool) {
+	db.migrating.Store(migrating)
+
+	db.readyListenersMu.Lock()
+	defer db.readyListenersMu.Unlock()
+
+	for _, fn := range db.readyListeners {
+		fn(!migrating)
+	}
+}
+
+// QueryContext runs a query that returns rows, waiting for any migration in progress.
+// The migration lock is released once the query has started, not when the rows are closed, so a
+// migration can run while the caller is still reading rows. Holding it until Close would deadlock
+// callers that query again while reading, whenever a migration is waiting for the lock.
+func (db *DB) QueryContext(ctx context.Context, query string, args ...any) (Rows, error) {
+	db.migrationLock.RLock()
+	defer db.migrationLock.RUnlock()
+
+	rows, err := db.conn.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// ExecContext runs a statement that returns no rows, waiting for any migration in progress
+func (db *DB) ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error) {
+	db.migrationLock.RLock()
+	defer db.migrationLock.RUnlock()
+
+	return db.conn.ExecContext(ctx, query, args...)
+}
+
+// QueryRowContext runs a query that returns at most one row, waiting for any migration in progress
+func (db *DB) QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row {
+	db.migrationLock.RLock()
+	defer db.migrationLock.RUnlock()
+
+	return db.conn.QueryRowContext(ctx, query, args...)
+}
@@ -0,0 +1,106 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestMigrate(t *testing.T) {
+	t.Run("queries wait for the migration", func(t *testing.T) {
+		db := newTestDB(t)
+
+		var mu sync.Mutex
+		var readyChanges []bool
+		db.OnReadyChange(func(ready bool) {
+			mu.Lock()
+			defer mu.Unlock()
+			readyChanges = append(readyChanges, ready)
+		})
+
+		started := make(chan struct{})
+		migrated := make(chan error, 1)
+		go func() {
+			migrated <- db.Migrate(context.Background(), func(ctx context.Context, conn *sql.DB) error {
+				close(started)
+				time.Sleep(100 * time.Millisecond)
+				_, err := conn.ExecContext(ctx, `INSERT INTO flags (ticket_id) VALUES (1)`)
+				return err
+			})
+		}()
+
+		<-started
+		if db.IsReady() {
+			t.Error("Expected database not to be ready during migration")
+		}
+
+		// The query must see the migration's row, so it cannot run before the migration finishes
+		var count int
+		if err := db.QueryRowContext(context.Background(), `SELECT COUNT(*) FROM flags`).Scan(&count); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if count != 1 {
+			t.Errorf("Expected query to wait for migration, saw %d rows", count)
+		}
+
+		if err := <-migrated; err != nil {
+			t.Fatalf("Unexpected migration error: %v", err)
+		}
+		if !db.IsReady() {
+			t.Error("Expected database to be ready after migration")
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+		if len(readyChanges) != 2 || readyChanges[0] || !readyChanges[1] {
+			t.Errorf("Expected ready changes [false true], got %v", readyChanges)
+		}
+	})
+
+	t.Run("open rows do not block a migration", func(t *testing.T) {
+		db := newTestDB(t)
+
+		rows, err := db.QueryContext(context.Background(), `SELECT ticket_id FROM flags`)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		defer rows.Close()
+
+		migrated := make(chan error, 1)
+		go func() {
+			migrated <- db.Migrate(context.Background(), func(ctx context.Context, conn *sql.DB) error { return nil })
+		}()
+
+		select {
+		case err := <-migrated:
+			if err != nil {
+				t.Fatalf("Unexpected migration error: %v", err)
+			}
+		case <-time.After(time.Second):
+			t.Fatal("Expected the migration not to wait for the rows to be closed")
+		}
+	})
+
+	t.Run("stops at the first failing migration", func(t *testing.T) {
+		db := newTestDB(t)
+		errMigration := errors.New("migration failed")
+
+		ran := 0
+		err := db.Migrate(context.Background(),
+			func(ctx context.Context, conn *sql.DB) error { ran++; return errMigration },
+			func(ctx context.Context, conn *sql.DB) error { ran++; return nil },
+		)
+		if !errors.Is(err, errMigration) {
+			t.Fatalf("Expected migration error, got %v", err)
+		}
+		if ran != 1 {
+			t.Errorf("Expected 1 migration to run, ran %d", ran)
+		}
+		if !db.IsReady() {
+			t.Error("Expected database to be ready after a failed migration")
+		}
+	})
+}
@@ -0,0 +1,172 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"log/slog"
+	"regexp"
+	"strings"
+	"sync"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Rows is the subset of *sql.Rows used by the repositories
+type Rows interface {
+	Next() bool
+	Scan(dest ...any) error
+	Err() error
+	Close() error
+}
+
+// WrappedDB is the subset of *sql.DB used by the repositories
+type WrappedDB interface {
+	QueryContext(ctx context.Context, query string, args ...any) (Rows, error)
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+	BeginTx(ctx context.Context, opts *sql.TxOptions) (*sql.Tx, error)
+}
+
+var (
+	tableNamePattern     = regexp.MustCompile(`(?i)\b(?:FROM|INTO|UPDATE|JOIN)\s+([A-Za-z_][A-Za-z0-9_]*)`)
+	stringLiteralPattern = regexp.MustCompile(`'(?:[^']|'')*'`)
+	numberLiteralPattern = regexp.MustCompile(`\b\d+(?:\.\d+)?\b`)
+)
+
+// TracedDB wraps a database, starting a span and logging the statement for every query
+type TracedDB struct {
+	conn   WrappedDB
+	tracer trace.Tracer
+	logger *slog.Logger
+}
+
+// NewTracedDB creates a new traced database wrapper
+func NewTracedDB(conn WrappedDB, tracer trace.Tracer, logger *slog.Logger) *TracedDB {
+	return &TracedDB{
+		conn:   conn,
+		tracer: tracer,
+		logger: logger,
+	}
+}
+
+// QueryContext runs a query that returns rows inside a span. The span ends once the rows are read
+// or closed.
+func (db *TracedDB) QueryContext(ctx context.Context, query string, args ...any) (Rows, error) {
+	ctx, span := db.startSpan(ctx, query)
+
+	rows, err := db.conn.QueryContext(ctx, query, args...)
+	if err != nil {
+		recordError(span, err)
+		span.End()
+		return nil, err
+	}
+	return &tracedRows{Rows: rows, span: span}, nil
+}
+
+// ExecContext runs a statement that returns no rows inside a span
+func (db *TracedDB) ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error) {
+	ctx, span := db.startSpan(ctx, query)
+	defer span.End()
+
+	result, err := db.conn.ExecContext(ctx, query, args...)
+	recordError(span, err)
+	return result, err
+}
+
+// QueryRowContext runs a query that returns at most one row inside a span.
+// Errors surface when the row is scanned, so they are not recorded on the span.
+func (db *TracedDB) QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row {
+	ctx, span := db.startSpan(ctx, query)
+	defer span.End()
+
+	return db.conn.QueryRowContext(ctx, query, args...)
+}
+
+// BeginTx starts a transaction; statements run on the transaction are not traced
+func (db *TracedDB) BeginTx(ctx context.Context, opts *sql.TxOptions) (*sql.Tx, error) {
+	return db.conn.BeginTx(ctx, opts)
+}
+
+// startSpan starts a span named after the statement's operation and table, and logs the statement
+func (db *TracedDB) startSpan(ctx context.Context, query string) (context.Context, trace.Span) {
+	operation, table := describeQuery(query)
+	statement := sanitizeQuery(query)
+
+	ctx, span := db.tracer.Start(ctx, strings.TrimSpace(operation+" "+table),
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.String("db.system", "sqlite"),
+			attribute.String("db.operation", operation),
+			attribute.String("db.sql.table", table),
+			attribute.String("db.statement", statement),
+		),
+	)
+
+	db.logger.DebugContext(ctx, "db query",
+		slog.String("operation", operation),
+		slog.String("table", table),
+		slog.String("statement", statement),
+	)
+
+	return ctx, span
+}
+
+// tracedRows ends the span of its query when the last row has been read or the rows are closed
+type tracedRows struct {
+	Rows
+	span     trace.Span
+	spanOnce sync.Once
+}
+
+// Next advances to the next row, ending the span after the last one
+func (r *tracedRows) Next() bool {
+	if r.Rows.Next() {
+		return true
+	}
+	r.endSpan()
+	return false
+}
+
+// Close closes the rows and ends the span
+func (r *tracedRows) Close() error {
+	err := r.Rows.Close()
+	r.endSpan()
+	return err
+}
+
+// endSpan records any error hit while reading the rows and ends the span, once
+func (r *tracedRows) endSpan() {
+	r.spanOnce.Do(func() {
+		recordError(r.span, r.Rows.Err())
+		r.span.End()
+	})
+}
+
+// recordError marks the span as failed when err is not nil
+func recordError(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+}
+
+// describeQuery returns the SQL operation (e.g. "SELECT") and the first table a query touches
+func describeQuery(query string) (operation, table string) {
+	fields := strings.Fields(query)
+	if len(fields) > 0 {
+		operation = strings.ToUpper(fields[0])
+	}
+	if match := tableNamePattern.FindStringSubmatch(query); match != nil {
+		table = match[1]
+	}
+	return operation, table
+}
+
+// sanitizeQuery collapses whitespace and replaces literal values with placeholders
+func sanitizeQuery(query string) string {
+	query = stringLiteralPattern.ReplaceAllString(query, "?")
+	query = numberLiteralPattern.ReplaceAllString(query, "?")
+	return strings.Join(strings.Fields(query), " ")
+}
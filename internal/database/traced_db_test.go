@@ -0,0 +1,213 @@
+package database
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+// newTestTracedDB wraps a test database, recording spans and debug logs
+func newTestTracedDB(t *testing.T) (*TracedDB, *tracetest.SpanRecorder, *bytes.Buffer) {
+	t.Helper()
+
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	t.Cleanup(func() { provider.Shutdown(context.Background()) })
+
+	var logs bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&logs, &slog.HandlerOptions{Level: slog.LevelDebug}))
+
+	return NewTracedDB(newTestDB(t), provider.Tracer("test"), logger), recorder, &logs
+}
+
+// spanAttributes returns the string attributes of a span
+func spanAttributes(attributes []attribute.KeyValue) map[string]string {
+	values := make(map[string]string, len(attributes))
+	for _, attr := range attributes {
+		values[string(attr.Key)] = attr.Value.AsString()
+	}
+	return values
+}
+
+func TestTracedDB_Spans(t *testing.T) {
+	tests := []struct {
+		name              string
+		run               func(ctx context.Context, db *TracedDB) error
+		expectedName      string
+		expectedOperation string
+		expectedTable     string
+		expectedError     bool
+	}{
+		{
+			name: "exec",
+			run: func(ctx context.Context, db *TracedDB) error {
+				_, err := db.ExecContext(ctx, "INSERT INTO flags (ticket_id) VALUES (?)", 42)
+				return err
+			},
+			expectedName:      "INSERT flags",
+			expectedOperation: "INSERT",
+			expectedTable:     "flags",
+		},
+		{
+			name: "query",
+			run: func(ctx context.Context, db *TracedDB) error {
+				rows, err := db.QueryContext(ctx, "SELECT ticket_id\n\t\t\t  FROM flags WHERE ticket_id = ?", 42)
+				if err != nil {
+					return err
+				}
+				return rows.Close()
+			},
+			expectedName:      "SELECT flags",
+			expectedOperation: "SELECT",
+			expectedTable:     "flags",
+		},
+		{
+			name: "query row",
+			run: func(ctx context.Context, db *TracedDB) error {
+				var count int
+				return db.QueryRowContext(ctx, "SELECT COUNT(*) FROM flags").Scan(&count)
+			},
+			expectedName:      "SELECT flags",
+			expectedOperation: "SELECT",
+			expectedTable:     "flags",
+		},
+		{
+			name: "failed query",
+			run: func(ctx context.Context, db *TracedDB) error {
+				_, err := db.QueryContext(ctx, "SELECT id FROM missing_table")
+				return err
+			},
+			expectedName:      "SELECT missing_table",
+			expectedOperation: "SELECT",
+			expectedTable:     "missing_table",
+			expectedError:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, recorder, _ := newTestTracedDB(t)
+
+			err := tt.run(context.Background(), db)
+			if tt.expectedError != (err != nil) {
+				t.Fatalf("Expected error: %v, got %v", tt.expectedError, err)
+			}
+
+			spans := recorder.Ended()
+			if len(spans) != 1 {
+				t.Fatalf("Expected 1 span, got %d", len(spans))
+			}
+			span := spans[0]
+
+			if span.Name() != tt.expectedName {
+				t.Errorf("Expected span name %q, got %q", tt.expectedName, span.Name())
+			}
+
+			attributes := spanAttributes(span.Attributes())
+			if attributes["db.operation"] != tt.expectedOperation {
+				t.Errorf("Expected db.operation %q, got %q", tt.expectedOperation, attributes["db.operation"])
+			}
+			if attributes["db.sql.table"] != tt.expectedTable {
+				t.Errorf("Expected db.sql.table %q, got %q", tt.expectedTable, attributes["db.sql.table"])
+			}
+			if attributes["db.system"] != "sqlite" {
+				t.Errorf("Expected db.system sqlite, got %q", attributes["db.system"])
+			}
+
+			if tt.expectedError != (span.Status().Code == codes.Error) {
+				t.Errorf("Expected error status: %v, got %v", tt.expectedError, span.Status().Code)
+			}
+		})
+	}
+}
+
+func TestTracedDB_QuerySpanCoversRows(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("ended after the last row", func(t *testing.T) {
+		db, recorder, _ := newTestTracedDB(t)
+		if _, err := db.ExecContext(ctx, "INSERT INTO flags (ticket_id) VALUES (1), (2)"); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		rows, err := db.QueryContext(ctx, "SELECT ticket_id FROM flags")
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		defer rows.Close()
+
+		for rows.Next() {
+			// Only the insert has ended while rows are still being read
+			if ended := len(recorder.Ended()); ended != 1 {
+				t.Fatalf("Expected the query span to be open while reading, got %d ended spans", ended)
+			}
+		}
+		if ended := len(recorder.Ended()); ended != 2 {
+			t.Errorf("Expected the query span to end after the last row, got %d ended spans", ended)
+		}
+	})
+
+	t.Run("ended once on close", func(t *testing.T) {
+		db, recorder, _ := newTestTracedDB(t)
+
+		rows, err := db.QueryContext(ctx, "SELECT ticket_id FROM flags")
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if ended := len(recorder.Ended()); ended != 0 {
+			t.Fatalf("Expected the query span to be open before close, got %d ended spans", ended)
+		}
+
+		rows.Close()
+		rows.Close()
+		if ended := len(recorder.Ended()); ended != 1 {
+			t.Errorf("Expected the query span to end once, got %d ended spans", ended)
+		}
+	})
+}
+
+func TestTracedDB_LogsSanitizedStatement(t *testing.T) {
+	db, _, logs := newTestTracedDB(t)
+
+	if _, err := db.ExecContext(context.Background(), "INSERT INTO flags (ticket_id) VALUES (31337)"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	output := logs.String()
+	if !strings.Contains(output, "level=DEBUG") || !strings.Contains(output, "table=flags") {
+		t.Errorf("Expected debug log with table, got %q", output)
+	}
+	if strings.Contains(output, "31337") {
+		t.Errorf("Expected literal values to be removed, got %q", output)
+	}
+}
+
+func TestSanitizeQuery(t *testing.T) {
+	tests := []struct {
+		query    string
+		expected string
+	}{
+		{"SELECT id FROM ratings WHERE id = ?", "SELECT id FROM ratings WHERE id = ?"},
+		{"SELECT id\n\t\tFROM ratings\n\t\tWHERE rating > 3", "SELECT id FROM ratings WHERE rating > ?"},
+		{"SELECT id FROM users WHERE name = 'O''Brien' AND score = 4.5", "SELECT id FROM users WHERE name = ? AND score = ?"},
+	}
+
+	for _, tt := range tests {
+		if result := sanitizeQuery(tt.query); result != tt.expected {
+			t.Errorf("sanitizeQuery(%q) = %q, expected %q", tt.query, result, tt.expected)
+		}
+	}
+}
+
+// TracedDB must be usable wherever the repositories expect a database
+var (
+	_ WrappedDB = (*TracedDB)(nil)
+	_ WrappedDB = (*DB)(nil)
+)
@@ -0,0 +1,54 @@
+package interceptor
+
+import (
+	"context"
+	"log/slog"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"ticket-score-service/internal/logger"
+)
+
+// ErrorLog logs requests that fail inside the server, i.e. with an Internal or Unknown status.
+// Errors caused by the request itself, such as InvalidArgument or NotFound, aren't logged. The
+// logger is also passed down in the request context, see logger.FromContext, so the services can
+// log their errors with the call stack where they occur.
+type ErrorLog struct {
+	logger *slog.Logger
+}
+
+// NewErrorLog creates an error logging interceptor writing to logger
+func NewErrorLog(logger *slog.Logger) *ErrorLog {
+	return &ErrorLog{
+		logger: logger,
+	}
+}
+
+// Unary is the unary interceptor for error logging
+func (l *ErrorLog) Unary(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	resp, err := handler(l.withLogger(ctx, info.FullMethod), req)
+	l.log(ctx, info.FullMethod, err)
+	return resp, err
+}
+
+// Stream is the stream interceptor for error logging
+func (l *ErrorLog) Stream(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	err := handler(srv, &contextStream{ServerStream: ss, ctx: l.withLogger(ss.Context(), info.FullMethod)})
+	l.log(ss.Context(), info.FullMethod, err)
+	return err
+}
+
+// withLogger returns a copy of ctx carrying the logger, annotated with method
+func (l *ErrorLog) withLogger(ctx context.Context, method string) context.Context {
+	return logger.NewContext(ctx, l.logger.With(slog.String("method", method)))
+}
+
+// log logs err if it is a server-side failure of method
+func (l *ErrorLog) log(ctx context.Context, method string, err error) {
+	switch status.Code(err) {
+	case codes.Internal, codes.Unknown:
+		l.logger.ErrorContext(ctx, "request failed", slog.String("method", method), slog.String("error", err.Error()))
+	}
+}
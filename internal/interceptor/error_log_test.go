@@ -0,0 +1,75 @@
+package interceptor
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"ticket-score-service/internal/logger"
+)
+
+func TestErrorLog(t *testing.T) {
+	tests := []struct {
+		name        string
+		err         error
+		expectedLog bool
+	}{
+		{name: "success", err: nil},
+		{name: "invalid argument", err: status.Error(codes.InvalidArgument, "bad date")},
+		{name: "internal error", err: status.Error(codes.Internal, "database is locked"), expectedLog: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var logs bytes.Buffer
+			errorLog := NewErrorLog(logger.New(&logs, false))
+
+			_, err := errorLog.Unary(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/test.Service/Unary"}, func(ctx context.Context, req any) (any, error) {
+				return nil, tt.err
+			})
+			if err != tt.err {
+				t.Fatalf("Expected error %v, got %v", tt.err, err)
+			}
+
+			err = errorLog.Stream(nil, &fakeServerStream{ctx: context.Background()}, &grpc.StreamServerInfo{FullMethod: "/test.Service/Stream"}, func(srv any, ss grpc.ServerStream) error {
+				return tt.err
+			})
+			if err != tt.err {
+				t.Fatalf("Expected error %v, got %v", tt.err, err)
+			}
+
+			output := logs.String()
+			for _, method := range []string{"/test.Service/Unary", "/test.Service/Stream"} {
+				if strings.Contains(output, method) != tt.expectedLog {
+					t.Errorf("Expected log for %s %v, got %q", method, tt.expectedLog, output)
+				}
+			}
+		})
+	}
+}
+
+func TestErrorLog_PassesLoggerToHandler(t *testing.T) {
+	var logs bytes.Buffer
+	errorLog := NewErrorLog(logger.New(&logs, false))
+
+	errorLog.Unary(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/test.Service/Unary"}, func(ctx context.Context, req any) (any, error) {
+		logger.FromContext(ctx).ErrorContext(ctx, "service error")
+		return nil, nil
+	})
+	errorLog.Stream(nil, &fakeServerStream{ctx: context.Background()}, &grpc.StreamServerInfo{FullMethod: "/test.Service/Stream"}, func(srv any, ss grpc.ServerStream) error {
+		logger.FromContext(ss.Context()).ErrorContext(ss.Context(), "service error")
+		return nil
+	})
+
+	output := logs.String()
+	for _, method := range []string{"method=/test.Service/Unary", "method=/test.Service/Stream"} {
+		if !strings.Contains(output, method) {
+			t.Errorf("Expected a log annotated with %s, got %q", method, output)
+		}
+	}
+}
@@ -0,0 +1,54 @@
+package interceptor
+
+import (
+	"context"
+
+	"golang.org/x/time/rate"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// RateLimiter limits the request rate of individual gRPC methods with token buckets.
+// Methods without a configured limit are not limited.
+type RateLimiter struct {
+	limits map[string]*rate.Limiter // keyed by full method name, e.g. "/ticket_scores.TicketScoresService/GetTicketScores"
+}
+
+// NewRateLimiter creates a rate limiter from requests per second keyed by full method name.
+// Each bucket holds one second's worth of requests.
+func NewRateLimiter(requestsPerSecond map[string]int) *RateLimiter {
+	limits := make(map[string]*rate.Limiter, len(requestsPerSecond))
+	for method, rps := range requestsPerSecond {
+		limits[method] = rate.NewLimiter(rate.Limit(rps), rps)
+	}
+
+	return &RateLimiter{
+		limits: limits,
+	}
+}
+
+// Unary is the unary interceptor for the rate limiter
+func (l *RateLimiter) Unary(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	if err := l.check(info.FullMethod); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+// Stream is the stream interceptor for the rate limiter
+func (l *RateLimiter) Stream(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if err := l.check(info.FullMethod); err != nil {
+		return err
+	}
+	return handler(srv, ss)
+}
+
+// check returns a ResourceExhausted error if fullMethod's bucket is empty
+func (l *RateLimiter) check(fullMethod string) error {
+	limiter, ok := l.limits[fullMethod]
+	if !ok || limiter.Allow() {
+		return nil
+	}
+	return status.Errorf(codes.ResourceExhausted, "rate limit exceeded for %s", fullMethod)
+}
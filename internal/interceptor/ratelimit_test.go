@@ -0,0 +1,80 @@
+package interceptor
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const limitedMethod = "/ticket_scores.TicketScoresService/GetTicketScores"
+
+func TestRateLimiter_Unary(t *testing.T) {
+	limiter := NewRateLimiter(map[string]int{limitedMethod: 2})
+	handler := func(ctx context.Context, req any) (any, error) { return "ok", nil }
+
+	tests := []struct {
+		name          string
+		method        string
+		requests      int
+		expectedCodes []codes.Code
+	}{
+		{
+			name:     "limited method",
+			method:   limitedMethod,
+			requests: 10,
+			expectedCodes: []codes.Code{
+				codes.OK, codes.OK, codes.ResourceExhausted, codes.ResourceExhausted, codes.ResourceExhausted,
+				codes.ResourceExhausted, codes.ResourceExhausted, codes.ResourceExhausted, codes.ResourceExhausted, codes.ResourceExhausted,
+			},
+		},
+		{
+			name:     "method without a limit",
+			method:   "/ticket_scores.TicketScoresService/GetTicketScoresGroupedByReviewer",
+			requests: 10,
+			expectedCodes: []codes.Code{
+				codes.OK, codes.OK, codes.OK, codes.OK, codes.OK, codes.OK, codes.OK, codes.OK, codes.OK, codes.OK,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// All requests are fired well within the first second
+			info := &grpc.UnaryServerInfo{FullMethod: tt.method}
+			for i := 0; i < tt.requests; i++ {
+				_, err := limiter.Unary(context.Background(), nil, info, handler)
+				if code := status.Code(err); code != tt.expectedCodes[i] {
+					t.Errorf("Request %d: expected %v, got %v", i+1, tt.expectedCodes[i], code)
+				}
+			}
+		})
+	}
+}
+
+func TestRateLimiter_Stream(t *testing.T) {
+	limiter := NewRateLimiter(map[string]int{limitedMethod: 2})
+	info := &grpc.StreamServerInfo{FullMethod: limitedMethod}
+
+	calls := 0
+	handler := func(srv any, ss grpc.ServerStream) error {
+		calls++
+		return nil
+	}
+
+	for i := 0; i < 3; i++ {
+		err := limiter.Stream(nil, nil, info, handler)
+		if i < 2 && err != nil {
+			t.Errorf("Request %d: unexpected error: %v", i+1, err)
+		}
+		if i == 2 && status.Code(err) != codes.ResourceExhausted {
+			t.Errorf("Request 3: expected ResourceExhausted, got %v", err)
+		}
+	}
+
+	if calls != 2 {
+		t.Errorf("Expected the handler to run twice, got %d", calls)
+	}
+}
@@ -0,0 +1,55 @@
+package interceptor
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc"
+)
+
+// Timeout gives every request a deadline. The timeout is read for each request so it can
+// change while the server runs; a timeout of zero or less leaves requests without a deadline.
+type Timeout struct {
+	timeout func() time.Duration
+}
+
+// NewTimeout creates a timeout interceptor reading the current timeout from timeout
+func NewTimeout(timeout func() time.Duration) *Timeout {
+	return &Timeout{
+		timeout: timeout,
+	}
+}
+
+// Unary is the unary interceptor for the timeout
+func (t *Timeout) Unary(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	ctx, cancel := t.withDeadline(ctx)
+	defer cancel()
+	return handler(ctx, req)
+}
+
+// Stream is the stream interceptor for the timeout
+func (t *Timeout) Stream(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	ctx, cancel := t.withDeadline(ss.Context())
+	defer cancel()
+	return handler(srv, &contextStream{ServerStream: ss, ctx: ctx})
+}
+
+// withDeadline derives a context that expires after the current timeout
+func (t *Timeout) withDeadline(ctx context.Context) (context.Context, context.CancelFunc) {
+	timeout := t.timeout()
+	if timeout <= 0 {
+		return context.WithCancel(ctx)
+	}
+	return context.WithTimeout(ctx, timeout)
+}
+
+// contextStream is a server stream with a replaced context
+type contextStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+// Context returns the replaced context
+func (s *contextStream) Context() context.Context {
+	return s.ctx
+}
@@ -0,0 +1,79 @@
+package interceptor
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+)
+
+// fakeServerStream is a server stream that only carries a context
+type fakeServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *fakeServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func TestTimeout(t *testing.T) {
+	tests := []struct {
+		name          string
+		timeout       time.Duration
+		expectedLimit bool
+	}{
+		{name: "timeout set", timeout: time.Minute, expectedLimit: true},
+		{name: "no timeout", timeout: 0, expectedLimit: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			timeout := NewTimeout(func() time.Duration { return tt.timeout })
+
+			checkDeadline := func(ctx context.Context) {
+				deadline, ok := ctx.Deadline()
+				if ok != tt.expectedLimit {
+					t.Fatalf("Expected deadline %v, got %v", tt.expectedLimit, ok)
+				}
+				if ok && time.Until(deadline) > tt.timeout {
+					t.Errorf("Expected deadline within %v, got %v", tt.timeout, time.Until(deadline))
+				}
+			}
+
+			_, err := timeout.Unary(context.Background(), nil, &grpc.UnaryServerInfo{}, func(ctx context.Context, req any) (any, error) {
+				checkDeadline(ctx)
+				return nil, nil
+			})
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			err = timeout.Stream(nil, &fakeServerStream{ctx: context.Background()}, &grpc.StreamServerInfo{}, func(srv any, ss grpc.ServerStream) error {
+				checkDeadline(ss.Context())
+				return nil
+			})
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+		})
+	}
+
+	t.Run("timeout is read for every request", func(t *testing.T) {
+		current := time.Duration(0)
+		timeout := NewTimeout(func() time.Duration { return current })
+
+		for _, expected := range []bool{false, true} {
+			if expected {
+				current = time.Minute
+			}
+			_, _ = timeout.Unary(context.Background(), nil, &grpc.UnaryServerInfo{}, func(ctx context.Context, req any) (any, error) {
+				if _, ok := ctx.Deadline(); ok != expected {
+					t.Errorf("Expected deadline %v, got %v", expected, ok)
+				}
+				return nil, nil
+			})
+		}
+	})
+}
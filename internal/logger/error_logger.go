@@ -0,0 +1,48 @@
+package logger
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"runtime/debug"
+)
+
+// contextKey is the context key of the logger carried by a request
+type contextKey struct{}
+
+// NewContext returns a copy of ctx that carries logger, for FromContext
+func NewContext(ctx context.Context, logger *slog.Logger) context.Context {
+	return context.WithValue(ctx, contextKey{}, logger)
+}
+
+// FromContext returns the logger carried by ctx, or slog.Default() if it carries none
+func FromContext(ctx context.Context) *slog.Logger {
+	if logger, ok := ctx.Value(contextKey{}).(*slog.Logger); ok {
+		return logger
+	}
+	return slog.Default()
+}
+
+// New creates a text logger writing to w. With logErrorStacks the logger is enabled at DEBUG level,
+// so LogErrorWithStack includes call stacks; otherwise it logs from INFO level.
+func New(w io.Writer, logErrorStacks bool) *slog.Logger {
+	level := slog.LevelInfo
+	if logErrorStacks {
+		level = slog.LevelDebug
+	}
+	return slog.New(slog.NewTextHandler(w, &slog.HandlerOptions{Level: level}))
+}
+
+// LogErrorWithStack logs err at ERROR level. When logger is enabled at DEBUG level the call stack
+// of the caller is added as the "stack" attribute; otherwise only the error message is logged.
+func LogErrorWithStack(ctx context.Context, logger *slog.Logger, msg string, err error) {
+	if !logger.Enabled(ctx, slog.LevelDebug) {
+		logger.ErrorContext(ctx, msg, slog.String("error", err.Error()))
+		return
+	}
+
+	logger.ErrorContext(ctx, msg,
+		slog.String("error", err.Error()),
+		slog.String("stack", string(debug.Stack())),
+	)
+}
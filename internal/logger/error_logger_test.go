@@ -0,0 +1,50 @@
+package logger
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+	"testing"
+)
+
+func TestLogErrorWithStack(t *testing.T) {
+	tests := []struct {
+		name           string
+		logErrorStacks bool
+		expectedStack  bool
+	}{
+		{name: "stacks enabled", logErrorStacks: true, expectedStack: true},
+		{name: "stacks disabled", logErrorStacks: false, expectedStack: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var logs bytes.Buffer
+			LogErrorWithStack(context.Background(), New(&logs, tt.logErrorStacks), "request failed", errors.New("database is locked"))
+
+			output := logs.String()
+			if !strings.Contains(output, "level=ERROR") || !strings.Contains(output, `error="database is locked"`) {
+				t.Errorf("Expected the error to be logged, got %q", output)
+			}
+			if strings.Contains(output, "stack=") != tt.expectedStack {
+				t.Errorf("Expected stack %v, got %q", tt.expectedStack, output)
+			}
+			if tt.expectedStack && !strings.Contains(output, "TestLogErrorWithStack") {
+				t.Errorf("Expected the stack to name the caller, got %q", output)
+			}
+		})
+	}
+}
+
+func TestFromContext(t *testing.T) {
+	if FromContext(context.Background()) != slog.Default() {
+		t.Error("Expected the default logger without one in the context")
+	}
+
+	logger := New(&bytes.Buffer{}, false)
+	if FromContext(NewContext(context.Background(), logger)) != logger {
+		t.Error("Expected the logger carried by the context")
+	}
+}
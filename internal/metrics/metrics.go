@@ -0,0 +1,34 @@
+package metrics
+
+import (
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const path = "/metrics"
+
+var (
+	// ChunksProcessed counts the rating chunks processed by overall quality calculations
+	ChunksProcessed = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "ticket_score_chunks_processed_total",
+		Help: "Number of rating chunks processed by overall quality calculations.",
+	})
+
+	// CalculationDuration observes how long each overall quality calculation takes
+	CalculationDuration = promauto.NewHistogram(prometheus.HistogramOpts{
+		Name:    "ticket_score_calculation_duration_seconds",
+		Help:    "Duration of overall quality calculations in seconds.",
+		Buckets: prometheus.DefBuckets,
+	})
+)
+
+// NewHandler creates an HTTP handler that serves the default registry under /metrics in the
+// Prometheus text format
+func NewHandler() http.Handler {
+	mux := http.NewServeMux()
+	mux.Handle(path, promhttp.Handler())
+	return mux
+}
@@ -0,0 +1,52 @@
+package metrics
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestHandler(t *testing.T) {
+	ChunksProcessed.Inc()
+	CalculationDuration.Observe(0.5)
+
+	server := httptest.NewServer(NewHandler())
+	defer server.Close()
+
+	resp, err := http.Get(server.URL + "/metrics")
+	if err != nil {
+		t.Fatalf("Failed to get metrics: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("Expected status 200, got %d", resp.StatusCode)
+	}
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("Failed to read metrics: %v", err)
+	}
+
+	for _, name := range []string{
+		"ticket_score_chunks_processed_total",
+		"ticket_score_calculation_duration_seconds_bucket",
+		"ticket_score_calculation_duration_seconds_count",
+	} {
+		if !strings.Contains(string(body), name) {
+			t.Errorf("Expected metric %s in:\n%s", name, body)
+		}
+	}
+
+	t.Run("unknown path", func(t *testing.T) {
+		resp, err := http.Get(server.URL + "/other")
+		if err != nil {
+			t.Fatalf("Failed to get: %v", err)
+		}
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusNotFound {
+			t.Errorf("Expected status 404, got %d", resp.StatusCode)
+		}
+	})
+}
@@ -3,16 +3,20 @@ package mocks
 import (
 	"context"
 	"fmt"
+	"sort"
 	"ticket-score-service/internal/models"
 	"time"
 )
 
 type MockRatingsRepo struct {
-	Ratings       map[string][]models.Rating
-	Count         int
-	PaginationErr error
-	CountErr      error
-	Err           error
+	Ratings        map[string][]models.Rating
+	Count          int
+	PaginationErr  error
+	PaginationErrs map[string]error // per-page errors keyed by "limit:offset"
+	CountErr       error
+	Err            error
+	InsertErr      error
+	Inserted       [][]models.Rating // batches passed to BulkInsertRatings
 }
 
 func (m *MockRatingsRepo) GetByCategoryIDAndDate(ctx context.Context, categoryID int, date time.Time) ([]models.Rating, error) {
@@ -30,6 +34,46 @@ func (m *MockRatingsRepo) GetByCategoryIDAndDate(ctx context.Context, categoryID
 	return []models.Rating{}, nil
 }
 
+func (m *MockRatingsRepo) GetByCategoryIDAndDateRange(ctx context.Context, categoryID int, startDate, endDate time.Time) ([]models.Rating, error) {
+	if m.Err != nil {
+		return nil, m.Err
+	}
+
+	var results []models.Rating
+	for _, rating := range m.ratingsInRange(startDate, endDate) {
+		if rating.RatingCategoryID == categoryID {
+			results = append(results, rating)
+		}
+	}
+
+	sort.Slice(results, func(i, j int) bool {
+		return results[i].CreatedAt.Before(results[j].CreatedAt)
+	})
+
+	return results, nil
+}
+
+func (m *MockRatingsRepo) GetByCategoryID(ctx context.Context, categoryID int) ([]models.Rating, error) {
+	if m.Err != nil {
+		return nil, m.Err
+	}
+
+	var results []models.Rating
+	for _, ratings := range m.Ratings {
+		for _, rating := range ratings {
+			if rating.RatingCategoryID == categoryID {
+				results = append(results, rating)
+			}
+		}
+	}
+
+	sort.Slice(results, func(i, j int) bool {
+		return results[i].CreatedAt.Before(results[j].CreatedAt)
+	})
+
+	return results, nil
+}
+
 func (m *MockRatingsRepo) GetDistinctTicketIDsByDateRange(ctx context.Context, startDate, endDate time.Time) ([]int, error) {
 	if m.Err != nil {
 		return nil, m.Err
@@ -52,6 +96,44 @@ func (m *MockRatingsRepo) GetDistinctTicketIDsByDateRange(ctx context.Context, s
 	return ticketIDs, nil
 }
 
+func (m *MockRatingsRepo) GetByTicketID(ctx context.Context, ticketID int) ([]models.Rating, error) {
+	if m.Err != nil {
+		return nil, m.Err
+	}
+
+	var results []models.Rating
+	for _, ratings := range m.Ratings {
+		for _, rating := range ratings {
+			if rating.TicketID == ticketID {
+				results = append(results, rating)
+			}
+		}
+	}
+
+	return results, nil
+}
+
+func (m *MockRatingsRepo) GetByTicketIDBeforeTime(ctx context.Context, ticketID int, cutoff time.Time) ([]models.Rating, error) {
+	if m.Err != nil {
+		return nil, m.Err
+	}
+
+	var results []models.Rating
+	for _, ratings := range m.Ratings {
+		for _, rating := range ratings {
+			if rating.TicketID == ticketID && !rating.CreatedAt.After(cutoff) {
+				results = append(results, rating)
+			}
+		}
+	}
+
+	sort.Slice(results, func(i, j int) bool {
+		return results[i].CreatedAt.Before(results[j].CreatedAt)
+	})
+
+	return results, nil
+}
+
 func (m *MockRatingsRepo) GetByTicketIDAndCategoryID(ctx context.Context, ticketID, categoryID int) ([]models.Rating, error) {
 	if m.Err != nil {
 		return nil, m.Err
@@ -69,12 +151,127 @@ func (m *MockRatingsRepo) GetByTicketIDAndCategoryID(ctx context.Context, ticket
 	return results, nil
 }
 
+func (m *MockRatingsRepo) GetRatingsByTicketIDsAndCategoryIDs(ctx context.Context, ticketIDs []int, categoryIDs []int) (map[int]map[int][]models.Rating, error) {
+	if m.Err != nil {
+		return nil, m.Err
+	}
+
+	tickets := make(map[int]bool, len(ticketIDs))
+	for _, ticketID := range ticketIDs {
+		tickets[ticketID] = true
+	}
+	categories := make(map[int]bool, len(categoryIDs))
+	for _, categoryID := range categoryIDs {
+		categories[categoryID] = true
+	}
+
+	results := make(map[int]map[int][]models.Rating)
+	for _, ratings := range m.Ratings {
+		for _, rating := range ratings {
+			if !tickets[rating.TicketID] || !categories[rating.RatingCategoryID] {
+				continue
+			}
+			if results[rating.TicketID] == nil {
+				results[rating.TicketID] = make(map[int][]models.Rating)
+			}
+			results[rating.TicketID][rating.RatingCategoryID] = append(results[rating.TicketID][rating.RatingCategoryID], rating)
+		}
+	}
+
+	for _, byCategory := range results {
+		for _, ratings := range byCategory {
+			sort.Slice(ratings, func(i, j int) bool {
+				return ratings[i].CreatedAt.Before(ratings[j].CreatedAt)
+			})
+		}
+	}
+
+	return results, nil
+}
+
+func (m *MockRatingsRepo) GetByRevieweeIDAndCategoryID(ctx context.Context, revieweeID, categoryID int) ([]models.Rating, error) {
+	if m.Err != nil {
+		return nil, m.Err
+	}
+
+	var results []models.Rating
+	for _, ratings := range m.Ratings {
+		for _, rating := range ratings {
+			if rating.RevieweeID == revieweeID && rating.RatingCategoryID == categoryID {
+				results = append(results, rating)
+			}
+		}
+	}
+
+	return results, nil
+}
+
+func (m *MockRatingsRepo) GetByReviewerIDAndCategoryIDAndDateRange(ctx context.Context, reviewerID, categoryID int, startDate, endDate time.Time) ([]models.Rating, error) {
+	if m.Err != nil {
+		return nil, m.Err
+	}
+
+	var results []models.Rating
+	for _, rating := range m.ratingsInRange(startDate, endDate) {
+		if rating.ReviewerID == reviewerID && rating.RatingCategoryID == categoryID {
+			results = append(results, rating)
+		}
+	}
+
+	sort.Slice(results, func(i, j int) bool {
+		return results[i].CreatedAt.Before(results[j].CreatedAt)
+	})
+
+	return results, nil
+}
+
+func (m *MockRatingsRepo) GetByReviewerIDAndDateRange(ctx context.Context, reviewerID int, startDate, endDate time.Time) ([]models.Rating, error) {
+	if m.Err != nil {
+		return nil, m.Err
+	}
+
+	var results []models.Rating
+	for _, rating := range m.ratingsInRange(startDate, endDate) {
+		if rating.ReviewerID == reviewerID {
+			results = append(results, rating)
+		}
+	}
+
+	sort.Slice(results, func(i, j int) bool {
+		return results[i].CreatedAt.Before(results[j].CreatedAt)
+	})
+
+	return results, nil
+}
+
+func (m *MockRatingsRepo) GetByRevieweeIDAndDateRange(ctx context.Context, revieweeID int, startDate, endDate time.Time) ([]models.Rating, error) {
+	if m.Err != nil {
+		return nil, m.Err
+	}
+
+	var results []models.Rating
+	for _, rating := range m.ratingsInRange(startDate, endDate) {
+		if rating.RevieweeID == revieweeID {
+			results = append(results, rating)
+		}
+	}
+
+	sort.Slice(results, func(i, j int) bool {
+		return results[i].CreatedAt.Before(results[j].CreatedAt)
+	})
+
+	return results, nil
+}
+
 func (m *MockRatingsRepo) GetByDateRangePaginated(ctx context.Context, startDate, endDate time.Time, limit, offset int) ([]models.Rating, error) {
 	if m.PaginationErr != nil {
 		return nil, m.PaginationErr
 	}
 
 	key := fmt.Sprintf("%d:%d", limit, offset)
+	if err, exists := m.PaginationErrs[key]; exists {
+		return nil, err
+	}
 	if ratings, exists := m.Ratings[key]; exists {
 		return ratings, nil
 	}
@@ -87,3 +284,335 @@ func (m *MockRatingsRepo) CountByDateRange(ctx context.Context, startDate, endDa
 	}
 	return m.Count, nil
 }
+
+func (m *MockRatingsRepo) GetAverageScoreByReviewer(ctx context.Context, startDate, endDate time.Time) (map[int]float64, error) {
+	if m.Err != nil {
+		return nil, m.Err
+	}
+
+	sums := make(map[int]int)
+	counts := make(map[int]int)
+	for _, rating := range m.ratingsInRange(startDate, endDate) {
+		sums[rating.ReviewerID] += rating.Rating
+		counts[rating.ReviewerID]++
+	}
+
+	averages := make(map[int]float64)
+	for reviewerID, count := range counts {
+		averages[reviewerID] = float64(sums[reviewerID]) / float64(count)
+	}
+
+	return averages, nil
+}
+
+func (m *MockRatingsRepo) GetAverageRatingByRevieweeAndCategory(ctx context.Context, startDate, endDate time.Time) (map[int]map[int]float64, error) {
+	if m.Err != nil {
+		return nil, m.Err
+	}
+
+	type key struct{ revieweeID, categoryID int }
+	sums := make(map[key]int)
+	counts := make(map[key]int)
+	for _, rating := range m.ratingsInRange(startDate, endDate) {
+		k := key{rating.RevieweeID, rating.RatingCategoryID}
+		sums[k] += rating.Rating
+		counts[k]++
+	}
+
+	averages := make(map[int]map[int]float64)
+	for k, count := range counts {
+		if averages[k.revieweeID] == nil {
+			averages[k.revieweeID] = make(map[int]float64)
+		}
+		averages[k.revieweeID][k.categoryID] = float64(sums[k]) / float64(count)
+	}
+
+	return averages, nil
+}
+
+func (m *MockRatingsRepo) GetCountAboveThreshold(ctx context.Context, categoryID int, threshold int, startDate, endDate time.Time) (int, error) {
+	if m.CountErr != nil {
+		return 0, m.CountErr
+	}
+
+	count := 0
+	for _, rating := range m.ratingsInRange(startDate, endDate) {
+		if rating.RatingCategoryID == categoryID && rating.Rating >= threshold {
+			count++
+		}
+	}
+
+	return count, nil
+}
+
+func (m *MockRatingsRepo) GetDistinctTicketCountByCategoryIDAndDateRange(ctx context.Context, categoryID int, startDate, endDate time.Time) (int, error) {
+	if m.CountErr != nil {
+		return 0, m.CountErr
+	}
+
+	ticketIDs := make(map[int]bool)
+	for _, rating := range m.ratingsInRange(startDate, endDate) {
+		if rating.RatingCategoryID == categoryID {
+			ticketIDs[rating.TicketID] = true
+		}
+	}
+
+	return len(ticketIDs), nil
+}
+
+func (m *MockRatingsRepo) GetCountBelowThreshold(ctx context.Context, categoryID int, threshold int, startDate, endDate time.Time) (int, error) {
+	if m.CountErr != nil {
+		return 0, m.CountErr
+	}
+
+	count := 0
+	for _, rating := range m.ratingsInRange(startDate, endDate) {
+		if rating.RatingCategoryID == categoryID && rating.Rating <= threshold {
+			count++
+		}
+	}
+
+	return count, nil
+}
+
+func (m *MockRatingsRepo) GetAverageRatingPerTicket(ctx context.Context, startDate, endDate time.Time) (map[int]float64, error) {
+	if m.Err != nil {
+		return nil, m.Err
+	}
+
+	sums := make(map[int]int)
+	counts := make(map[int]int)
+	for _, rating := range m.ratingsInRange(startDate, endDate) {
+		sums[rating.TicketID] += rating.Rating
+		counts[rating.TicketID]++
+	}
+
+	averages := make(map[int]float64)
+	for ticketID, count := range counts {
+		averages[ticketID] = float64(sums[ticketID]) / float64(count)
+	}
+
+	return averages, nil
+}
+
+func (m *MockRatingsRepo) GetRatingCountPerTicket(ctx context.Context, startDate, endDate time.Time) (map[int]int, error) {
+	if m.CountErr != nil {
+		return nil, m.CountErr
+	}
+
+	counts := make(map[int]int)
+	for _, rating := range m.ratingsInRange(startDate, endDate) {
+		counts[rating.TicketID]++
+	}
+
+	return counts, nil
+}
+
+func (m *MockRatingsRepo) GetRatingCountByReviewerAndDateRange(ctx context.Context, startDate, endDate time.Time) (map[int]int, error) {
+	if m.CountErr != nil {
+		return nil, m.CountErr
+	}
+
+	counts := make(map[int]int)
+	for _, rating := range m.ratingsInRange(startDate, endDate) {
+		counts[rating.ReviewerID]++
+	}
+
+	return counts, nil
+}
+
+func (m *MockRatingsRepo) GetCountByCategoryIDAndDateRange(ctx context.Context, startDate, endDate time.Time) (map[string]int, error) {
+	if m.CountErr != nil {
+		return nil, m.CountErr
+	}
+
+	counts := make(map[string]int)
+	for _, rating := range m.ratingsInRange(startDate, endDate) {
+		counts[rating.CreatedAt.UTC().Format("2006-01-02")]++
+	}
+
+	return counts, nil
+}
+
+func (m *MockRatingsRepo) GetDistinctReviewerIDsByDateRange(ctx context.Context, startDate, endDate time.Time) ([]int, error) {
+	if m.Err != nil {
+		return nil, m.Err
+	}
+
+	reviewerIDMap := make(map[int]bool)
+	for _, rating := range m.ratingsInRange(startDate, endDate) {
+		reviewerIDMap[rating.ReviewerID] = true
+	}
+
+	var reviewerIDs []int
+	for id := range reviewerIDMap {
+		reviewerIDs = append(reviewerIDs, id)
+	}
+	sort.Ints(reviewerIDs)
+
+	return reviewerIDs, nil
+}
+
+func (m *MockRatingsRepo) BulkInsertRatings(ctx context.Context, ratings []models.Rating) error {
+	if m.InsertErr != nil {
+		return m.InsertErr
+	}
+
+	m.Inserted = append(m.Inserted, ratings)
+	return nil
+}
+
+func (m *MockRatingsRepo) GetRatingsByHourOfDay(ctx context.Context, startDate, endDate time.Time) ([]models.HourlyRatings, error) {
+	if m.Err != nil {
+		return nil, m.Err
+	}
+
+	sums := make(map[int]int)
+	counts := make(map[int]int)
+	for _, rating := range m.ratingsInRange(startDate, endDate) {
+		hour := rating.CreatedAt.UTC().Hour()
+		sums[hour] += rating.Rating
+		counts[hour]++
+	}
+
+	var hours []models.HourlyRatings
+	for hour := 0; hour < 24; hour++ {
+		if counts[hour] == 0 {
+			continue
+		}
+		hours = append(hours, models.HourlyRatings{
+			Hour:          hour,
+			Count:         counts[hour],
+			AverageRating: float64(sums[hour]) / float64(counts[hour]),
+		})
+	}
+
+	return hours, nil
+}
+
+func (m *MockRatingsRepo) GetRatingsByWeekdayAndCategoryID(ctx context.Context, categoryID int, startDate, endDate time.Time) ([]models.WeekdayRatings, error) {
+	if m.Err != nil {
+		return nil, m.Err
+	}
+
+	sums := make(map[int]int)
+	counts := make(map[int]int)
+	for _, rating := range m.ratingsInRange(startDate, endDate) {
+		if rating.RatingCategoryID != categoryID {
+			continue
+		}
+		weekday := int(rating.CreatedAt.UTC().Weekday())
+		sums[weekday] += rating.Rating
+		counts[weekday]++
+	}
+
+	var weekdays []models.WeekdayRatings
+	for weekday := 0; weekday < 7; weekday++ {
+		if counts[weekday] == 0 {
+			continue
+		}
+		weekdays = append(weekdays, models.WeekdayRatings{
+			Weekday:       weekday,
+			Count:         counts[weekday],
+			AverageRating: float64(sums[weekday]) / float64(counts[weekday]),
+		})
+	}
+
+	return weekdays, nil
+}
+
+func (m *MockRatingsRepo) GetRatingCountByDayOfWeek(ctx context.Context, startDate, endDate time.Time) ([7]int, error) {
+	var counts [7]int
+	if m.CountErr != nil {
+		return counts, m.CountErr
+	}
+
+	for _, rating := range m.ratingsInRange(startDate, endDate) {
+		counts[rating.CreatedAt.UTC().Weekday()]++
+	}
+
+	return counts, nil
+}
+
+func (m *MockRatingsRepo) GetRatingsByHourAndWeekdayAndCategoryID(ctx context.Context, categoryID int, startDate, endDate time.Time) ([]models.HourWeekdayRatings, error) {
+	if m.Err != nil {
+		return nil, m.Err
+	}
+
+	var sums, counts [7][24]int
+	for _, rating := range m.ratingsInRange(startDate, endDate) {
+		if rating.RatingCategoryID != categoryID {
+			continue
+		}
+		createdAt := rating.CreatedAt.UTC()
+		sums[createdAt.Weekday()][createdAt.Hour()] += rating.Rating
+		counts[createdAt.Weekday()][createdAt.Hour()]++
+	}
+
+	var cells []models.HourWeekdayRatings
+	for weekday := 0; weekday < 7; weekday++ {
+		for hour := 0; hour < 24; hour++ {
+			if counts[weekday][hour] == 0 {
+				continue
+			}
+			cells = append(cells, models.HourWeekdayRatings{
+				Weekday:       weekday,
+				Hour:          hour,
+				Count:         counts[weekday][hour],
+				AverageRating: float64(sums[weekday][hour]) / float64(counts[weekday][hour]),
+			})
+		}
+	}
+
+	return cells, nil
+}
+
+func (m *MockRatingsRepo) GetReviewerCountAndAverageRatingByTicket(ctx context.Context, categoryID int, startDate, endDate time.Time) ([]models.TicketReviewerRatings, error) {
+	if m.Err != nil {
+		return nil, m.Err
+	}
+
+	sums := make(map[int]int)
+	counts := make(map[int]int)
+	reviewers := make(map[int]map[int]bool)
+	for _, rating := range m.ratingsInRange(startDate, endDate) {
+		if rating.RatingCategoryID != categoryID {
+			continue
+		}
+		sums[rating.TicketID] += rating.Rating
+		counts[rating.TicketID]++
+		if reviewers[rating.TicketID] == nil {
+			reviewers[rating.TicketID] = make(map[int]bool)
+		}
+		reviewers[rating.TicketID][rating.ReviewerID] = true
+	}
+
+	tickets := make([]models.TicketReviewerRatings, 0, len(counts))
+	for ticketID, count := range counts {
+		tickets = append(tickets, models.TicketReviewerRatings{
+			TicketID:      ticketID,
+			ReviewerCount: len(reviewers[ticketID]),
+			AverageRating: float64(sums[ticketID]) / float64(count),
+		})
+	}
+	sort.Slice(tickets, func(i, j int) bool { return tickets[i].TicketID < tickets[j].TicketID })
+
+	return tickets, nil
+}
+
+// ratingsInRange returns all stored ratings created on any day from startDate to endDate inclusive
+func (m *MockRatingsRepo) ratingsInRange(startDate, endDate time.Time) []models.Rating {
+	start := time.Date(startDate.Year(), startDate.Month(), startDate.Day(), 0, 0, 0, 0, startDate.Location())
+	end := time.Date(endDate.Year(), endDate.Month(), endDate.Day(), 0, 0, 0, 0, endDate.Location()).Add(24 * time.Hour)
+
+	var results []models.Rating
+	for _, ratings := range m.Ratings {
+		for _, rating := range ratings {
+			if !rating.CreatedAt.Before(start) && rating.CreatedAt.Before(end) {
+				results = append(results, rating)
+			}
+		}
+	}
+
+	return results
+}
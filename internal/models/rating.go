@@ -11,3 +11,43 @@ type Rating struct {
 	RevieweeID       int       `json:"reviewee_id" db:"reviewee_id"`
 	CreatedAt        time.Time `json:"created_at" db:"created_at"`
 }
+
+// RatingQualityCounts holds the counts behind the rating data quality metrics of a date range
+type RatingQualityCounts struct {
+	TotalRatings      int `json:"total_ratings"`
+	RatedTickets      int `json:"rated_tickets"`
+	FullyRatedTickets int `json:"fully_rated_tickets"` // Tickets rated in every category
+	DistinctReviewers int `json:"distinct_reviewers"`
+	RatingGroups      int `json:"rating_groups"`     // Distinct (reviewer, ticket, category, day) combinations
+	DuplicatedGroups  int `json:"duplicated_groups"` // Combinations with more than one rating
+}
+
+// WeekdayRatings aggregates the ratings created on one day of the week (UTC), 0 being Sunday
+type WeekdayRatings struct {
+	Weekday       int     `json:"weekday" db:"weekday"`
+	Count         int     `json:"count" db:"count"`
+	AverageRating float64 `json:"average_rating" db:"average_rating"`
+}
+
+// HourWeekdayRatings aggregates the ratings created within one hour of one day of the week (UTC),
+// 0 being Sunday
+type HourWeekdayRatings struct {
+	Weekday       int     `json:"weekday" db:"weekday"`
+	Hour          int     `json:"hour" db:"hour"`
+	Count         int     `json:"count" db:"count"`
+	AverageRating float64 `json:"average_rating" db:"average_rating"`
+}
+
+// TicketReviewerRatings aggregates a ticket's ratings in one category
+type TicketReviewerRatings struct {
+	TicketID      int     `json:"ticket_id" db:"ticket_id"`
+	ReviewerCount int     `json:"reviewer_count" db:"reviewer_count"`
+	AverageRating float64 `json:"average_rating" db:"average_rating"`
+}
+
+// HourlyRatings aggregates the ratings created within one hour of the day (UTC)
+type HourlyRatings struct {
+	Hour          int     `json:"hour" db:"hour"`
+	Count         int     `json:"count" db:"count"`
+	AverageRating float64 `json:"average_rating" db:"average_rating"`
+}
@@ -0,0 +1,33 @@
+package models
+
+import "time"
+
+// RatingsFilter narrows a ratings query. Empty ID lists, nil ratings and zero dates
+// leave the corresponding field unfiltered; a rating bound of 0 is a bound like any other.
+type RatingsFilter struct {
+	CategoryIDs []int     `json:"category_ids"`
+	ReviewerIDs []int     `json:"reviewer_ids"`
+	RevieweeIDs []int     `json:"reviewee_ids"`
+	MinRating   *int      `json:"min_rating"`
+	MaxRating   *int      `json:"max_rating"`
+	StartDate   time.Time `json:"start_date"`
+	EndDate     time.Time `json:"end_date"`
+}
+
+// RatingsSortField is the column ratings are sorted by
+type RatingsSortField int
+
+const (
+	SortByCreatedAt RatingsSortField = iota
+	SortByRating
+	SortByTicketID
+	SortByID
+)
+
+// SortDirection is the order ratings are sorted in
+type SortDirection int
+
+const (
+	SortAscending SortDirection = iota
+	SortDescending
+)
@@ -0,0 +1,11 @@
+package models
+
+// ScoringRule caps the rating of one category when another category is rated low: if a ticket is
+// rated at most IfMaxRating in IfCategoryID, its ratings in ThenCategoryID must not exceed ThenMaxRating
+type ScoringRule struct {
+	ID             int `json:"id" db:"id"`
+	IfCategoryID   int `json:"if_category_id" db:"if_category_id"`
+	IfMaxRating    int `json:"if_max_rating" db:"if_max_rating"`
+	ThenCategoryID int `json:"then_category_id" db:"then_category_id"`
+	ThenMaxRating  int `json:"then_max_rating" db:"then_max_rating"`
+}
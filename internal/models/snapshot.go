@@ -0,0 +1,22 @@
+package models
+
+import "time"
+
+// SnapshotScore is a category's score frozen in a weekly snapshot
+type SnapshotScore struct {
+	SnapshotID   int       `json:"snapshot_id" db:"snapshot_id"`
+	WeekLabel    string    `json:"week_label" db:"week_label"`
+	CategoryID   int       `json:"category_id" db:"category_id"`
+	CategoryName string    `json:"category_name" db:"category_name"`
+	Score        string    `json:"score" db:"score"`
+	RatingCount  int       `json:"rating_count" db:"rating_count"`
+	CreatedAt    time.Time `json:"created_at" db:"created_at"`
+}
+
+// SnapshotSummary describes a weekly snapshot without its scores
+type SnapshotSummary struct {
+	SnapshotID    int       `json:"snapshot_id" db:"snapshot_id"`
+	WeekLabel     string    `json:"week_label" db:"week_label"`
+	CategoryCount int       `json:"category_count"`
+	CreatedAt     time.Time `json:"created_at" db:"created_at"`
+}
@@ -0,0 +1,95 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"ticket-score-service/internal/database"
+	"ticket-score-service/internal/models"
+)
+
+// IntegrityRepository runs consistency checks across the ratings tables
+type IntegrityRepository struct {
+	db database.WrappedDB
+}
+
+func NewIntegrityRepository(db database.WrappedDB) *IntegrityRepository {
+	return &IntegrityRepository{
+		db: db,
+	}
+}
+
+// CountOrphanedRatings counts ratings whose category does not exist
+func (r *IntegrityRepository) CountOrphanedRatings(ctx context.Context) (int, error) {
+	query := `SELECT COUNT(*) FROM ratings
+			  WHERE rating_category_id NOT IN (SELECT id FROM rating_categories)`
+
+	return r.count(ctx, query, "orphaned ratings")
+}
+
+// CountInvalidRatings counts ratings with a value outside [0, maxRating]
+func (r *IntegrityRepository) CountInvalidRatings(ctx context.Context, maxRating int) (int, error) {
+	query := `SELECT COUNT(*) FROM ratings WHERE rating < 0 OR rating > ?`
+
+	return r.count(ctx, query, "invalid ratings", maxRating)
+}
+
+// CountOrphanedTicketRefs counts the distinct tickets referenced by ratings that do not exist
+func (r *IntegrityRepository) CountOrphanedTicketRefs(ctx context.Context) (int, error) {
+	query := `SELECT COUNT(DISTINCT ticket_id) FROM ratings
+			  WHERE ticket_id NOT IN (SELECT id FROM tickets)`
+
+	return r.count(ctx, query, "orphaned ticket references")
+}
+
+// GetRatingQualityCounts counts what the rating data quality metrics are calculated from, for the
+// ratings created in a date range
+func (r *IntegrityRepository) GetRatingQualityCounts(ctx context.Context, startDate, endDate time.Time) (*models.RatingQualityCounts, error) {
+	start, end := dayRange(startDate, endDate)
+
+	totalsQuery := `SELECT COUNT(*), COUNT(DISTINCT ticket_id), COUNT(DISTINCT reviewer_id)
+					FROM ratings
+					WHERE created_at >= ? AND created_at < ?`
+
+	var counts models.RatingQualityCounts
+	if err := r.db.QueryRowContext(ctx, totalsQuery, start, end).Scan(&counts.TotalRatings, &counts.RatedTickets, &counts.DistinctReviewers); err != nil {
+		return nil, fmt.Errorf("failed to count ratings: %w", err)
+	}
+
+	fullyRatedQuery := `SELECT COUNT(*) FROM (
+						  SELECT ticket_id
+						  FROM ratings
+						  WHERE created_at >= ? AND created_at < ?
+						    AND rating_category_id IN (SELECT id FROM rating_categories)
+						  GROUP BY ticket_id
+						  HAVING COUNT(DISTINCT rating_category_id) = (SELECT COUNT(*) FROM rating_categories)
+						)`
+
+	var err error
+	if counts.FullyRatedTickets, err = r.count(ctx, fullyRatedQuery, "fully rated tickets", start, end); err != nil {
+		return nil, err
+	}
+
+	groupsQuery := `SELECT COUNT(*), COALESCE(SUM(rating_count > 1), 0) FROM (
+					  SELECT COUNT(*) AS rating_count
+					  FROM ratings
+					  WHERE created_at >= ? AND created_at < ?
+					  GROUP BY reviewer_id, ticket_id, rating_category_id, strftime('%Y-%m-%d', created_at)
+					)`
+
+	if err := r.db.QueryRowContext(ctx, groupsQuery, start, end).Scan(&counts.RatingGroups, &counts.DuplicatedGroups); err != nil {
+		return nil, fmt.Errorf("failed to count duplicate ratings: %w", err)
+	}
+
+	return &counts, nil
+}
+
+// count runs a single-value COUNT query
+func (r *IntegrityRepository) count(ctx context.Context, query, what string, args ...any) (int, error) {
+	var count int
+	if err := r.db.QueryRowContext(ctx, query, args...).Scan(&count); err != nil {
+		return 0, fmt.Errorf("failed to count %s: %w", what, err)
+	}
+	return count, nil
+}
@@ -3,21 +3,53 @@ package repository
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
+	"sync"
 
+	"ticket-score-service/internal/database"
 	"ticket-score-service/internal/models"
 )
 
+// ErrCategoryNotFound is returned when a rating category ID does not exist
+var ErrCategoryNotFound = errors.New("rating category not found")
+
 type RatingCategoryRepository struct {
-	db *sql.DB
+	db database.WrappedDB
+
+	// byID caches categories found by GetByID for the lifetime of the repository
+	byID sync.Map
 }
 
-func NewRatingCategoryRepository(db *sql.DB) *RatingCategoryRepository {
+func NewRatingCategoryRepository(db database.WrappedDB) *RatingCategoryRepository {
 	return &RatingCategoryRepository{
 		db: db,
 	}
 }
 
+// GetByID gets a rating category by ID. Found categories are cached; unknown IDs are looked up
+// again on every call.
+func (r *RatingCategoryRepository) GetByID(ctx context.Context, id int) (*models.RatingCategory, error) {
+	if cached, ok := r.byID.Load(id); ok {
+		category := cached.(models.RatingCategory)
+		return &category, nil
+	}
+
+	query := `SELECT id, name, weight FROM rating_categories WHERE id = ?`
+
+	var category models.RatingCategory
+	err := r.db.QueryRowContext(ctx, query, id).Scan(&category.ID, &category.Name, &category.Weight)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("%w: %d", ErrCategoryNotFound, id)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to query rating category: %w", err)
+	}
+
+	r.byID.Store(id, category)
+	return &category, nil
+}
+
 func (r *RatingCategoryRepository) GetAll(ctx context.Context) ([]models.RatingCategory, error) {
 	query := `SELECT id, name, weight FROM rating_categories ORDER BY id`
 
@@ -42,3 +74,41 @@ func (r *RatingCategoryRepository) GetAll(ctx context.Context) ([]models.RatingC
 
 	return categories, nil
 }
+
+// GetAllPaginated gets a page of rating categories ordered by ID
+func (r *RatingCategoryRepository) GetAllPaginated(ctx context.Context, limit, offset int) ([]models.RatingCategory, error) {
+	query := `SELECT id, name, weight FROM rating_categories ORDER BY id LIMIT ? OFFSET ?`
+
+	rows, err := r.db.QueryContext(ctx, query, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query paginated rating categories: %w", err)
+	}
+	defer rows.Close()
+
+	var categories []models.RatingCategory
+	for rows.Next() {
+		var category models.RatingCategory
+		if err := rows.Scan(&category.ID, &category.Name, &category.Weight); err != nil {
+			return nil, fmt.Errorf("failed to scan rating category: %w", err)
+		}
+		categories = append(categories, category)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+
+	return categories, nil
+}
+
+// Count counts all rating categories
+func (r *RatingCategoryRepository) Count(ctx context.Context) (int, error) {
+	query := `SELECT COUNT(*) FROM rating_categories`
+
+	var count int
+	if err := r.db.QueryRowContext(ctx, query).Scan(&count); err != nil {
+		return 0, fmt.Errorf("failed to count rating categories: %w", err)
+	}
+
+	return count, nil
+}
@@ -0,0 +1,129 @@
+package repository_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"testing"
+
+	"ticket-score-service/internal/database"
+	"ticket-score-service/internal/models"
+	"ticket-score-service/internal/repository"
+	"ticket-score-service/internal/testutil"
+)
+
+func TestRatingCategoryRepository_GetAllPaginated(t *testing.T) {
+	db := testutil.NewTestDB(t)
+	repo := repository.NewRatingCategoryRepository(db)
+
+	categories := make([]models.RatingCategory, 5)
+	for i := range categories {
+		// Inserted out of ID order to check the pages are ordered by ID
+		id := len(categories) - i
+		categories[i] = models.RatingCategory{ID: id, Name: fmt.Sprintf("Category %d", id), Weight: 1}
+	}
+	testutil.SeedTestData(t, db, nil, categories)
+
+	tests := []struct {
+		name     string
+		limit    int
+		offset   int
+		expected []int
+	}{
+		{name: "first page", limit: 2, offset: 0, expected: []int{1, 2}},
+		{name: "middle page", limit: 2, offset: 2, expected: []int{3, 4}},
+		{name: "partial last page", limit: 2, offset: 4, expected: []int{5}},
+		{name: "past the end", limit: 2, offset: 5, expected: []int{}},
+		{name: "page larger than table", limit: 10, offset: 0, expected: []int{1, 2, 3, 4, 5}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			page, err := repo.GetAllPaginated(context.Background(), tt.limit, tt.offset)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			ids := make([]int, len(page))
+			for i, category := range page {
+				ids[i] = category.ID
+			}
+			assertIDs(t, "category", tt.expected, ids)
+		})
+	}
+
+	count, err := repo.Count(context.Background())
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if count != len(categories) {
+		t.Errorf("Expected %d categories, got %d", len(categories), count)
+	}
+}
+
+// countingDB counts single-row queries so tests can tell cached lookups from database hits
+type countingDB struct {
+	*database.DB
+	queries atomic.Int32
+}
+
+func (c *countingDB) QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row {
+	c.queries.Add(1)
+	return c.DB.QueryRowContext(ctx, query, args...)
+}
+
+func TestRatingCategoryRepository_GetByID(t *testing.T) {
+	db := testutil.NewTestDB(t)
+	testutil.SeedTestData(t, db, nil, []models.RatingCategory{{ID: 1, Name: "Spelling", Weight: 1}})
+	counter := &countingDB{DB: db}
+	repo := repository.NewRatingCategoryRepository(counter)
+
+	t.Run("cache miss", func(t *testing.T) {
+		category, err := repo.GetByID(context.Background(), 1)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if *category != (models.RatingCategory{ID: 1, Name: "Spelling", Weight: 1}) {
+			t.Errorf("Unexpected category %+v", *category)
+		}
+		if queries := counter.queries.Load(); queries != 1 {
+			t.Errorf("Expected 1 query, got %d", queries)
+		}
+	})
+
+	t.Run("cache hit", func(t *testing.T) {
+		category, err := repo.GetByID(context.Background(), 1)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if category.Name != "Spelling" {
+			t.Errorf("Expected Spelling, got %s", category.Name)
+		}
+		if queries := counter.queries.Load(); queries != 1 {
+			t.Errorf("Expected the cached category to be used, got %d queries", queries)
+		}
+
+		// Callers can't change the cached category
+		category.Name = "Changed"
+		if again, _ := repo.GetByID(context.Background(), 1); again.Name != "Spelling" {
+			t.Errorf("Expected the cache to be unaffected, got %s", again.Name)
+		}
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		if _, err := repo.GetByID(context.Background(), 2); !errors.Is(err, repository.ErrCategoryNotFound) {
+			t.Fatalf("Expected ErrCategoryNotFound, got %v", err)
+		}
+
+		// Unknown IDs are not cached, so a category added later is found
+		testutil.SeedTestData(t, db, nil, []models.RatingCategory{{ID: 2, Name: "Grammar", Weight: 0.7}})
+		category, err := repo.GetByID(context.Background(), 2)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if category.Name != "Grammar" {
+			t.Errorf("Expected Grammar, got %s", category.Name)
+		}
+	})
+}
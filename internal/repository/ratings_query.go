@@ -0,0 +1,129 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"ticket-score-service/internal/models"
+)
+
+// sortColumns maps the supported sort fields to their columns
+var sortColumns = map[models.RatingsSortField]string{
+	models.SortByCreatedAt: "created_at",
+	models.SortByRating:    "rating",
+	models.SortByTicketID:  "ticket_id",
+	models.SortByID:        "id",
+}
+
+// ratingsQuery builds a parameterized WHERE clause; values are only ever passed as args
+type ratingsQuery struct {
+	conditions []string
+	args       []any
+}
+
+// newRatingsQuery builds the conditions for every field set in filter
+func newRatingsQuery(filter models.RatingsFilter) *ratingsQuery {
+	q := &ratingsQuery{}
+	q.whereIn("rating_category_id", filter.CategoryIDs)
+	q.whereIn("reviewer_id", filter.ReviewerIDs)
+	q.whereIn("reviewee_id", filter.RevieweeIDs)
+	if filter.MinRating != nil {
+		q.where("rating >= ?", *filter.MinRating)
+	}
+	if filter.MaxRating != nil {
+		q.where("rating <= ?", *filter.MaxRating)
+	}
+	if !filter.StartDate.IsZero() {
+		start := time.Date(filter.StartDate.Year(), filter.StartDate.Month(), filter.StartDate.Day(), 0, 0, 0, 0, filter.StartDate.Location())
+		q.where("created_at >= ?", start)
+	}
+	if !filter.EndDate.IsZero() {
+		_, end := dayRange(filter.EndDate, filter.EndDate)
+		q.where("created_at < ?", end)
+	}
+	return q
+}
+
+// where adds a condition with its placeholder values
+func (q *ratingsQuery) where(condition string, args ...any) {
+	q.conditions = append(q.conditions, condition)
+	q.args = append(q.args, args...)
+}
+
+// whereIn adds a "column IN (...)" condition unless ids is empty
+func (q *ratingsQuery) whereIn(column string, ids []int) {
+	if len(ids) == 0 {
+		return
+	}
+	placeholders := make([]string, len(ids))
+	args := make([]any, len(ids))
+	for i, id := range ids {
+		placeholders[i] = "?"
+		args[i] = id
+	}
+	q.where(fmt.Sprintf("%s IN (%s)", column, strings.Join(placeholders, ", ")), args...)
+}
+
+// clause returns the WHERE clause, or an empty string without conditions
+func (q *ratingsQuery) clause() string {
+	if len(q.conditions) == 0 {
+		return ""
+	}
+	return "WHERE " + strings.Join(q.conditions, " AND ")
+}
+
+// GetFilteredRatings gets a page of the ratings matching filter, sorted by field and
+// then by ID so pages are stable
+func (r *RatingsRepository) GetFilteredRatings(ctx context.Context, filter models.RatingsFilter, field models.RatingsSortField, direction models.SortDirection, limit, offset int) ([]models.Rating, error) {
+	column, ok := sortColumns[field]
+	if !ok {
+		return nil, fmt.Errorf("unsupported sort field %d", field)
+	}
+	order := "ASC"
+	if direction == models.SortDescending {
+		order = "DESC"
+	}
+
+	q := newRatingsQuery(filter)
+	query := fmt.Sprintf(`SELECT id, rating, ticket_id, rating_category_id, reviewer_id, reviewee_id, created_at
+			  FROM ratings
+			  %s
+			  ORDER BY %s %s, id %s
+			  LIMIT ? OFFSET ?`, q.clause(), column, order, order)
+
+	rows, err := r.db.QueryContext(ctx, query, append(q.args, limit, offset)...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query filtered ratings: %w", err)
+	}
+	defer rows.Close()
+
+	var ratings []models.Rating
+	for rows.Next() {
+		var rating models.Rating
+		if err := rows.Scan(&rating.ID, &rating.Rating, &rating.TicketID, &rating.RatingCategoryID, &rating.ReviewerID, &rating.RevieweeID, &rating.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan rating: %w", err)
+		}
+		ratings = append(ratings, rating)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+
+	return ratings, nil
+}
+
+// CountFilteredRatings counts the ratings matching filter
+func (r *RatingsRepository) CountFilteredRatings(ctx context.Context, filter models.RatingsFilter) (int, error) {
+	q := newRatingsQuery(filter)
+	query := fmt.Sprintf(`SELECT COUNT(*) FROM ratings %s`, q.clause())
+
+	var count int
+	if err := r.db.QueryRowContext(ctx, query, q.args...).Scan(&count); err != nil {
+		return 0, fmt.Errorf("failed to count filtered ratings: %w", err)
+	}
+
+	return count, nil
+}
@@ -0,0 +1,109 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"ticket-score-service/internal/models"
+	"ticket-score-service/internal/repository"
+	"ticket-score-service/internal/testutil"
+)
+
+func TestRatingsRepository_GetFilteredRatings(t *testing.T) {
+	db := testutil.NewTestDB(t)
+	repo := repository.NewRatingsRepository(db)
+
+	day := time.Date(2019, 10, 1, 0, 0, 0, 0, time.UTC)
+	testutil.SeedTestData(t, db, []models.Rating{
+		{ID: 1, Rating: 5, TicketID: 10, RatingCategoryID: 1, ReviewerID: 1, RevieweeID: 7, CreatedAt: day.Add(1 * time.Hour)},
+		{ID: 2, Rating: 2, TicketID: 11, RatingCategoryID: 2, ReviewerID: 1, RevieweeID: 8, CreatedAt: day.Add(26 * time.Hour)},
+		{ID: 3, Rating: 4, TicketID: 12, RatingCategoryID: 1, ReviewerID: 2, RevieweeID: 8, CreatedAt: day.Add(50 * time.Hour)},
+		{ID: 4, Rating: 1, TicketID: 13, RatingCategoryID: 3, ReviewerID: 3, RevieweeID: 7, CreatedAt: day.Add(74 * time.Hour)},
+		{ID: 5, Rating: 3, TicketID: 14, RatingCategoryID: 2, ReviewerID: 2, RevieweeID: 9, CreatedAt: day.Add(98 * time.Hour)},
+	}, nil)
+
+	rating := func(value int) *int { return &value }
+
+	tests := []struct {
+		name      string
+		filter    models.RatingsFilter
+		field     models.RatingsSortField
+		direction models.SortDirection
+		limit     int
+		offset    int
+		expected  []int
+	}{
+		{name: "no filter", expected: []int{1, 2, 3, 4, 5}},
+		{name: "category IDs", filter: models.RatingsFilter{CategoryIDs: []int{1, 3}}, expected: []int{1, 3, 4}},
+		{name: "reviewer IDs", filter: models.RatingsFilter{ReviewerIDs: []int{2}}, expected: []int{3, 5}},
+		{name: "reviewee IDs", filter: models.RatingsFilter{RevieweeIDs: []int{7, 9}}, expected: []int{1, 4, 5}},
+		{name: "min rating", filter: models.RatingsFilter{MinRating: rating(4)}, expected: []int{1, 3}},
+		{name: "max rating", filter: models.RatingsFilter{MaxRating: rating(2)}, expected: []int{2, 4}},
+		{name: "min rating of 0", filter: models.RatingsFilter{MinRating: rating(0)}, expected: []int{1, 2, 3, 4, 5}},
+		{name: "max rating of 0", filter: models.RatingsFilter{MaxRating: rating(0)}, expected: nil},
+		{name: "start date", filter: models.RatingsFilter{StartDate: day.AddDate(0, 0, 3)}, expected: []int{4, 5}},
+		{name: "end date includes the whole day", filter: models.RatingsFilter{EndDate: day.AddDate(0, 0, 1)}, expected: []int{1, 2}},
+		{
+			name:     "date range",
+			filter:   models.RatingsFilter{StartDate: day.AddDate(0, 0, 1), EndDate: day.AddDate(0, 0, 3)},
+			expected: []int{2, 3, 4},
+		},
+		{
+			name:     "categories and rating range",
+			filter:   models.RatingsFilter{CategoryIDs: []int{1, 2}, MinRating: rating(3), MaxRating: rating(4)},
+			expected: []int{3, 5},
+		},
+		{
+			name: "every field",
+			filter: models.RatingsFilter{
+				CategoryIDs: []int{1, 2},
+				ReviewerIDs: []int{1, 2},
+				RevieweeIDs: []int{8},
+				MinRating:   rating(2),
+				MaxRating:   rating(4),
+				StartDate:   day,
+				EndDate:     day.AddDate(0, 0, 2),
+			},
+			expected: []int{2, 3},
+		},
+		{name: "no matches", filter: models.RatingsFilter{CategoryIDs: []int{1}, ReviewerIDs: []int{3}}, expected: nil},
+		{name: "sort by rating descending", field: models.SortByRating, direction: models.SortDescending, expected: []int{1, 3, 5, 2, 4}},
+		{name: "sort by ticket ID descending", field: models.SortByTicketID, direction: models.SortDescending, expected: []int{5, 4, 3, 2, 1}},
+		{name: "page", field: models.SortByID, limit: 2, offset: 2, expected: []int{3, 4}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			limit := tt.limit
+			if limit == 0 {
+				limit = 100
+			}
+
+			ratings, err := repo.GetFilteredRatings(context.Background(), tt.filter, tt.field, tt.direction, limit, tt.offset)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			ids := make([]int, len(ratings))
+			for i, rating := range ratings {
+				ids[i] = rating.ID
+			}
+			assertIDs(t, "rating", tt.expected, ids)
+
+			if tt.limit != 0 {
+				return
+			}
+			count, err := repo.CountFilteredRatings(context.Background(), tt.filter)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if count != len(tt.expected) {
+				t.Errorf("Expected count %d, got %d", len(tt.expected), count)
+			}
+		})
+	}
+
+	if _, err := repo.GetFilteredRatings(context.Background(), models.RatingsFilter{}, models.RatingsSortField(99), models.SortAscending, 10, 0); err == nil {
+		t.Error("Expected error for an unsupported sort field")
+	}
+}
@@ -4,21 +4,37 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"strings"
 	"time"
 
+	"ticket-score-service/internal/database"
 	"ticket-score-service/internal/models"
 )
 
+// bulkInsertChunkSize is the number of ratings inserted per INSERT statement
+const bulkInsertChunkSize = 500
+
+// maxQueryParams is the most placeholders SQLite accepts in one statement
+const maxQueryParams = 999
+
 type RatingsRepository struct {
-	db *sql.DB
+	db database.WrappedDB
 }
 
-func NewRatingsRepository(db *sql.DB) *RatingsRepository {
+func NewRatingsRepository(db database.WrappedDB) *RatingsRepository {
 	return &RatingsRepository{
 		db: db,
 	}
 }
 
+// dayRange expands a date range to cover full days: from the start of startDate
+// up to (but excluding) the start of the day after endDate
+func dayRange(startDate, endDate time.Time) (time.Time, time.Time) {
+	start := time.Date(startDate.Year(), startDate.Month(), startDate.Day(), 0, 0, 0, 0, startDate.Location())
+	end := time.Date(endDate.Year(), endDate.Month(), endDate.Day(), 0, 0, 0, 0, endDate.Location()).Add(24 * time.Hour)
+	return start, end
+}
+
 func (r *RatingsRepository) GetByCategoryIDAndDate(ctx context.Context, categoryID int, date time.Time) ([]models.Rating, error) {
 	startOfDay := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, date.Location())
 	endOfDay := startOfDay.Add(24 * time.Hour)
@@ -50,6 +66,66 @@ func (r *RatingsRepository) GetByCategoryIDAndDate(ctx context.Context, category
 	return ratings, nil
 }
 
+// GetByCategoryIDAndDateRange gets all ratings in a category for every day from startDate to endDate
+func (r *RatingsRepository) GetByCategoryIDAndDateRange(ctx context.Context, categoryID int, startDate, endDate time.Time) ([]models.Rating, error) {
+	start, end := dayRange(startDate, endDate)
+
+	query := `SELECT id, rating, ticket_id, rating_category_id, reviewer_id, reviewee_id, created_at
+			  FROM ratings
+			  WHERE rating_category_id = ? AND created_at >= ? AND created_at < ?
+			  ORDER BY created_at`
+
+	rows, err := r.db.QueryContext(ctx, query, categoryID, start, end)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query ratings: %w", err)
+	}
+	defer rows.Close()
+
+	var ratings []models.Rating
+	for rows.Next() {
+		var rating models.Rating
+		if err := rows.Scan(&rating.ID, &rating.Rating, &rating.TicketID, &rating.RatingCategoryID, &rating.ReviewerID, &rating.RevieweeID, &rating.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan rating: %w", err)
+		}
+		ratings = append(ratings, rating)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+
+	return ratings, nil
+}
+
+// GetByCategoryID gets every rating ever given in a category, ordered by creation time
+func (r *RatingsRepository) GetByCategoryID(ctx context.Context, categoryID int) ([]models.Rating, error) {
+	query := `SELECT id, rating, ticket_id, rating_category_id, reviewer_id, reviewee_id, created_at
+			  FROM ratings
+			  WHERE rating_category_id = ?
+			  ORDER BY created_at`
+
+	rows, err := r.db.QueryContext(ctx, query, categoryID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query ratings: %w", err)
+	}
+	defer rows.Close()
+
+	var ratings []models.Rating
+	for rows.Next() {
+		var rating models.Rating
+		if err := rows.Scan(&rating.ID, &rating.Rating, &rating.TicketID, &rating.RatingCategoryID, &rating.ReviewerID, &rating.RevieweeID, &rating.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan rating: %w", err)
+		}
+		ratings = append(ratings, rating)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+
+	return ratings, nil
+}
+
 func (r *RatingsRepository) GetByTicketID(ctx context.Context, ticketID int) ([]models.Rating, error) {
 	query := `SELECT id, rating, ticket_id, rating_category_id, reviewer_id, reviewee_id, created_at
 			  FROM ratings
@@ -78,6 +154,35 @@ func (r *RatingsRepository) GetByTicketID(ctx context.Context, ticketID int) ([]
 	return ratings, nil
 }
 
+// GetByTicketIDBeforeTime gets a ticket's ratings created at or before cutoff, oldest first
+func (r *RatingsRepository) GetByTicketIDBeforeTime(ctx context.Context, ticketID int, cutoff time.Time) ([]models.Rating, error) {
+	query := `SELECT id, rating, ticket_id, rating_category_id, reviewer_id, reviewee_id, created_at
+			  FROM ratings
+			  WHERE ticket_id = ? AND created_at <= ?
+			  ORDER BY created_at`
+
+	rows, err := r.db.QueryContext(ctx, query, ticketID, cutoff)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query ratings: %w", err)
+	}
+	defer rows.Close()
+
+	var ratings []models.Rating
+	for rows.Next() {
+		var rating models.Rating
+		if err := rows.Scan(&rating.ID, &rating.Rating, &rating.TicketID, &rating.RatingCategoryID, &rating.ReviewerID, &rating.RevieweeID, &rating.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan rating: %w", err)
+		}
+		ratings = append(ratings, rating)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+
+	return ratings, nil
+}
+
 func (r *RatingsRepository) GetByTicketIDAndCategoryID(ctx context.Context, ticketID, categoryID int) ([]models.Rating, error) {
 	query := `SELECT id, rating, ticket_id, rating_category_id, reviewer_id, reviewee_id, created_at
 			  FROM ratings
@@ -106,13 +211,229 @@ func (r *RatingsRepository) GetByTicketIDAndCategoryID(ctx context.Context, tick
 	return ratings, nil
 }
 
+// GetRatingsByTicketIDsAndCategoryIDs gets the ratings of many tickets in the given categories, grouped by
+// ticket ID and then category ID, each ordered by creation time. Ticket IDs are queried in batches so no
+// statement has more than maxQueryParams placeholders.
+func (r *RatingsRepository) GetRatingsByTicketIDsAndCategoryIDs(ctx context.Context, ticketIDs []int, categoryIDs []int) (map[int]map[int][]models.Rating, error) {
+	results := make(map[int]map[int][]models.Rating)
+	if len(ticketIDs) == 0 || len(categoryIDs) == 0 {
+		return results, nil
+	}
+
+	batchSize := maxQueryParams - len(categoryIDs)
+	if batchSize <= 0 {
+		return nil, fmt.Errorf("too many category IDs: %d", len(categoryIDs))
+	}
+
+	for start := 0; start < len(ticketIDs); start += batchSize {
+		end := min(start+batchSize, len(ticketIDs))
+
+		q := &ratingsQuery{}
+		q.whereIn("ticket_id", ticketIDs[start:end])
+		q.whereIn("rating_category_id", categoryIDs)
+
+		query := `SELECT id, rating, ticket_id, rating_category_id, reviewer_id, reviewee_id, created_at
+				  FROM ratings
+				  ` + q.clause() + `
+				  ORDER BY created_at, id`
+
+		if err := r.queryRatingsInto(ctx, query, q.args, results); err != nil {
+			return nil, err
+		}
+	}
+
+	return results, nil
+}
+
+// queryRatingsInto runs a ratings query and adds each row to results by ticket ID and category ID
+func (r *RatingsRepository) queryRatingsInto(ctx context.Context, query string, args []any, results map[int]map[int][]models.Rating) error {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to query ratings: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var rating models.Rating
+		if err := rows.Scan(&rating.ID, &rating.Rating, &rating.TicketID, &rating.RatingCategoryID, &rating.ReviewerID, &rating.RevieweeID, &rating.CreatedAt); err != nil {
+			return fmt.Errorf("failed to scan rating: %w", err)
+		}
+		if results[rating.TicketID] == nil {
+			results[rating.TicketID] = make(map[int][]models.Rating)
+		}
+		results[rating.TicketID][rating.RatingCategoryID] = append(results[rating.TicketID][rating.RatingCategoryID], rating)
+	}
+
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("rows iteration error: %w", err)
+	}
+
+	return nil
+}
+
+// GetByReviewerIDAndTicketID gets every rating a reviewer gave a ticket, ordered by creation time
+func (r *RatingsRepository) GetByReviewerIDAndTicketID(ctx context.Context, reviewerID, ticketID int) ([]models.Rating, error) {
+	query := `SELECT id, rating, ticket_id, rating_category_id, reviewer_id, reviewee_id, created_at
+			  FROM ratings
+			  WHERE reviewer_id = ? AND ticket_id = ?
+			  ORDER BY created_at`
+
+	rows, err := r.db.QueryContext(ctx, query, reviewerID, ticketID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query ratings: %w", err)
+	}
+	defer rows.Close()
+
+	var ratings []models.Rating
+	for rows.Next() {
+		var rating models.Rating
+		if err := rows.Scan(&rating.ID, &rating.Rating, &rating.TicketID, &rating.RatingCategoryID, &rating.ReviewerID, &rating.RevieweeID, &rating.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan rating: %w", err)
+		}
+		ratings = append(ratings, rating)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+
+	return ratings, nil
+}
+
+// GetByRevieweeIDAndCategoryID gets all ratings a reviewee received in a category, ordered by creation time
+func (r *RatingsRepository) GetByRevieweeIDAndCategoryID(ctx context.Context, revieweeID, categoryID int) ([]models.Rating, error) {
+	query := `SELECT id, rating, ticket_id, rating_category_id, reviewer_id, reviewee_id, created_at
+			  FROM ratings
+			  WHERE reviewee_id = ? AND rating_category_id = ?
+			  ORDER BY created_at`
+
+	rows, err := r.db.QueryContext(ctx, query, revieweeID, categoryID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query ratings: %w", err)
+	}
+	defer rows.Close()
+
+	var ratings []models.Rating
+	for rows.Next() {
+		var rating models.Rating
+		if err := rows.Scan(&rating.ID, &rating.Rating, &rating.TicketID, &rating.RatingCategoryID, &rating.ReviewerID, &rating.RevieweeID, &rating.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan rating: %w", err)
+		}
+		ratings = append(ratings, rating)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+
+	return ratings, nil
+}
+
+// GetByReviewerIDAndCategoryIDAndDateRange gets the ratings a reviewer gave in a category for every
+// day from startDate to endDate, ordered by creation time
+func (r *RatingsRepository) GetByReviewerIDAndCategoryIDAndDateRange(ctx context.Context, reviewerID, categoryID int, startDate, endDate time.Time) ([]models.Rating, error) {
+	start, end := dayRange(startDate, endDate)
+
+	query := `SELECT id, rating, ticket_id, rating_category_id, reviewer_id, reviewee_id, created_at
+			  FROM ratings
+			  WHERE reviewer_id = ? AND rating_category_id = ? AND created_at >= ? AND created_at < ?
+			  ORDER BY created_at`
+
+	rows, err := r.db.QueryContext(ctx, query, reviewerID, categoryID, start, end)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query ratings: %w", err)
+	}
+	defer rows.Close()
+
+	var ratings []models.Rating
+	for rows.Next() {
+		var rating models.Rating
+		if err := rows.Scan(&rating.ID, &rating.Rating, &rating.TicketID, &rating.RatingCategoryID, &rating.ReviewerID, &rating.RevieweeID, &rating.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan rating: %w", err)
+		}
+		ratings = append(ratings, rating)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+
+	return ratings, nil
+}
+
+// GetByReviewerIDAndDateRange gets the ratings a reviewer gave in any category for every day from
+// startDate to endDate, ordered by creation time
+func (r *RatingsRepository) GetByReviewerIDAndDateRange(ctx context.Context, reviewerID int, startDate, endDate time.Time) ([]models.Rating, error) {
+	start, end := dayRange(startDate, endDate)
+
+	query := `SELECT id, rating, ticket_id, rating_category_id, reviewer_id, reviewee_id, created_at
+			  FROM ratings
+			  WHERE reviewer_id = ? AND created_at >= ? AND created_at < ?
+			  ORDER BY created_at`
+
+	rows, err := r.db.QueryContext(ctx, query, reviewerID, start, end)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query ratings: %w", err)
+	}
+	defer rows.Close()
+
+	var ratings []models.Rating
+	for rows.Next() {
+		var rating models.Rating
+		if err := rows.Scan(&rating.ID, &rating.Rating, &rating.TicketID, &rating.RatingCategoryID, &rating.ReviewerID, &rating.RevieweeID, &rating.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan rating: %w", err)
+		}
+		ratings = append(ratings, rating)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+
+	return ratings, nil
+}
+
+// GetByRevieweeIDAndDateRange gets the ratings a reviewee received in any category for every day
+// from startDate to endDate, ordered by creation time
+func (r *RatingsRepository) GetByRevieweeIDAndDateRange(ctx context.Context, revieweeID int, startDate, endDate time.Time) ([]models.Rating, error) {
+	start, end := dayRange(startDate, endDate)
+
+	query := `SELECT id, rating, ticket_id, rating_category_id, reviewer_id, reviewee_id, created_at
+			  FROM ratings
+			  WHERE reviewee_id = ? AND created_at >= ? AND created_at < ?
+			  ORDER BY created_at`
+
+	rows, err := r.db.QueryContext(ctx, query, revieweeID, start, end)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query ratings: %w", err)
+	}
+	defer rows.Close()
+
+	var ratings []models.Rating
+	for rows.Next() {
+		var rating models.Rating
+		if err := rows.Scan(&rating.ID, &rating.Rating, &rating.TicketID, &rating.RatingCategoryID, &rating.ReviewerID, &rating.RevieweeID, &rating.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan rating: %w", err)
+		}
+		ratings = append(ratings, rating)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+
+	return ratings, nil
+}
+
 func (r *RatingsRepository) GetDistinctTicketIDsByDateRange(ctx context.Context, startDate, endDate time.Time) ([]int, error) {
+	start, end := dayRange(startDate, endDate)
+
 	query := `SELECT DISTINCT ticket_id
 			  FROM ratings
 			  WHERE created_at >= ? AND created_at < ?
 			  ORDER BY ticket_id`
 
-	rows, err := r.db.QueryContext(ctx, query, startDate, endDate)
+	rows, err := r.db.QueryContext(ctx, query, start, end)
 	if err != nil {
 		return nil, fmt.Errorf("failed to query distinct ticket IDs: %w", err)
 	}
@@ -176,3 +497,523 @@ func (r *RatingsRepository) CountByDateRange(ctx context.Context, startDate, end
 
 	return count, nil
 }
+
+// GetAverageScoreByReviewer gets the average rating given by each reviewer in a date range
+func (r *RatingsRepository) GetAverageScoreByReviewer(ctx context.Context, startDate, endDate time.Time) (map[int]float64, error) {
+	start, end := dayRange(startDate, endDate)
+
+	query := `SELECT reviewer_id, AVG(rating)
+			  FROM ratings
+			  WHERE created_at >= ? AND created_at < ?
+			  GROUP BY reviewer_id`
+
+	rows, err := r.db.QueryContext(ctx, query, start, end)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query reviewer averages: %w", err)
+	}
+	defer rows.Close()
+
+	averages := make(map[int]float64)
+	for rows.Next() {
+		var reviewerID int
+		var average float64
+		if err := rows.Scan(&reviewerID, &average); err != nil {
+			return nil, fmt.Errorf("failed to scan reviewer average: %w", err)
+		}
+		averages[reviewerID] = average
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+
+	return averages, nil
+}
+
+// GetAverageRatingByRevieweeAndCategory gets the average rating each reviewee received in each
+// category in a date range, keyed by reviewee ID then category ID
+func (r *RatingsRepository) GetAverageRatingByRevieweeAndCategory(ctx context.Context, startDate, endDate time.Time) (map[int]map[int]float64, error) {
+	start, end := dayRange(startDate, endDate)
+
+	query := `SELECT reviewee_id, rating_category_id, AVG(rating)
+			  FROM ratings
+			  WHERE created_at >= ? AND created_at < ?
+			  GROUP BY reviewee_id, rating_category_id`
+
+	rows, err := r.db.QueryContext(ctx, query, start, end)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query reviewee category averages: %w", err)
+	}
+	defer rows.Close()
+
+	averages := make(map[int]map[int]float64)
+	for rows.Next() {
+		var revieweeID, categoryID int
+		var average float64
+		if err := rows.Scan(&revieweeID, &categoryID, &average); err != nil {
+			return nil, fmt.Errorf("failed to scan reviewee category average: %w", err)
+		}
+		if averages[revieweeID] == nil {
+			averages[revieweeID] = make(map[int]float64)
+		}
+		averages[revieweeID][categoryID] = average
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+
+	return averages, nil
+}
+
+// GetCountAboveThreshold counts ratings in a category at or above the threshold for a date range
+func (r *RatingsRepository) GetCountAboveThreshold(ctx context.Context, categoryID int, threshold int, startDate, endDate time.Time) (int, error) {
+	start, end := dayRange(startDate, endDate)
+
+	query := `SELECT COUNT(*) FROM ratings
+			  WHERE rating_category_id = ? AND rating >= ? AND created_at >= ? AND created_at < ?`
+
+	var count int
+	err := r.db.QueryRowContext(ctx, query, categoryID, threshold, start, end).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count ratings above threshold: %w", err)
+	}
+
+	return count, nil
+}
+
+// GetDistinctTicketCountByCategoryIDAndDateRange counts the tickets with at least one rating in a category for a date range
+func (r *RatingsRepository) GetDistinctTicketCountByCategoryIDAndDateRange(ctx context.Context, categoryID int, startDate, endDate time.Time) (int, error) {
+	start, end := dayRange(startDate, endDate)
+
+	query := `SELECT COUNT(DISTINCT ticket_id) FROM ratings
+			  WHERE rating_category_id = ? AND created_at >= ? AND created_at < ?`
+
+	var count int
+	err := r.db.QueryRowContext(ctx, query, categoryID, start, end).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count rated tickets: %w", err)
+	}
+
+	return count, nil
+}
+
+// GetCountBelowThreshold counts ratings in a category at or below the threshold for a date range
+func (r *RatingsRepository) GetCountBelowThreshold(ctx context.Context, categoryID int, threshold int, startDate, endDate time.Time) (int, error) {
+	start, end := dayRange(startDate, endDate)
+
+	query := `SELECT COUNT(*) FROM ratings
+			  WHERE rating_category_id = ? AND rating <= ? AND created_at >= ? AND created_at < ?`
+
+	var count int
+	err := r.db.QueryRowContext(ctx, query, categoryID, threshold, start, end).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count ratings below threshold: %w", err)
+	}
+
+	return count, nil
+}
+
+// GetAverageRatingPerTicket gets the average raw rating of each ticket rated in a date range
+func (r *RatingsRepository) GetAverageRatingPerTicket(ctx context.Context, startDate, endDate time.Time) (map[int]float64, error) {
+	start, end := dayRange(startDate, endDate)
+
+	query := `SELECT ticket_id, AVG(rating)
+			  FROM ratings
+			  WHERE created_at >= ? AND created_at < ?
+			  GROUP BY ticket_id`
+
+	rows, err := r.db.QueryContext(ctx, query, start, end)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query ticket averages: %w", err)
+	}
+	defer rows.Close()
+
+	averages := make(map[int]float64)
+	for rows.Next() {
+		var ticketID int
+		var average float64
+		if err := rows.Scan(&ticketID, &average); err != nil {
+			return nil, fmt.Errorf("failed to scan ticket average: %w", err)
+		}
+		averages[ticketID] = average
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+
+	return averages, nil
+}
+
+// GetRatingsByWeekdayAndCategoryID gets the number and average raw rating of a category's ratings per
+// day of the week (UTC, 0 being Sunday) for a date range, ordered by weekday. Weekdays without ratings
+// are omitted.
+func (r *RatingsRepository) GetRatingsByWeekdayAndCategoryID(ctx context.Context, categoryID int, startDate, endDate time.Time) ([]models.WeekdayRatings, error) {
+	start, end := dayRange(startDate, endDate)
+
+	query := `SELECT CAST(strftime('%w', created_at) AS INTEGER) AS weekday, COUNT(*) AS count, AVG(rating)
+			  FROM ratings
+			  WHERE rating_category_id = ? AND created_at >= ? AND created_at < ?
+			  GROUP BY weekday
+			  ORDER BY weekday`
+
+	rows, err := r.db.QueryContext(ctx, query, categoryID, start, end)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query ratings by weekday: %w", err)
+	}
+	defer rows.Close()
+
+	var weekdays []models.WeekdayRatings
+	for rows.Next() {
+		var weekday models.WeekdayRatings
+		if err := rows.Scan(&weekday.Weekday, &weekday.Count, &weekday.AverageRating); err != nil {
+			return nil, fmt.Errorf("failed to scan weekday ratings: %w", err)
+		}
+		weekdays = append(weekdays, weekday)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+
+	return weekdays, nil
+}
+
+// GetRatingsByHourAndWeekdayAndCategoryID gets the number and average raw rating of a category's
+// ratings per day of the week and hour of the day (UTC, 0 being Sunday) for a date range, ordered by
+// weekday then hour. Cells without ratings are omitted.
+func (r *RatingsRepository) GetRatingsByHourAndWeekdayAndCategoryID(ctx context.Context, categoryID int, startDate, endDate time.Time) ([]models.HourWeekdayRatings, error) {
+	start, end := dayRange(startDate, endDate)
+
+	query := `SELECT CAST(strftime('%w', created_at) AS INTEGER) AS weekday,
+			         CAST(strftime('%H', created_at) AS INTEGER) AS hour,
+			         COUNT(*) AS count, AVG(rating)
+			  FROM ratings
+			  WHERE rating_category_id = ? AND created_at >= ? AND created_at < ?
+			  GROUP BY weekday, hour
+			  ORDER BY weekday, hour`
+
+	rows, err := r.db.QueryContext(ctx, query, categoryID, start, end)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query ratings by hour and weekday: %w", err)
+	}
+	defer rows.Close()
+
+	var cells []models.HourWeekdayRatings
+	for rows.Next() {
+		var cell models.HourWeekdayRatings
+		if err := rows.Scan(&cell.Weekday, &cell.Hour, &cell.Count, &cell.AverageRating); err != nil {
+			return nil, fmt.Errorf("failed to scan hour and weekday ratings: %w", err)
+		}
+		cells = append(cells, cell)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+
+	return cells, nil
+}
+
+// GetReviewerCountAndAverageRatingByTicket gets the number of distinct reviewers and the average raw
+// rating of each ticket's ratings in a category for a date range, ordered by ticket ID
+func (r *RatingsRepository) GetReviewerCountAndAverageRatingByTicket(ctx context.Context, categoryID int, startDate, endDate time.Time) ([]models.TicketReviewerRatings, error) {
+	start, end := dayRange(startDate, endDate)
+
+	query := `SELECT ticket_id, COUNT(DISTINCT reviewer_id) AS reviewer_count, AVG(rating)
+			  FROM ratings
+			  WHERE rating_category_id = ? AND created_at >= ? AND created_at < ?
+			  GROUP BY ticket_id
+			  ORDER BY ticket_id`
+
+	rows, err := r.db.QueryContext(ctx, query, categoryID, start, end)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query reviewer counts by ticket: %w", err)
+	}
+	defer rows.Close()
+
+	var tickets []models.TicketReviewerRatings
+	for rows.Next() {
+		var ticket models.TicketReviewerRatings
+		if err := rows.Scan(&ticket.TicketID, &ticket.ReviewerCount, &ticket.AverageRating); err != nil {
+			return nil, fmt.Errorf("failed to scan ticket reviewer ratings: %w", err)
+		}
+		tickets = append(tickets, ticket)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+
+	return tickets, nil
+}
+
+// GetRatingCountByDayOfWeek gets the number of ratings created on each day of the week (UTC) in a
+// date range, indexed from 0 for Sunday. Weekdays without ratings count 0.
+func (r *RatingsRepository) GetRatingCountByDayOfWeek(ctx context.Context, startDate, endDate time.Time) ([7]int, error) {
+	start, end := dayRange(startDate, endDate)
+
+	query := `SELECT CAST(strftime('%w', created_at) AS INTEGER) AS dow, COUNT(*)
+			  FROM ratings
+			  WHERE created_at >= ? AND created_at < ?
+			  GROUP BY dow`
+
+	var counts [7]int
+	rows, err := r.db.QueryContext(ctx, query, start, end)
+	if err != nil {
+		return counts, fmt.Errorf("failed to query rating counts by day of week: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var weekday, count int
+		if err := rows.Scan(&weekday, &count); err != nil {
+			return counts, fmt.Errorf("failed to scan day of week rating count: %w", err)
+		}
+		if weekday >= 0 && weekday < len(counts) {
+			counts[weekday] = count
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return counts, fmt.Errorf("rows iteration error: %w", err)
+	}
+
+	return counts, nil
+}
+
+// GetRatingsByHourOfDay gets the number and average raw rating of ratings per hour of the day
+// (UTC) for a date range, ordered by hour. Hours without ratings are omitted.
+func (r *RatingsRepository) GetRatingsByHourOfDay(ctx context.Context, startDate, endDate time.Time) ([]models.HourlyRatings, error) {
+	start, end := dayRange(startDate, endDate)
+
+	query := `SELECT CAST(strftime('%H', created_at) AS INTEGER) AS hour, COUNT(*) AS count, AVG(rating)
+			  FROM ratings
+			  WHERE created_at >= ? AND created_at < ?
+			  GROUP BY hour
+			  ORDER BY hour`
+
+	rows, err := r.db.QueryContext(ctx, query, start, end)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query ratings by hour: %w", err)
+	}
+	defer rows.Close()
+
+	var hours []models.HourlyRatings
+	for rows.Next() {
+		var hour models.HourlyRatings
+		if err := rows.Scan(&hour.Hour, &hour.Count, &hour.AverageRating); err != nil {
+			return nil, fmt.Errorf("failed to scan hourly ratings: %w", err)
+		}
+		hours = append(hours, hour)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+
+	return hours, nil
+}
+
+// GetCountByCategoryIDAndDateRange gets the number of ratings in all categories created on each
+// day of a date range, keyed by UTC date (YYYY-MM-DD). Days without ratings are left out.
+func (r *RatingsRepository) GetCountByCategoryIDAndDateRange(ctx context.Context, startDate, endDate time.Time) (map[string]int, error) {
+	start, end := dayRange(startDate, endDate)
+
+	query := `SELECT date(created_at) AS day, COUNT(*)
+			  FROM ratings
+			  WHERE created_at >= ? AND created_at < ?
+			  GROUP BY day`
+
+	rows, err := r.db.QueryContext(ctx, query, start, end)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query daily rating counts: %w", err)
+	}
+	defer rows.Close()
+
+	counts := make(map[string]int)
+	for rows.Next() {
+		var day string
+		var count int
+		if err := rows.Scan(&day, &count); err != nil {
+			return nil, fmt.Errorf("failed to scan daily rating count: %w", err)
+		}
+		counts[day] = count
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+
+	return counts, nil
+}
+
+// GetRatingCountPerTicket gets the number of ratings of each ticket rated in a date range
+func (r *RatingsRepository) GetRatingCountPerTicket(ctx context.Context, startDate, endDate time.Time) (map[int]int, error) {
+	start, end := dayRange(startDate, endDate)
+
+	query := `SELECT ticket_id, COUNT(*)
+			  FROM ratings
+			  WHERE created_at >= ? AND created_at < ?
+			  GROUP BY ticket_id`
+
+	rows, err := r.db.QueryContext(ctx, query, start, end)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query ticket rating counts: %w", err)
+	}
+	defer rows.Close()
+
+	counts := make(map[int]int)
+	for rows.Next() {
+		var ticketID, count int
+		if err := rows.Scan(&ticketID, &count); err != nil {
+			return nil, fmt.Errorf("failed to scan ticket rating count: %w", err)
+		}
+		counts[ticketID] = count
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+
+	return counts, nil
+}
+
+// GetRatingCountByReviewerAndDateRange gets the number of ratings each reviewer gave in a date range
+func (r *RatingsRepository) GetRatingCountByReviewerAndDateRange(ctx context.Context, startDate, endDate time.Time) (map[int]int, error) {
+	start, end := dayRange(startDate, endDate)
+
+	query := `SELECT reviewer_id, COUNT(*)
+			  FROM ratings
+			  WHERE created_at >= ? AND created_at < ?
+			  GROUP BY reviewer_id`
+
+	rows, err := r.db.QueryContext(ctx, query, start, end)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query reviewer rating counts: %w", err)
+	}
+	defer rows.Close()
+
+	counts := make(map[int]int)
+	for rows.Next() {
+		var reviewerID, count int
+		if err := rows.Scan(&reviewerID, &count); err != nil {
+			return nil, fmt.Errorf("failed to scan reviewer rating count: %w", err)
+		}
+		counts[reviewerID] = count
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+
+	return counts, nil
+}
+
+// GetDistinctReviewerIDsByDateRange gets the IDs of all reviewers who rated in a date range
+func (r *RatingsRepository) GetDistinctReviewerIDsByDateRange(ctx context.Context, startDate, endDate time.Time) ([]int, error) {
+	start, end := dayRange(startDate, endDate)
+
+	query := `SELECT DISTINCT reviewer_id
+			  FROM ratings
+			  WHERE created_at >= ? AND created_at < ?
+			  ORDER BY reviewer_id`
+
+	rows, err := r.db.QueryContext(ctx, query, start, end)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query distinct reviewer IDs: %w", err)
+	}
+	defer rows.Close()
+
+	var reviewerIDs []int
+	for rows.Next() {
+		var reviewerID int
+		if err := rows.Scan(&reviewerID); err != nil {
+			return nil, fmt.Errorf("failed to scan reviewer ID: %w", err)
+		}
+		reviewerIDs = append(reviewerIDs, reviewerID)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+
+	return reviewerIDs, nil
+}
+
+// BulkInsertRatings inserts ratings in a single transaction, so either all ratings are inserted or none are.
+// Ratings with a zero ID are assigned one by the database.
+func (r *RatingsRepository) BulkInsertRatings(ctx context.Context, ratings []models.Rating) error {
+	if len(ratings) == 0 {
+		return nil
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+
+	for start := 0; start < len(ratings); start += bulkInsertChunkSize {
+		end := min(start+bulkInsertChunkSize, len(ratings))
+		if err := insertRatingsChunk(ctx, tx, ratings[start:end]); err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// insertRatingsChunk inserts ratings using one multi-row INSERT statement
+func insertRatingsChunk(ctx context.Context, tx *sql.Tx, ratings []models.Rating) error {
+	placeholders := make([]string, len(ratings))
+	args := make([]any, 0, len(ratings)*7)
+	for i, rating := range ratings {
+		placeholders[i] = "(?, ?, ?, ?, ?, ?, ?)"
+		args = append(args, ratingID(rating), rating.Rating, rating.TicketID, rating.RatingCategoryID,
+			rating.ReviewerID, rating.RevieweeID, rating.CreatedAt)
+	}
+
+	query := `INSERT INTO ratings (id, rating, ticket_id, rating_category_id, reviewer_id, reviewee_id, created_at)
+			  VALUES ` + strings.Join(placeholders, ", ")
+
+	if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("failed to insert ratings: %w", err)
+	}
+
+	return nil
+}
+
+// UpsertRating inserts a rating, or updates the existing rating with the same ID
+func (r *RatingsRepository) UpsertRating(ctx context.Context, rating models.Rating) error {
+	query := `INSERT INTO ratings (id, rating, ticket_id, rating_category_id, reviewer_id, reviewee_id, created_at)
+			  VALUES (?, ?, ?, ?, ?, ?, ?)
+			  ON CONFLICT(id) DO UPDATE SET
+				rating = excluded.rating,
+				ticket_id = excluded.ticket_id,
+				rating_category_id = excluded.rating_category_id,
+				reviewer_id = excluded.reviewer_id,
+				reviewee_id = excluded.reviewee_id,
+				created_at = excluded.created_at`
+
+	_, err := r.db.ExecContext(ctx, query, ratingID(rating), rating.Rating, rating.TicketID, rating.RatingCategoryID,
+		rating.ReviewerID, rating.RevieweeID, rating.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to upsert rating: %w", err)
+	}
+
+	return nil
+}
+
+// ratingID returns the rating's ID, or nil so the database assigns one when it is zero
+func ratingID(rating models.Rating) any {
+	if rating.ID == 0 {
+		return nil
+	}
+	return rating.ID
+}
@@ -0,0 +1,838 @@
+package repository_test
+
+import (
+	"context"
+	"math"
+	"reflect"
+	"testing"
+	"time"
+
+	"ticket-score-service/internal/database"
+	"ticket-score-service/internal/models"
+	"ticket-score-service/internal/repository"
+	"ticket-score-service/internal/testutil"
+)
+
+func TestRatingsRepository_GetCountAboveAndBelowThreshold(t *testing.T) {
+	db := testutil.NewTestDB(t)
+	repo := repository.NewRatingsRepository(db)
+
+	day := time.Date(2019, 10, 1, 0, 0, 0, 0, time.UTC)
+	testutil.SeedTestData(t, db, []models.Rating{
+		{ID: 1, Rating: 5, TicketID: 1, RatingCategoryID: 1, CreatedAt: day.Add(1 * time.Hour)},
+		{ID: 2, Rating: 4, TicketID: 2, RatingCategoryID: 1, CreatedAt: day.Add(2 * time.Hour)},
+		{ID: 3, Rating: 3, TicketID: 3, RatingCategoryID: 1, CreatedAt: day.Add(3 * time.Hour)},
+		{ID: 4, Rating: 2, TicketID: 4, RatingCategoryID: 1, CreatedAt: day.Add(26 * time.Hour)},
+		{ID: 5, Rating: 1, TicketID: 5, RatingCategoryID: 1, CreatedAt: day.Add(50 * time.Hour)}, // end date, late in the day
+		{ID: 6, Rating: 5, TicketID: 6, RatingCategoryID: 2, CreatedAt: day.Add(4 * time.Hour)},  // other category
+		{ID: 7, Rating: 5, TicketID: 7, RatingCategoryID: 1, CreatedAt: day.Add(-1 * time.Hour)}, // before range
+		{ID: 8, Rating: 1, TicketID: 8, RatingCategoryID: 1, CreatedAt: day.Add(73 * time.Hour)}, // after range
+	}, nil)
+
+	startDate := day
+	endDate := day.AddDate(0, 0, 2)
+
+	tests := []struct {
+		name          string
+		threshold     int
+		expectedAbove int
+		expectedBelow int
+	}{
+		{name: "threshold 1", threshold: 1, expectedAbove: 5, expectedBelow: 1},
+		{name: "threshold 2", threshold: 2, expectedAbove: 4, expectedBelow: 2},
+		{name: "threshold 3", threshold: 3, expectedAbove: 3, expectedBelow: 3},
+		{name: "threshold 4", threshold: 4, expectedAbove: 2, expectedBelow: 4},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			above, err := repo.GetCountAboveThreshold(ctx, 1, tt.threshold, startDate, endDate)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if above != tt.expectedAbove {
+				t.Errorf("Expected %d ratings above threshold, got %d", tt.expectedAbove, above)
+			}
+
+			below, err := repo.GetCountBelowThreshold(ctx, 1, tt.threshold, startDate, endDate)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if below != tt.expectedBelow {
+				t.Errorf("Expected %d ratings below threshold, got %d", tt.expectedBelow, below)
+			}
+		})
+	}
+
+	t.Run("unknown category", func(t *testing.T) {
+		above, err := repo.GetCountAboveThreshold(context.Background(), 99, 1, startDate, endDate)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if above != 0 {
+			t.Errorf("Expected 0 ratings, got %d", above)
+		}
+	})
+}
+
+func TestRatingsRepository_GetAverageScoreByReviewer(t *testing.T) {
+	db := testutil.NewTestDB(t)
+	repo := repository.NewRatingsRepository(db)
+
+	day := time.Date(2019, 10, 1, 0, 0, 0, 0, time.UTC)
+	testutil.SeedTestData(t, db, []models.Rating{
+		{ID: 1, Rating: 5, TicketID: 1, RatingCategoryID: 1, ReviewerID: 1, CreatedAt: day.Add(1 * time.Hour)},
+		{ID: 2, Rating: 4, TicketID: 2, RatingCategoryID: 1, ReviewerID: 1, CreatedAt: day.Add(2 * time.Hour)},
+		{ID: 3, Rating: 2, TicketID: 1, RatingCategoryID: 1, ReviewerID: 2, CreatedAt: day.Add(3 * time.Hour)},
+		{ID: 4, Rating: 1, TicketID: 1, RatingCategoryID: 1, ReviewerID: 2, CreatedAt: day.AddDate(0, 0, 5)}, // after range
+	}, nil)
+
+	averages, err := repo.GetAverageScoreByReviewer(context.Background(), day, day)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expected := map[int]float64{1: 4.5, 2: 2}
+	if len(averages) != len(expected) {
+		t.Fatalf("Expected %d reviewers, got %d", len(expected), len(averages))
+	}
+	for reviewerID, average := range expected {
+		if averages[reviewerID] != average {
+			t.Errorf("Expected average %.2f for reviewer %d, got %.2f", average, reviewerID, averages[reviewerID])
+		}
+	}
+}
+
+func TestRatingsRepository_GetAverageRatingByRevieweeAndCategory(t *testing.T) {
+	db := testutil.NewTestDB(t)
+	repo := repository.NewRatingsRepository(db)
+
+	day := time.Date(2019, 10, 1, 0, 0, 0, 0, time.UTC)
+	testutil.SeedTestData(t, db, []models.Rating{
+		{ID: 1, Rating: 5, TicketID: 1, RatingCategoryID: 1, RevieweeID: 1, CreatedAt: day.Add(1 * time.Hour)},
+		{ID: 2, Rating: 2, TicketID: 2, RatingCategoryID: 1, RevieweeID: 1, CreatedAt: day.Add(2 * time.Hour)},
+		{ID: 3, Rating: 4, TicketID: 1, RatingCategoryID: 2, RevieweeID: 1, CreatedAt: day.Add(3 * time.Hour)},
+		{ID: 4, Rating: 1, TicketID: 3, RatingCategoryID: 1, RevieweeID: 2, CreatedAt: day.Add(4 * time.Hour)},
+		{ID: 5, Rating: 0, TicketID: 3, RatingCategoryID: 2, RevieweeID: 2, CreatedAt: day.AddDate(0, 0, 5)}, // after range
+	}, nil)
+
+	averages, err := repo.GetAverageRatingByRevieweeAndCategory(context.Background(), day, day)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expected := map[int]map[int]float64{
+		1: {1: 3.5, 2: 4},
+		2: {1: 1},
+	}
+	if !reflect.DeepEqual(averages, expected) {
+		t.Errorf("Expected %v, got %v", expected, averages)
+	}
+}
+
+func TestRatingsRepository_GetByCategoryIDAndDateRange(t *testing.T) {
+	db := testutil.NewTestDB(t)
+	repo := repository.NewRatingsRepository(db)
+
+	day := time.Date(2019, 10, 1, 0, 0, 0, 0, time.UTC)
+	testutil.SeedTestData(t, db, []models.Rating{
+		{ID: 1, Rating: 5, TicketID: 1, RatingCategoryID: 1, CreatedAt: day.Add(30 * time.Hour)},
+		{ID: 2, Rating: 4, TicketID: 2, RatingCategoryID: 1, CreatedAt: day.Add(2 * time.Hour)},
+		{ID: 3, Rating: 3, TicketID: 3, RatingCategoryID: 1, CreatedAt: day.Add(47 * time.Hour)}, // end date, late in the day
+		{ID: 4, Rating: 2, TicketID: 4, RatingCategoryID: 2, CreatedAt: day.Add(3 * time.Hour)},  // other category
+		{ID: 5, Rating: 1, TicketID: 5, RatingCategoryID: 1, CreatedAt: day.Add(48 * time.Hour)}, // after range
+	}, nil)
+
+	ratings, err := repo.GetByCategoryIDAndDateRange(context.Background(), 1, day, day.AddDate(0, 0, 1))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expectedIDs := []int{2, 1, 3}
+	if len(ratings) != len(expectedIDs) {
+		t.Fatalf("Expected %d ratings, got %d", len(expectedIDs), len(ratings))
+	}
+	for i, id := range expectedIDs {
+		if ratings[i].ID != id {
+			t.Errorf("Expected rating %d at position %d, got %d", id, i, ratings[i].ID)
+		}
+	}
+}
+
+func TestRatingsRepository_GetByCategoryID(t *testing.T) {
+	db := testutil.NewTestDB(t)
+	repo := repository.NewRatingsRepository(db)
+
+	day := time.Date(2019, 10, 1, 0, 0, 0, 0, time.UTC)
+	testutil.SeedTestData(t, db, []models.Rating{
+		{ID: 1, Rating: 5, TicketID: 1, RatingCategoryID: 1, CreatedAt: day.AddDate(1, 0, 0)},
+		{ID: 2, Rating: 4, TicketID: 2, RatingCategoryID: 1, CreatedAt: day},
+		{ID: 3, Rating: 3, TicketID: 3, RatingCategoryID: 2, CreatedAt: day}, // other category
+	}, nil)
+
+	ratings, err := repo.GetByCategoryID(context.Background(), 1)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	ids := make([]int, len(ratings))
+	for i, rating := range ratings {
+		ids[i] = rating.ID
+	}
+	assertIDs(t, "rating", []int{2, 1}, ids)
+}
+
+func TestRatingsRepository_GetDistinctTicketCountByCategoryIDAndDateRange(t *testing.T) {
+	db := testutil.NewTestDB(t)
+	repo := repository.NewRatingsRepository(db)
+
+	day := time.Date(2019, 10, 1, 0, 0, 0, 0, time.UTC)
+	testutil.SeedTestData(t, db, []models.Rating{
+		{ID: 1, Rating: 5, TicketID: 1, RatingCategoryID: 1, CreatedAt: day},
+		{ID: 2, Rating: 4, TicketID: 1, RatingCategoryID: 1, CreatedAt: day.Add(time.Hour)}, // same ticket
+		{ID: 3, Rating: 3, TicketID: 2, RatingCategoryID: 1, CreatedAt: day.Add(47 * time.Hour)},
+		{ID: 4, Rating: 2, TicketID: 3, RatingCategoryID: 2, CreatedAt: day},                     // other category
+		{ID: 5, Rating: 1, TicketID: 4, RatingCategoryID: 1, CreatedAt: day.Add(48 * time.Hour)}, // after range
+	}, nil)
+
+	count, err := repo.GetDistinctTicketCountByCategoryIDAndDateRange(context.Background(), 1, day, day.AddDate(0, 0, 1))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if count != 2 {
+		t.Errorf("Expected 2 tickets, got %d", count)
+	}
+}
+
+func TestRatingsRepository_GetDistinctTicketIDsByDateRange(t *testing.T) {
+	db := testutil.NewTestDB(t)
+	repo := repository.NewRatingsRepository(db)
+
+	day := time.Date(2019, 10, 1, 0, 0, 0, 0, time.UTC)
+	testutil.SeedTestData(t, db, []models.Rating{
+		{ID: 1, Rating: 5, TicketID: 3, RatingCategoryID: 1, CreatedAt: day},
+		{ID: 2, Rating: 4, TicketID: 3, RatingCategoryID: 2, CreatedAt: day.Add(time.Hour)},      // same ticket
+		{ID: 3, Rating: 3, TicketID: 1, RatingCategoryID: 1, CreatedAt: day.Add(34 * time.Hour)}, // end date
+		{ID: 4, Rating: 2, TicketID: 2, RatingCategoryID: 1, CreatedAt: day.Add(48 * time.Hour)}, // after range
+		{ID: 5, Rating: 1, TicketID: 4, RatingCategoryID: 1, CreatedAt: day.Add(-time.Second)},   // before range
+	}, nil)
+
+	ticketIDs, err := repo.GetDistinctTicketIDsByDateRange(context.Background(), day, day.AddDate(0, 0, 1))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	assertIDs(t, "ticket", []int{1, 3}, ticketIDs)
+}
+
+func TestRatingsRepository_GetTicketRatingAggregates(t *testing.T) {
+	db := testutil.NewTestDB(t)
+	repo := repository.NewRatingsRepository(db)
+
+	day := time.Date(2019, 10, 1, 0, 0, 0, 0, time.UTC)
+	testutil.SeedTestData(t, db, []models.Rating{
+		{ID: 1, Rating: 5, TicketID: 1, RatingCategoryID: 1, CreatedAt: day.Add(1 * time.Hour)},
+		{ID: 2, Rating: 2, TicketID: 1, RatingCategoryID: 2, CreatedAt: day.Add(2 * time.Hour)},
+		{ID: 3, Rating: 4, TicketID: 1, RatingCategoryID: 3, CreatedAt: day.Add(26 * time.Hour)},
+		{ID: 4, Rating: 3, TicketID: 2, RatingCategoryID: 1, CreatedAt: day.Add(47 * time.Hour)}, // end date, late in the day
+		{ID: 5, Rating: 0, TicketID: 2, RatingCategoryID: 2, CreatedAt: day.Add(3 * time.Hour)},
+		{ID: 6, Rating: 1, TicketID: 1, RatingCategoryID: 1, CreatedAt: day.Add(48 * time.Hour)}, // after range
+		{ID: 7, Rating: 5, TicketID: 3, RatingCategoryID: 1, CreatedAt: day.Add(-1 * time.Hour)}, // before range
+	}, nil)
+
+	startDate := day
+	endDate := day.AddDate(0, 0, 1)
+
+	averages, err := repo.GetAverageRatingPerTicket(context.Background(), startDate, endDate)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expectedAverages := map[int]float64{1: 11.0 / 3.0, 2: 1.5}
+	if len(averages) != len(expectedAverages) {
+		t.Fatalf("Expected %d tickets, got %d", len(expectedAverages), len(averages))
+	}
+	for ticketID, average := range expectedAverages {
+		if math.Abs(averages[ticketID]-average) > 1e-9 {
+			t.Errorf("Expected average %.4f for ticket %d, got %.4f", average, ticketID, averages[ticketID])
+		}
+	}
+
+	counts, err := repo.GetRatingCountPerTicket(context.Background(), startDate, endDate)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expectedCounts := map[int]int{1: 3, 2: 2}
+	if len(counts) != len(expectedCounts) {
+		t.Fatalf("Expected %d tickets, got %d", len(expectedCounts), len(counts))
+	}
+	for ticketID, count := range expectedCounts {
+		if counts[ticketID] != count {
+			t.Errorf("Expected %d ratings for ticket %d, got %d", count, ticketID, counts[ticketID])
+		}
+	}
+}
+
+func TestRatingsRepository_GetDistinctIDsByReviewer(t *testing.T) {
+	db := testutil.NewTestDB(t)
+	repo := repository.NewRatingsRepository(db)
+
+	day := time.Date(2019, 10, 1, 0, 0, 0, 0, time.UTC)
+	testutil.SeedTestData(t, db, []models.Rating{
+		{ID: 1, Rating: 5, TicketID: 2, RatingCategoryID: 1, ReviewerID: 3, CreatedAt: day.Add(1 * time.Hour)},
+		{ID: 2, Rating: 4, TicketID: 1, RatingCategoryID: 2, ReviewerID: 3, CreatedAt: day.Add(47 * time.Hour)}, // end date, late in the day
+		{ID: 3, Rating: 3, TicketID: 2, RatingCategoryID: 2, ReviewerID: 3, CreatedAt: day.Add(2 * time.Hour)},
+		{ID: 4, Rating: 2, TicketID: 3, RatingCategoryID: 1, ReviewerID: 1, CreatedAt: day.Add(3 * time.Hour)},
+		{ID: 5, Rating: 1, TicketID: 4, RatingCategoryID: 1, ReviewerID: 3, CreatedAt: day.Add(48 * time.Hour)}, // after range
+		{ID: 6, Rating: 1, TicketID: 5, RatingCategoryID: 1, ReviewerID: 2, CreatedAt: day.Add(-1 * time.Hour)}, // before range
+	}, nil)
+
+	startDate := day
+	endDate := day.AddDate(0, 0, 1)
+
+	reviewerIDs, err := repo.GetDistinctReviewerIDsByDateRange(context.Background(), startDate, endDate)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	assertIDs(t, "reviewer", []int{1, 3}, reviewerIDs)
+
+	counts, err := repo.GetRatingCountByReviewerAndDateRange(context.Background(), startDate, endDate)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	expectedCounts := map[int]int{1: 1, 3: 3}
+	if len(counts) != len(expectedCounts) {
+		t.Fatalf("Expected %d reviewers, got %d", len(expectedCounts), len(counts))
+	}
+	for reviewerID, count := range expectedCounts {
+		if counts[reviewerID] != count {
+			t.Errorf("Expected %d ratings by reviewer %d, got %d", count, reviewerID, counts[reviewerID])
+		}
+	}
+}
+
+// assertIDs checks that ids matches expected in order
+func assertIDs(t *testing.T, kind string, expected, ids []int) {
+	t.Helper()
+
+	if len(ids) != len(expected) {
+		t.Fatalf("Expected %s IDs %v, got %v", kind, expected, ids)
+	}
+	for i := range expected {
+		if ids[i] != expected[i] {
+			t.Errorf("Expected %s IDs %v, got %v", kind, expected, ids)
+			return
+		}
+	}
+}
+
+// countRatings returns the number of rows in the ratings table
+func countRatings(t *testing.T, db *database.DB) int {
+	t.Helper()
+
+	var count int
+	if err := db.QueryRowContext(context.Background(), "SELECT COUNT(*) FROM ratings").Scan(&count); err != nil {
+		t.Fatalf("Failed to count ratings: %v", err)
+	}
+	return count
+}
+
+func TestRatingsRepository_GetRatingsByTicketIDsAndCategoryIDs(t *testing.T) {
+	db := testutil.NewTestDB(t)
+	repo := repository.NewRatingsRepository(db)
+
+	day := time.Date(2019, 10, 1, 0, 0, 0, 0, time.UTC)
+
+	// Enough tickets that the ticket IDs are split across several queries
+	var ratings []models.Rating
+	var ticketIDs []int
+	for ticketID := 1; ticketID <= 1200; ticketID++ {
+		ticketIDs = append(ticketIDs, ticketID)
+		ratings = append(ratings,
+			models.Rating{ID: ticketID*3 - 2, Rating: 5, TicketID: ticketID, RatingCategoryID: 1, CreatedAt: day.Add(2 * time.Hour)},
+			models.Rating{ID: ticketID*3 - 1, Rating: 4, TicketID: ticketID, RatingCategoryID: 1, CreatedAt: day.Add(1 * time.Hour)},
+			models.Rating{ID: ticketID * 3, Rating: 3, TicketID: ticketID, RatingCategoryID: 3, CreatedAt: day}, // category not requested
+		)
+	}
+	ratings = append(ratings, models.Rating{ID: 4000, Rating: 1, TicketID: 1300, RatingCategoryID: 1, CreatedAt: day}) // ticket not requested
+	testutil.SeedTestData(t, db, ratings, nil)
+
+	results, err := repo.GetRatingsByTicketIDsAndCategoryIDs(context.Background(), ticketIDs, []int{1, 2})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if len(results) != 1200 {
+		t.Fatalf("Expected ratings for 1200 tickets, got %d", len(results))
+	}
+	for _, ticketID := range []int{1, 999, 1000, 1200} {
+		byCategory := results[ticketID]
+		if len(byCategory) != 1 {
+			t.Errorf("Expected ratings in 1 category for ticket %d, got %d", ticketID, len(byCategory))
+		}
+
+		ids := make([]int, len(byCategory[1]))
+		for i, rating := range byCategory[1] {
+			ids[i] = rating.ID
+		}
+		assertIDs(t, "rating", []int{ticketID*3 - 1, ticketID*3 - 2}, ids)
+	}
+
+	t.Run("no ticket IDs", func(t *testing.T) {
+		results, err := repo.GetRatingsByTicketIDsAndCategoryIDs(context.Background(), nil, []int{1})
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if len(results) != 0 {
+			t.Errorf("Expected no results, got %d", len(results))
+		}
+	})
+}
+
+func TestRatingsRepository_GetByRevieweeIDAndCategoryID(t *testing.T) {
+	db := testutil.NewTestDB(t)
+	repo := repository.NewRatingsRepository(db)
+
+	day := time.Date(2019, 10, 1, 0, 0, 0, 0, time.UTC)
+	testutil.SeedTestData(t, db, []models.Rating{
+		{ID: 1, Rating: 5, TicketID: 1, RatingCategoryID: 1, ReviewerID: 1, RevieweeID: 7, CreatedAt: day.Add(3 * time.Hour)},
+		{ID: 2, Rating: 4, TicketID: 2, RatingCategoryID: 1, ReviewerID: 1, RevieweeID: 7, CreatedAt: day.Add(1 * time.Hour)},
+		{ID: 3, Rating: 3, TicketID: 3, RatingCategoryID: 2, ReviewerID: 1, RevieweeID: 7, CreatedAt: day}, // other category
+		{ID: 4, Rating: 2, TicketID: 4, RatingCategoryID: 1, ReviewerID: 1, RevieweeID: 8, CreatedAt: day}, // other reviewee
+	}, nil)
+
+	ratings, err := repo.GetByRevieweeIDAndCategoryID(context.Background(), 7, 1)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	ids := make([]int, len(ratings))
+	for i, rating := range ratings {
+		ids[i] = rating.ID
+	}
+	assertIDs(t, "rating", []int{2, 1}, ids)
+
+	ratings, err = repo.GetByRevieweeIDAndCategoryID(context.Background(), 99, 1)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(ratings) != 0 {
+		t.Errorf("Expected no ratings for an unknown reviewee, got %d", len(ratings))
+	}
+}
+
+func TestRatingsRepository_GetByReviewerIDAndCategoryIDAndDateRange(t *testing.T) {
+	db := testutil.NewTestDB(t)
+	repo := repository.NewRatingsRepository(db)
+
+	day := time.Date(2019, 10, 1, 0, 0, 0, 0, time.UTC)
+	testutil.SeedTestData(t, db, []models.Rating{
+		{ID: 1, Rating: 5, TicketID: 1, RatingCategoryID: 1, ReviewerID: 3, CreatedAt: day.Add(30 * time.Hour)}, // end date
+		{ID: 2, Rating: 4, TicketID: 2, RatingCategoryID: 1, ReviewerID: 3, CreatedAt: day.Add(1 * time.Hour)},
+		{ID: 3, Rating: 3, TicketID: 3, RatingCategoryID: 2, ReviewerID: 3, CreatedAt: day},                     // other category
+		{ID: 4, Rating: 2, TicketID: 4, RatingCategoryID: 1, ReviewerID: 4, CreatedAt: day},                     // other reviewer
+		{ID: 5, Rating: 1, TicketID: 5, RatingCategoryID: 1, ReviewerID: 3, CreatedAt: day.Add(50 * time.Hour)}, // after range
+	}, nil)
+
+	ratings, err := repo.GetByReviewerIDAndCategoryIDAndDateRange(context.Background(), 3, 1, day, day.AddDate(0, 0, 1))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	ids := make([]int, len(ratings))
+	for i, rating := range ratings {
+		ids[i] = rating.ID
+	}
+	assertIDs(t, "rating", []int{2, 1}, ids)
+}
+
+func TestRatingsRepository_GetByReviewerIDAndDateRange(t *testing.T) {
+	db := testutil.NewTestDB(t)
+	repo := repository.NewRatingsRepository(db)
+
+	day := time.Date(2019, 10, 1, 0, 0, 0, 0, time.UTC)
+	testutil.SeedTestData(t, db, []models.Rating{
+		{ID: 1, Rating: 5, TicketID: 1, RatingCategoryID: 1, ReviewerID: 3, CreatedAt: day.Add(47 * time.Hour)}, // end date
+		{ID: 2, Rating: 4, TicketID: 2, RatingCategoryID: 1, ReviewerID: 3, CreatedAt: day},
+		{ID: 3, Rating: 3, TicketID: 3, RatingCategoryID: 2, ReviewerID: 3, CreatedAt: day.Add(1 * time.Hour)},  // other category
+		{ID: 4, Rating: 2, TicketID: 4, RatingCategoryID: 1, ReviewerID: 4, CreatedAt: day},                     // other reviewer
+		{ID: 5, Rating: 1, TicketID: 5, RatingCategoryID: 1, ReviewerID: 3, CreatedAt: day.Add(48 * time.Hour)}, // after range
+		{ID: 6, Rating: 1, TicketID: 6, RatingCategoryID: 2, ReviewerID: 3, CreatedAt: day.Add(-time.Second)},   // before range
+	}, nil)
+
+	ratings, err := repo.GetByReviewerIDAndDateRange(context.Background(), 3, day, day.AddDate(0, 0, 1))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	ids := make([]int, len(ratings))
+	for i, rating := range ratings {
+		ids[i] = rating.ID
+	}
+	assertIDs(t, "rating", []int{2, 3, 1}, ids)
+
+	t.Run("unknown reviewer", func(t *testing.T) {
+		ratings, err := repo.GetByReviewerIDAndDateRange(context.Background(), 99, day, day.AddDate(0, 0, 1))
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if len(ratings) != 0 {
+			t.Errorf("Expected no ratings for an unknown reviewer, got %d", len(ratings))
+		}
+	})
+}
+
+func TestRatingsRepository_GetByRevieweeIDAndDateRange(t *testing.T) {
+	db := testutil.NewTestDB(t)
+	repo := repository.NewRatingsRepository(db)
+
+	day := time.Date(2019, 10, 1, 0, 0, 0, 0, time.UTC)
+	testutil.SeedTestData(t, db, []models.Rating{
+		{ID: 1, Rating: 5, TicketID: 1, RatingCategoryID: 1, RevieweeID: 7, CreatedAt: day.Add(47 * time.Hour)}, // end date
+		{ID: 2, Rating: 4, TicketID: 2, RatingCategoryID: 1, RevieweeID: 7, CreatedAt: day},
+		{ID: 3, Rating: 3, TicketID: 3, RatingCategoryID: 2, RevieweeID: 7, CreatedAt: day.Add(1 * time.Hour)},  // other category
+		{ID: 4, Rating: 2, TicketID: 4, RatingCategoryID: 1, RevieweeID: 8, CreatedAt: day},                     // other reviewee
+		{ID: 5, Rating: 1, TicketID: 5, RatingCategoryID: 1, RevieweeID: 7, CreatedAt: day.Add(48 * time.Hour)}, // after range
+		{ID: 6, Rating: 1, TicketID: 6, RatingCategoryID: 2, RevieweeID: 7, CreatedAt: day.Add(-time.Second)},   // before range
+	}, nil)
+
+	ratings, err := repo.GetByRevieweeIDAndDateRange(context.Background(), 7, day, day.AddDate(0, 0, 1))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	ids := make([]int, len(ratings))
+	for i, rating := range ratings {
+		ids[i] = rating.ID
+	}
+	assertIDs(t, "rating", []int{2, 3, 1}, ids)
+
+	t.Run("unknown reviewee", func(t *testing.T) {
+		ratings, err := repo.GetByRevieweeIDAndDateRange(context.Background(), 99, day, day.AddDate(0, 0, 1))
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if len(ratings) != 0 {
+			t.Errorf("Expected no ratings for an unknown reviewee, got %d", len(ratings))
+		}
+	})
+}
+
+func TestRatingsRepository_BulkInsertRatings(t *testing.T) {
+	day := time.Date(2019, 10, 1, 0, 0, 0, 0, time.UTC)
+
+	newRatings := func(firstID, count int) []models.Rating {
+		ratings := make([]models.Rating, count)
+		for i := range ratings {
+			ratings[i] = models.Rating{ID: firstID + i, Rating: i % 6, TicketID: i + 1, RatingCategoryID: 1, CreatedAt: day.Add(time.Hour)}
+		}
+		return ratings
+	}
+
+	tests := []struct {
+		name          string
+		existing      []models.Rating
+		ratings       []models.Rating
+		expectedCount int
+		expectedError bool
+	}{
+		{name: "partial batch", ratings: newRatings(1, 97), expectedCount: 97},
+		{name: "full batch", ratings: newRatings(1, 100), expectedCount: 100},
+		{name: "multiple chunks", ratings: newRatings(1, 1201), expectedCount: 1201},
+		{name: "no ratings", ratings: nil, expectedCount: 0},
+		{
+			name:          "duplicate within batch rolls back",
+			ratings:       append(newRatings(1, 600), newRatings(600, 1)...),
+			expectedCount: 0,
+			expectedError: true,
+		},
+		{
+			name:          "duplicate of existing rating rolls back",
+			existing:      newRatings(50, 1),
+			ratings:       newRatings(1, 100),
+			expectedCount: 1,
+			expectedError: true,
+		},
+		{
+			name:          "zero IDs are assigned",
+			ratings:       newRatings(0, 1),
+			expectedCount: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := testutil.NewTestDB(t)
+			repo := repository.NewRatingsRepository(db)
+			testutil.SeedTestData(t, db, tt.existing, nil)
+
+			err := repo.BulkInsertRatings(context.Background(), tt.ratings)
+			if tt.expectedError && err == nil {
+				t.Error("Expected error but got none")
+			}
+			if !tt.expectedError && err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if count := countRatings(t, db); count != tt.expectedCount {
+				t.Errorf("Expected %d ratings, got %d", tt.expectedCount, count)
+			}
+		})
+	}
+}
+
+func TestRatingsRepository_UpsertRating(t *testing.T) {
+	db := testutil.NewTestDB(t)
+	repo := repository.NewRatingsRepository(db)
+
+	day := time.Date(2019, 10, 1, 0, 0, 0, 0, time.UTC)
+	rating := models.Rating{ID: 1, Rating: 2, TicketID: 1, RatingCategoryID: 1, ReviewerID: 1, CreatedAt: day}
+
+	if err := repo.UpsertRating(context.Background(), rating); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	rating.Rating = 5
+	rating.ReviewerID = 2
+	if err := repo.UpsertRating(context.Background(), rating); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if count := countRatings(t, db); count != 1 {
+		t.Fatalf("Expected 1 rating, got %d", count)
+	}
+
+	ratings, err := repo.GetByTicketID(context.Background(), 1)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(ratings) != 1 || ratings[0].Rating != 5 || ratings[0].ReviewerID != 2 {
+		t.Errorf("Expected updated rating, got %+v", ratings)
+	}
+}
+
+func TestRatingsRepository_GetRatingsByHourOfDay(t *testing.T) {
+	db := testutil.NewTestDB(t)
+	repo := repository.NewRatingsRepository(db)
+
+	day := time.Date(2019, 10, 1, 0, 0, 0, 0, time.UTC)
+	testutil.SeedTestData(t, db, []models.Rating{
+		{ID: 1, Rating: 5, TicketID: 1, RatingCategoryID: 1, CreatedAt: day.Add(9*time.Hour + 5*time.Minute)},
+		{ID: 2, Rating: 2, TicketID: 2, RatingCategoryID: 1, CreatedAt: day.Add(9*time.Hour + 59*time.Minute)},
+		{ID: 3, Rating: 4, TicketID: 3, RatingCategoryID: 2, CreatedAt: day.Add(14 * time.Hour)},
+		{ID: 4, Rating: 3, TicketID: 4, RatingCategoryID: 1, CreatedAt: day.Add(33 * time.Hour)}, // 09:00 on the end date
+		{ID: 5, Rating: 1, TicketID: 5, RatingCategoryID: 1, CreatedAt: day.Add(-1 * time.Hour)}, // before range
+	}, nil)
+
+	startDate := day
+	endDate := day.AddDate(0, 0, 1)
+
+	hours, err := repo.GetRatingsByHourOfDay(context.Background(), startDate, endDate)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expected := []models.HourlyRatings{
+		{Hour: 9, Count: 3, AverageRating: 10.0 / 3},
+		{Hour: 14, Count: 1, AverageRating: 4},
+	}
+	if len(hours) != len(expected) {
+		t.Fatalf("Expected %d hours, got %+v", len(expected), hours)
+	}
+	for i, hour := range hours {
+		if hour.Hour != expected[i].Hour || hour.Count != expected[i].Count || math.Abs(hour.AverageRating-expected[i].AverageRating) > 0.001 {
+			t.Errorf("Expected %+v, got %+v", expected[i], hour)
+		}
+	}
+
+	// CountByDateRange takes an exclusive end time rather than whole days
+	total, err := repo.CountByDateRange(context.Background(), startDate, endDate.AddDate(0, 0, 1))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	sum := 0
+	for _, hour := range hours {
+		sum += hour.Count
+	}
+	if sum != total {
+		t.Errorf("Expected hourly counts to sum to %d, got %d", total, sum)
+	}
+}
+
+func TestRatingsRepository_GetRatingsByWeekdayAndCategoryID(t *testing.T) {
+	db := testutil.NewTestDB(t)
+	repo := repository.NewRatingsRepository(db)
+
+	// 2019-10-06 is a Sunday
+	sunday := time.Date(2019, 10, 6, 0, 0, 0, 0, time.UTC)
+	testutil.SeedTestData(t, db, []models.Rating{
+		{ID: 1, Rating: 5, TicketID: 1, RatingCategoryID: 1, CreatedAt: sunday.Add(23 * time.Hour)},
+		{ID: 2, Rating: 2, TicketID: 2, RatingCategoryID: 1, CreatedAt: sunday.Add(7*24*time.Hour + time.Hour)},    // next Sunday
+		{ID: 3, Rating: 4, TicketID: 3, RatingCategoryID: 1, CreatedAt: sunday.Add(6*24*time.Hour + 12*time.Hour)}, // Saturday
+		{ID: 4, Rating: 1, TicketID: 4, RatingCategoryID: 2, CreatedAt: sunday.Add(24 * time.Hour)},                // other category
+		{ID: 5, Rating: 1, TicketID: 5, RatingCategoryID: 1, CreatedAt: sunday.Add(-time.Hour)},                    // before range
+	}, nil)
+
+	weekdays, err := repo.GetRatingsByWeekdayAndCategoryID(context.Background(), 1, sunday, sunday.AddDate(0, 0, 7))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expected := []models.WeekdayRatings{
+		{Weekday: 0, Count: 2, AverageRating: 3.5},
+		{Weekday: 6, Count: 1, AverageRating: 4},
+	}
+	if len(weekdays) != len(expected) {
+		t.Fatalf("Expected %d weekdays, got %+v", len(expected), weekdays)
+	}
+	for i, weekday := range weekdays {
+		if weekday.Weekday != expected[i].Weekday || weekday.Count != expected[i].Count || math.Abs(weekday.AverageRating-expected[i].AverageRating) > 0.001 {
+			t.Errorf("Expected %+v, got %+v", expected[i], weekday)
+		}
+	}
+}
+
+func TestRatingsRepository_GetRatingsByHourAndWeekdayAndCategoryID(t *testing.T) {
+	db := testutil.NewTestDB(t)
+	repo := repository.NewRatingsRepository(db)
+
+	// 2019-10-06 is a Sunday
+	sunday := time.Date(2019, 10, 6, 0, 0, 0, 0, time.UTC)
+	testutil.SeedTestData(t, db, []models.Rating{
+		{ID: 1, Rating: 5, TicketID: 1, RatingCategoryID: 1, CreatedAt: sunday.Add(9 * time.Hour)},
+		{ID: 2, Rating: 2, TicketID: 2, RatingCategoryID: 1, CreatedAt: sunday.Add(9*time.Hour + 30*time.Minute)},
+		{ID: 3, Rating: 4, TicketID: 3, RatingCategoryID: 1, CreatedAt: sunday.Add(6*24*time.Hour + 23*time.Hour)}, // Saturday
+		{ID: 4, Rating: 1, TicketID: 4, RatingCategoryID: 2, CreatedAt: sunday.Add(9 * time.Hour)},                 // other category
+		{ID: 5, Rating: 1, TicketID: 5, RatingCategoryID: 1, CreatedAt: sunday.Add(-time.Hour)},                    // before range
+	}, nil)
+
+	cells, err := repo.GetRatingsByHourAndWeekdayAndCategoryID(context.Background(), 1, sunday, sunday.AddDate(0, 0, 6))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expected := []models.HourWeekdayRatings{
+		{Weekday: 0, Hour: 9, Count: 2, AverageRating: 3.5},
+		{Weekday: 6, Hour: 23, Count: 1, AverageRating: 4},
+	}
+	if len(cells) != len(expected) {
+		t.Fatalf("Expected %d cells, got %+v", len(expected), cells)
+	}
+	for i, cell := range cells {
+		if cell.Weekday != expected[i].Weekday || cell.Hour != expected[i].Hour || cell.Count != expected[i].Count || math.Abs(cell.AverageRating-expected[i].AverageRating) > 0.001 {
+			t.Errorf("Expected %+v, got %+v", expected[i], cell)
+		}
+	}
+}
+
+func TestRatingsRepository_GetReviewerCountAndAverageRatingByTicket(t *testing.T) {
+	db := testutil.NewTestDB(t)
+	repo := repository.NewRatingsRepository(db)
+
+	day := time.Date(2019, 10, 1, 0, 0, 0, 0, time.UTC)
+	testutil.SeedTestData(t, db, []models.Rating{
+		{ID: 1, Rating: 5, TicketID: 2, RatingCategoryID: 1, ReviewerID: 1, CreatedAt: day.Add(1 * time.Hour)},
+		{ID: 2, Rating: 2, TicketID: 2, RatingCategoryID: 1, ReviewerID: 2, CreatedAt: day.Add(2 * time.Hour)},
+		{ID: 3, Rating: 4, TicketID: 2, RatingCategoryID: 1, ReviewerID: 2, CreatedAt: day.Add(3 * time.Hour)},
+		{ID: 4, Rating: 3, TicketID: 1, RatingCategoryID: 1, ReviewerID: 3, CreatedAt: day.Add(71 * time.Hour)}, // end date, late in the day
+		{ID: 5, Rating: 1, TicketID: 1, RatingCategoryID: 2, ReviewerID: 1, CreatedAt: day.Add(1 * time.Hour)},  // other category
+		{ID: 6, Rating: 1, TicketID: 3, RatingCategoryID: 1, ReviewerID: 1, CreatedAt: day.Add(72 * time.Hour)}, // after range
+	}, nil)
+
+	tickets, err := repo.GetReviewerCountAndAverageRatingByTicket(context.Background(), 1, day, day.AddDate(0, 0, 2))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expected := []models.TicketReviewerRatings{
+		{TicketID: 1, ReviewerCount: 1, AverageRating: 3},
+		{TicketID: 2, ReviewerCount: 2, AverageRating: 11.0 / 3},
+	}
+	if len(tickets) != len(expected) {
+		t.Fatalf("Expected %d tickets, got %+v", len(expected), tickets)
+	}
+	for i, ticket := range tickets {
+		if ticket.TicketID != expected[i].TicketID || ticket.ReviewerCount != expected[i].ReviewerCount || math.Abs(ticket.AverageRating-expected[i].AverageRating) > 0.001 {
+			t.Errorf("Expected %+v, got %+v", expected[i], ticket)
+		}
+	}
+}
+
+func TestRatingsRepository_GetRatingCountByDayOfWeek(t *testing.T) {
+	db := testutil.NewTestDB(t)
+	repo := repository.NewRatingsRepository(db)
+
+	// 2019-10-06 is a Sunday
+	sunday := time.Date(2019, 10, 6, 0, 0, 0, 0, time.UTC)
+	testutil.SeedTestData(t, db, []models.Rating{
+		{ID: 1, Rating: 5, TicketID: 1, RatingCategoryID: 1, CreatedAt: sunday.Add(1 * time.Hour)},
+		{ID: 2, Rating: 2, TicketID: 2, RatingCategoryID: 2, CreatedAt: sunday.Add(23 * time.Hour)},
+		{ID: 3, Rating: 4, TicketID: 3, RatingCategoryID: 1, CreatedAt: sunday.Add(2*24*time.Hour + 12*time.Hour)}, // Tuesday
+		{ID: 4, Rating: 1, TicketID: 4, RatingCategoryID: 1, CreatedAt: sunday.Add(6*24*time.Hour + 8*time.Hour)},  // Saturday
+		{ID: 5, Rating: 3, TicketID: 5, RatingCategoryID: 2, CreatedAt: sunday.Add(6*24*time.Hour + 23*time.Hour)}, // Saturday, late on the end date
+		{ID: 6, Rating: 3, TicketID: 6, RatingCategoryID: 1, CreatedAt: sunday.Add(7 * 24 * time.Hour)},            // after range
+		{ID: 7, Rating: 1, TicketID: 7, RatingCategoryID: 1, CreatedAt: sunday.Add(-time.Hour)},                    // before range
+	}, nil)
+
+	counts, err := repo.GetRatingCountByDayOfWeek(context.Background(), sunday, sunday.AddDate(0, 0, 6))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expected := [7]int{2, 0, 1, 0, 0, 0, 2}
+	if counts != expected {
+		t.Errorf("Expected %v, got %v", expected, counts)
+	}
+}
+
+func TestRatingsRepository_GetCountByCategoryIDAndDateRange(t *testing.T) {
+	db := testutil.NewTestDB(t)
+	repo := repository.NewRatingsRepository(db)
+
+	day := time.Date(2019, 10, 1, 0, 0, 0, 0, time.UTC)
+	testutil.SeedTestData(t, db, []models.Rating{
+		{ID: 1, Rating: 5, TicketID: 1, RatingCategoryID: 1, CreatedAt: day.Add(1 * time.Hour)},
+		{ID: 2, Rating: 2, TicketID: 1, RatingCategoryID: 2, CreatedAt: day.Add(23 * time.Hour)},
+		{ID: 3, Rating: 4, TicketID: 2, RatingCategoryID: 1, CreatedAt: day.Add(71 * time.Hour)}, // end date, late in the day
+		{ID: 4, Rating: 3, TicketID: 3, RatingCategoryID: 1, CreatedAt: day.Add(72 * time.Hour)}, // after range
+		{ID: 5, Rating: 1, TicketID: 4, RatingCategoryID: 1, CreatedAt: day.Add(-1 * time.Hour)}, // before range
+	}, nil)
+
+	counts, err := repo.GetCountByCategoryIDAndDateRange(context.Background(), day, day.AddDate(0, 0, 2))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expected := map[string]int{"2019-10-01": 2, "2019-10-03": 1}
+	if len(counts) != len(expected) {
+		t.Fatalf("Expected %d days, got %v", len(expected), counts)
+	}
+	for date, count := range expected {
+		if counts[date] != count {
+			t.Errorf("Expected %d ratings on %s, got %d", count, date, counts[date])
+		}
+	}
+}
+
+func TestRatingsRepository_GetByTicketIDBeforeTime(t *testing.T) {
+	db := testutil.NewTestDB(t)
+	repo := repository.NewRatingsRepository(db)
+
+	day := time.Date(2019, 10, 1, 0, 0, 0, 0, time.UTC)
+	testutil.SeedTestData(t, db, []models.Rating{
+		{ID: 1, Rating: 5, TicketID: 1, RatingCategoryID: 1, CreatedAt: day.Add(2 * time.Hour)},
+		{ID: 2, Rating: 4, TicketID: 1, RatingCategoryID: 2, CreatedAt: day.Add(1 * time.Hour)},
+		{ID: 3, Rating: 3, TicketID: 1, RatingCategoryID: 1, CreatedAt: day.Add(3 * time.Hour)}, // exactly at the cutoff
+		{ID: 4, Rating: 2, TicketID: 1, RatingCategoryID: 1, CreatedAt: day.Add(3*time.Hour + time.Second)},
+		{ID: 5, Rating: 1, TicketID: 2, RatingCategoryID: 1, CreatedAt: day.Add(1 * time.Hour)},
+	}, nil)
+
+	ratings, err := repo.GetByTicketIDBeforeTime(context.Background(), 1, day.Add(3*time.Hour))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	ids := make([]int, len(ratings))
+	for i, rating := range ratings {
+		ids[i] = rating.ID
+	}
+	assertIDs(t, "rating", []int{2, 1, 3}, ids)
+}
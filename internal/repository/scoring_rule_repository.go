@@ -0,0 +1,66 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"ticket-score-service/internal/database"
+	"ticket-score-service/internal/models"
+)
+
+// CreateScoringRulesTable creates the scoring_rules table if it does not exist.
+// It is a database.Migration.
+func CreateScoringRulesTable(ctx context.Context, conn *sql.DB) error {
+	query := `CREATE TABLE IF NOT EXISTS scoring_rules (
+				id INTEGER PRIMARY KEY AUTOINCREMENT,
+				if_category_id INTEGER NOT NULL,
+				if_max_rating INTEGER NOT NULL,
+				then_category_id INTEGER NOT NULL,
+				then_max_rating INTEGER NOT NULL
+			  )`
+
+	if _, err := conn.ExecContext(ctx, query); err != nil {
+		return fmt.Errorf("failed to create scoring_rules table: %w", err)
+	}
+
+	return nil
+}
+
+type ScoringRuleRepository struct {
+	db database.WrappedDB
+}
+
+func NewScoringRuleRepository(db database.WrappedDB) *ScoringRuleRepository {
+	return &ScoringRuleRepository{
+		db: db,
+	}
+}
+
+// GetScoringRules gets every scoring rule, ordered by ID
+func (r *ScoringRuleRepository) GetScoringRules(ctx context.Context) ([]models.ScoringRule, error) {
+	query := `SELECT id, if_category_id, if_max_rating, then_category_id, then_max_rating
+			  FROM scoring_rules
+			  ORDER BY id`
+
+	rows, err := r.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query scoring rules: %w", err)
+	}
+	defer rows.Close()
+
+	var rules []models.ScoringRule
+	for rows.Next() {
+		var rule models.ScoringRule
+		if err := rows.Scan(&rule.ID, &rule.IfCategoryID, &rule.IfMaxRating, &rule.ThenCategoryID, &rule.ThenMaxRating); err != nil {
+			return nil, fmt.Errorf("failed to scan scoring rule: %w", err)
+		}
+		rules = append(rules, rule)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+
+	return rules, nil
+}
@@ -0,0 +1,46 @@
+package repository_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"ticket-score-service/internal/models"
+	"ticket-score-service/internal/repository"
+	"ticket-score-service/internal/testutil"
+)
+
+func TestScoringRuleRepository_GetScoringRules(t *testing.T) {
+	ctx := context.Background()
+	db := testutil.NewTestDB(t)
+	// The test database already ran the migration; running it again is harmless
+	if err := repository.CreateScoringRulesTable(ctx, db.GetConnection()); err != nil {
+		t.Fatalf("Failed to rerun migration: %v", err)
+	}
+	repo := repository.NewScoringRuleRepository(db)
+
+	rules, err := repo.GetScoringRules(ctx)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(rules) != 0 {
+		t.Errorf("Expected no rules, got %+v", rules)
+	}
+
+	if _, err := db.GetConnection().ExecContext(ctx, `INSERT INTO scoring_rules (if_category_id, if_max_rating, then_category_id, then_max_rating)
+		VALUES (2, 1, 1, 3), (3, 2, 4, 4)`); err != nil {
+		t.Fatalf("Failed to insert rules: %v", err)
+	}
+
+	rules, err = repo.GetScoringRules(ctx)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	expected := []models.ScoringRule{
+		{ID: 1, IfCategoryID: 2, IfMaxRating: 1, ThenCategoryID: 1, ThenMaxRating: 3},
+		{ID: 2, IfCategoryID: 3, IfMaxRating: 2, ThenCategoryID: 4, ThenMaxRating: 4},
+	}
+	if !reflect.DeepEqual(rules, expected) {
+		t.Errorf("Expected %+v, got %+v", expected, rules)
+	}
+}
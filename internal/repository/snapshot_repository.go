@@ -0,0 +1,137 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"ticket-score-service/internal/database"
+	"ticket-score-service/internal/models"
+)
+
+// CreateWeeklyScoreSnapshotsTable creates the weekly_score_snapshots table if it does not exist.
+// It is a database.Migration.
+func CreateWeeklyScoreSnapshotsTable(ctx context.Context, conn *sql.DB) error {
+	query := `CREATE TABLE IF NOT EXISTS weekly_score_snapshots (
+				id INTEGER PRIMARY KEY AUTOINCREMENT,
+				snapshot_id INTEGER NOT NULL,
+				week_label TEXT NOT NULL,
+				category_id INTEGER NOT NULL,
+				category_name TEXT NOT NULL,
+				score TEXT NOT NULL,
+				rating_count INTEGER NOT NULL,
+				created_at DATETIME NOT NULL,
+				UNIQUE (snapshot_id, category_id)
+			  )`
+
+	if _, err := conn.ExecContext(ctx, query); err != nil {
+		return fmt.Errorf("failed to create weekly_score_snapshots table: %w", err)
+	}
+
+	return nil
+}
+
+type SnapshotRepository struct {
+	db database.WrappedDB
+}
+
+func NewSnapshotRepository(db database.WrappedDB) *SnapshotRepository {
+	return &SnapshotRepository{
+		db: db,
+	}
+}
+
+// CreateSnapshot stores scores as a new snapshot in a single transaction and returns its ID.
+// The SnapshotID, WeekLabel and CreatedAt fields of scores are ignored.
+func (r *SnapshotRepository) CreateSnapshot(ctx context.Context, weekLabel string, createdAt time.Time, scores []models.SnapshotScore) (int, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	var snapshotID int
+	err = tx.QueryRowContext(ctx, `SELECT COALESCE(MAX(snapshot_id), 0) + 1 FROM weekly_score_snapshots`).Scan(&snapshotID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to allocate snapshot ID: %w", err)
+	}
+
+	query := `INSERT INTO weekly_score_snapshots (snapshot_id, week_label, category_id, category_name, score, rating_count, created_at)
+			  VALUES (?, ?, ?, ?, ?, ?, ?)`
+
+	for _, score := range scores {
+		_, err := tx.ExecContext(ctx, query, snapshotID, weekLabel, score.CategoryID, score.CategoryName, score.Score, score.RatingCount, createdAt)
+		if err != nil {
+			return 0, fmt.Errorf("failed to insert snapshot score: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return snapshotID, nil
+}
+
+// GetBySnapshotID gets the scores of a snapshot, ordered by category ID
+func (r *SnapshotRepository) GetBySnapshotID(ctx context.Context, snapshotID int) ([]models.SnapshotScore, error) {
+	query := `SELECT snapshot_id, week_label, category_id, category_name, score, rating_count, created_at
+			  FROM weekly_score_snapshots
+			  WHERE snapshot_id = ?
+			  ORDER BY category_id`
+
+	rows, err := r.db.QueryContext(ctx, query, snapshotID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query snapshot: %w", err)
+	}
+	defer rows.Close()
+
+	var scores []models.SnapshotScore
+	for rows.Next() {
+		var score models.SnapshotScore
+		err := rows.Scan(&score.SnapshotID, &score.WeekLabel, &score.CategoryID, &score.CategoryName,
+			&score.Score, &score.RatingCount, &score.CreatedAt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan snapshot score: %w", err)
+		}
+		scores = append(scores, score)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+
+	return scores, nil
+}
+
+// ListSnapshots lists the snapshots of weeks starting within a date range, ordered by snapshot ID.
+// Week labels begin with the week's start date, which is what the range is matched against.
+func (r *SnapshotRepository) ListSnapshots(ctx context.Context, startDate, endDate time.Time) ([]models.SnapshotSummary, error) {
+	query := `SELECT snapshot_id, week_label, created_at, COUNT(*)
+			  FROM weekly_score_snapshots
+			  WHERE substr(week_label, 1, 10) >= ? AND substr(week_label, 1, 10) <= ?
+			  GROUP BY snapshot_id, week_label, created_at
+			  ORDER BY snapshot_id`
+
+	rows, err := r.db.QueryContext(ctx, query, startDate.Format("2006-01-02"), endDate.Format("2006-01-02"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to query snapshots: %w", err)
+	}
+	defer rows.Close()
+
+	var summaries []models.SnapshotSummary
+	for rows.Next() {
+		var summary models.SnapshotSummary
+		if err := rows.Scan(&summary.SnapshotID, &summary.WeekLabel, &summary.CreatedAt, &summary.CategoryCount); err != nil {
+			return nil, fmt.Errorf("failed to scan snapshot: %w", err)
+		}
+		summaries = append(summaries, summary)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+
+	return summaries, nil
+}
@@ -0,0 +1,84 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"ticket-score-service/internal/models"
+	"ticket-score-service/internal/repository"
+	"ticket-score-service/internal/testutil"
+)
+
+func TestSnapshotRepository(t *testing.T) {
+	ctx := context.Background()
+	db := testutil.NewTestDB(t)
+	// The test database already ran the migration; running it again is harmless
+	if err := repository.CreateWeeklyScoreSnapshotsTable(ctx, db.GetConnection()); err != nil {
+		t.Fatalf("Failed to rerun migration: %v", err)
+	}
+	repo := repository.NewSnapshotRepository(db)
+
+	createdAt := time.Date(2019, 10, 14, 9, 0, 0, 0, time.UTC)
+	weeks := []struct {
+		label  string
+		scores []models.SnapshotScore
+	}{
+		{"2019-09-30 to 2019-10-06", []models.SnapshotScore{{CategoryID: 1, CategoryName: "Spelling", Score: "80%", RatingCount: 4}}},
+		{"2019-10-07 to 2019-10-13", []models.SnapshotScore{
+			{CategoryID: 2, CategoryName: "Grammar", Score: "N/A"},
+			{CategoryID: 1, CategoryName: "Spelling", Score: "90%", RatingCount: 2},
+		}},
+	}
+	for i, week := range weeks {
+		snapshotID, err := repo.CreateSnapshot(ctx, week.label, createdAt, week.scores)
+		if err != nil {
+			t.Fatalf("Failed to create snapshot: %v", err)
+		}
+		if snapshotID != i+1 {
+			t.Errorf("Expected snapshot ID %d, got %d", i+1, snapshotID)
+		}
+	}
+
+	scores, err := repo.GetBySnapshotID(ctx, 2)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(scores) != 2 || scores[0].CategoryID != 1 || scores[1].CategoryID != 2 {
+		t.Fatalf("Expected scores ordered by category ID, got %+v", scores)
+	}
+	if scores[0].Score != "90%" || scores[0].RatingCount != 2 || !scores[0].CreatedAt.Equal(createdAt) {
+		t.Errorf("Unexpected score row %+v", scores[0])
+	}
+
+	tests := []struct {
+		name      string
+		startDate time.Time
+		endDate   time.Time
+		expected  []int
+	}{
+		{"both weeks", time.Date(2019, 9, 30, 0, 0, 0, 0, time.UTC), time.Date(2019, 10, 7, 0, 0, 0, 0, time.UTC), []int{1, 2}},
+		{"week starting in range only", time.Date(2019, 10, 1, 0, 0, 0, 0, time.UTC), time.Date(2019, 10, 31, 0, 0, 0, 0, time.UTC), []int{2}},
+		{"no weeks", time.Date(2019, 11, 1, 0, 0, 0, 0, time.UTC), time.Date(2019, 11, 30, 0, 0, 0, 0, time.UTC), nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			summaries, err := repo.ListSnapshots(ctx, tt.startDate, tt.endDate)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if len(summaries) != len(tt.expected) {
+				t.Fatalf("Expected %d snapshots, got %d", len(tt.expected), len(summaries))
+			}
+			for i, summary := range summaries {
+				if summary.SnapshotID != tt.expected[i] {
+					t.Errorf("Expected snapshot %d, got %d", tt.expected[i], summary.SnapshotID)
+				}
+				if summary.CategoryCount != len(weeks[summary.SnapshotID-1].scores) {
+					t.Errorf("Expected %d categories, got %d", len(weeks[summary.SnapshotID-1].scores), summary.CategoryCount)
+				}
+			}
+		})
+	}
+}
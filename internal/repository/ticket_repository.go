@@ -2,18 +2,19 @@ package repository
 
 import (
 	"context"
-	"database/sql"
 	"fmt"
+	"strings"
 	"time"
 
+	"ticket-score-service/internal/database"
 	"ticket-score-service/internal/models"
 )
 
 type TicketRepository struct {
-	db *sql.DB
+	db database.WrappedDB
 }
 
-func NewTicketRepository(db *sql.DB) *TicketRepository {
+func NewTicketRepository(db database.WrappedDB) *TicketRepository {
 	return &TicketRepository{
 		db: db,
 	}
@@ -25,7 +26,70 @@ func (r *TicketRepository) GetByCreatedDateRange(ctx context.Context, startDate,
 			  WHERE created_at >= ? AND created_at < ?
 			  ORDER BY created_at DESC`
 
-	rows, err := r.db.QueryContext(ctx, query, startDate, endDate)
+	return r.queryTickets(ctx, query, startDate, endDate)
+}
+
+// GetTicketsByRevieweeAndDateRange gets one page of the tickets in which a reviewee was rated in a
+// date range, newest first, and the number of such tickets across all pages
+func (r *TicketRepository) GetTicketsByRevieweeAndDateRange(ctx context.Context, revieweeID int, startDate, endDate time.Time, limit, offset int) ([]models.Ticket, int, error) {
+	start, end := dayRange(startDate, endDate)
+
+	countQuery := `SELECT COUNT(DISTINCT t.id)
+				   FROM tickets t
+				   JOIN ratings r ON t.id = r.ticket_id
+				   WHERE r.reviewee_id = ? AND r.created_at >= ? AND r.created_at < ?`
+
+	var totalCount int
+	if err := r.db.QueryRowContext(ctx, countQuery, revieweeID, start, end).Scan(&totalCount); err != nil {
+		return nil, 0, fmt.Errorf("failed to count reviewee tickets: %w", err)
+	}
+
+	query := `SELECT DISTINCT t.id, t.subject, t.created_at
+			  FROM tickets t
+			  JOIN ratings r ON t.id = r.ticket_id
+			  WHERE r.reviewee_id = ? AND r.created_at >= ? AND r.created_at < ?
+			  ORDER BY t.created_at DESC, t.id DESC
+			  LIMIT ? OFFSET ?`
+
+	tickets, err := r.queryTickets(ctx, query, revieweeID, start, end, limit, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get reviewee tickets: %w", err)
+	}
+
+	return tickets, totalCount, nil
+}
+
+// likeEscaper escapes the LIKE wildcards in a literal search term
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// GroupTicketsBySubjectKeyword gets the tickets created in a date range whose subject contains each
+// keyword, ignoring case, newest first. A ticket matching several keywords is in each of their groups,
+// and keywords without matches are left out of the map.
+func (r *TicketRepository) GroupTicketsBySubjectKeyword(ctx context.Context, keywords []string, startDate, endDate time.Time) (map[string][]models.Ticket, error) {
+	start, end := dayRange(startDate, endDate)
+
+	query := `SELECT id, subject, created_at
+			  FROM tickets
+			  WHERE subject LIKE ? ESCAPE '\' AND created_at >= ? AND created_at < ?
+			  ORDER BY created_at DESC, id DESC`
+
+	groups := make(map[string][]models.Ticket)
+	for _, keyword := range keywords {
+		tickets, err := r.queryTickets(ctx, query, "%"+likeEscaper.Replace(keyword)+"%", start, end)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get tickets for keyword %q: %w", keyword, err)
+		}
+		if len(tickets) > 0 {
+			groups[keyword] = tickets
+		}
+	}
+
+	return groups, nil
+}
+
+// queryTickets runs a query selecting id, subject and created_at from tickets
+func (r *TicketRepository) queryTickets(ctx context.Context, query string, args ...any) ([]models.Ticket, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to query tickets: %w", err)
 	}
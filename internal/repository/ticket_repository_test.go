@@ -0,0 +1,108 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"ticket-score-service/internal/models"
+	"ticket-score-service/internal/repository"
+	"ticket-score-service/internal/testutil"
+)
+
+func TestTicketRepository_GetTicketsByRevieweeAndDateRange(t *testing.T) {
+	db := testutil.NewTestDB(t)
+	repo := repository.NewTicketRepository(db)
+
+	day := time.Date(2019, 10, 1, 0, 0, 0, 0, time.UTC)
+	testutil.SeedTickets(t, db, []models.Ticket{
+		{ID: 1, Subject: "Refund", CreatedAt: day.Add(-48 * time.Hour)},
+		{ID: 2, Subject: "Login", CreatedAt: day.Add(-24 * time.Hour)},
+		{ID: 3, Subject: "Billing", CreatedAt: day},
+		{ID: 4, Subject: "Shipping", CreatedAt: day.Add(-72 * time.Hour)},
+		{ID: 5, Subject: "Other reviewee", CreatedAt: day},
+	})
+	testutil.SeedTestData(t, db, []models.Rating{
+		// Ticket 1 is rated three times and must be listed once
+		{ID: 1, Rating: 5, TicketID: 1, RatingCategoryID: 1, RevieweeID: 7, CreatedAt: day.Add(1 * time.Hour)},
+		{ID: 2, Rating: 4, TicketID: 1, RatingCategoryID: 2, RevieweeID: 7, CreatedAt: day.Add(2 * time.Hour)},
+		{ID: 3, Rating: 3, TicketID: 1, RatingCategoryID: 1, RevieweeID: 7, CreatedAt: day.Add(26 * time.Hour)},
+		{ID: 4, Rating: 2, TicketID: 2, RatingCategoryID: 1, RevieweeID: 7, CreatedAt: day.Add(47 * time.Hour)}, // end date, late in the day
+		{ID: 5, Rating: 1, TicketID: 3, RatingCategoryID: 1, RevieweeID: 7, CreatedAt: day.Add(3 * time.Hour)},
+		{ID: 6, Rating: 1, TicketID: 4, RatingCategoryID: 1, RevieweeID: 7, CreatedAt: day.Add(48 * time.Hour)}, // after range
+		{ID: 7, Rating: 1, TicketID: 5, RatingCategoryID: 1, RevieweeID: 8, CreatedAt: day.Add(3 * time.Hour)},
+	}, nil)
+
+	startDate := day
+	endDate := day.AddDate(0, 0, 1)
+
+	tests := []struct {
+		name        string
+		revieweeID  int
+		limit       int
+		offset      int
+		expectedIDs []int
+		expectedAll int
+	}{
+		{name: "all tickets newest first", revieweeID: 7, limit: 10, offset: 0, expectedIDs: []int{3, 2, 1}, expectedAll: 3},
+		{name: "first page", revieweeID: 7, limit: 2, offset: 0, expectedIDs: []int{3, 2}, expectedAll: 3},
+		{name: "last page", revieweeID: 7, limit: 2, offset: 2, expectedIDs: []int{1}, expectedAll: 3},
+		{name: "past the last page", revieweeID: 7, limit: 2, offset: 4, expectedIDs: nil, expectedAll: 3},
+		{name: "reviewee without ratings", revieweeID: 9, limit: 10, offset: 0, expectedIDs: nil, expectedAll: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tickets, totalCount, err := repo.GetTicketsByRevieweeAndDateRange(context.Background(), tt.revieweeID, startDate, endDate, tt.limit, tt.offset)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if totalCount != tt.expectedAll {
+				t.Errorf("Expected total count %d, got %d", tt.expectedAll, totalCount)
+			}
+
+			ids := make([]int, len(tickets))
+			for i, ticket := range tickets {
+				ids[i] = ticket.ID
+			}
+			assertIDs(t, "ticket", tt.expectedIDs, ids)
+		})
+	}
+}
+
+func TestTicketRepository_GroupTicketsBySubjectKeyword(t *testing.T) {
+	db := testutil.NewTestDB(t)
+	repo := repository.NewTicketRepository(db)
+
+	day := time.Date(2019, 10, 1, 0, 0, 0, 0, time.UTC)
+	testutil.SeedTickets(t, db, []models.Ticket{
+		{ID: 1, Subject: "Billing question", CreatedAt: day},
+		{ID: 2, Subject: "Wrong BILLING address", CreatedAt: day.Add(30 * time.Hour)}, // end date, late in the day
+		{ID: 3, Subject: "Technical issue", CreatedAt: day.Add(time.Hour)},
+		{ID: 4, Subject: "Billing before range", CreatedAt: day.Add(-time.Hour)},
+		{ID: 5, Subject: "Technical billing problem", CreatedAt: day.Add(2 * time.Hour)},
+		{ID: 6, Subject: "100% refund", CreatedAt: day},
+		{ID: 7, Subject: "1000 refunds", CreatedAt: day},
+	})
+
+	groups, err := repo.GroupTicketsBySubjectKeyword(context.Background(), []string{"billing", "technical", "100%", "shipping"}, day, day.AddDate(0, 0, 1))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expected := map[string][]int{
+		"billing":   {2, 5, 1},
+		"technical": {5, 3},
+		"100%":      {6},
+	}
+	if len(groups) != len(expected) {
+		t.Errorf("Expected %d groups, got %d", len(expected), len(groups))
+	}
+	for keyword, expectedIDs := range expected {
+		ids := make([]int, len(groups[keyword]))
+		for i, ticket := range groups[keyword] {
+			ids[i] = ticket.ID
+		}
+		assertIDs(t, keyword+" ticket", expectedIDs, ids)
+	}
+}
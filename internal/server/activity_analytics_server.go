@@ -0,0 +1,74 @@
+package server
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"ticket-score-service/internal/apperror"
+	"ticket-score-service/internal/service"
+	"ticket-score-service/internal/utils"
+	pb "ticket-score-service/proto/generated/activity_analytics"
+)
+
+// ActivityAnalyticsServer implements the gRPC server for activity analytics
+type ActivityAnalyticsServer struct {
+	pb.UnimplementedActivityAnalyticsServiceServer
+	activityService *service.ActivityAnalyticsService
+}
+
+// NewActivityAnalyticsServer creates a new gRPC server instance
+func NewActivityAnalyticsServer(activityService *service.ActivityAnalyticsService) *ActivityAnalyticsServer {
+	return &ActivityAnalyticsServer{
+		activityService: activityService,
+	}
+}
+
+// GetPeakHourAnalysis handles the gRPC request for the peak hour analysis
+func (s *ActivityAnalyticsServer) GetPeakHourAnalysis(ctx context.Context, req *pb.GetPeakHourAnalysisRequest) (*pb.PeakHourReport, error) {
+	dateRange, err := utils.ParseDateRange(req.StartDate, req.EndDate)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// Call service layer
+	report, err := s.activityService.GetPeakHourAnalysis(ctx, dateRange.Start, dateRange.End)
+	if err != nil {
+		return nil, apperror.ToGRPCError(err, "failed to get peak hour analysis")
+	}
+
+	// Convert to proto response
+	pbHours := make([]*pb.HourActivity, 0, len(report.Hours))
+	for _, hour := range report.Hours {
+		pbHours = append(pbHours, &pb.HourActivity{
+			Hour:         int32(hour.Hour),
+			RatingCount:  int32(hour.RatingCount),
+			AverageScore: hour.AverageScore,
+		})
+	}
+
+	return &pb.PeakHourReport{Hours: pbHours}, nil
+}
+
+// GetWeekdayVolume handles the gRPC request for the number of ratings made on each day of the week
+func (s *ActivityAnalyticsServer) GetWeekdayVolume(ctx context.Context, req *pb.GetWeekdayVolumeRequest) (*pb.WeekdayVolume, error) {
+	dateRange, err := utils.ParseDateRange(req.StartDate, req.EndDate)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// Call service layer
+	counts, err := s.activityService.GetWeekdayVolume(ctx, dateRange.Start, dateRange.End)
+	if err != nil {
+		return nil, apperror.ToGRPCError(err, "failed to get weekday volume")
+	}
+
+	// Convert to proto response
+	ratingsByWeekday := make([]int32, len(counts))
+	for weekday, count := range counts {
+		ratingsByWeekday[weekday] = int32(count)
+	}
+
+	return &pb.WeekdayVolume{RatingsByWeekday: ratingsByWeekday}, nil
+}
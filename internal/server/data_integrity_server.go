@@ -0,0 +1,85 @@
+package server
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"ticket-score-service/internal/apperror"
+	"ticket-score-service/internal/service"
+	"ticket-score-service/internal/utils"
+	pb "ticket-score-service/proto/generated/data_integrity"
+)
+
+// DataIntegrityServer implements the gRPC server for data integrity checks
+type DataIntegrityServer struct {
+	pb.UnimplementedDataIntegrityServiceServer
+	integrityService *service.DataIntegrityService
+	qualityService   *service.DataQualityService
+}
+
+// DataIntegrityServerOption configures a DataIntegrityServer
+type DataIntegrityServerOption func(*DataIntegrityServer)
+
+// WithDataQualityService enables GetRatingQualityMetrics
+func WithDataQualityService(qualityService *service.DataQualityService) DataIntegrityServerOption {
+	return func(s *DataIntegrityServer) {
+		s.qualityService = qualityService
+	}
+}
+
+// NewDataIntegrityServer creates a new gRPC server instance
+func NewDataIntegrityServer(integrityService *service.DataIntegrityService, opts ...DataIntegrityServerOption) *DataIntegrityServer {
+	s := &DataIntegrityServer{
+		integrityService: integrityService,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// CheckDataIntegrity handles the gRPC request for a data integrity check
+func (s *DataIntegrityServer) CheckDataIntegrity(ctx context.Context, req *pb.CheckDataIntegrityRequest) (*pb.IntegrityReport, error) {
+	// Call service layer
+	report, err := s.integrityService.CheckDataIntegrity(ctx)
+	if err != nil {
+		return nil, apperror.ToGRPCError(err, "failed to check data integrity")
+	}
+
+	// Convert to proto response
+	return &pb.IntegrityReport{
+		OrphanedRatings:    int32(report.OrphanedRatings),
+		InvalidRatings:     int32(report.InvalidRatings),
+		OrphanedTicketRefs: int32(report.OrphanedTicketRefs),
+		IsClean:            report.IsClean,
+	}, nil
+}
+
+// GetRatingQualityMetrics handles the gRPC request for rating data quality metrics
+func (s *DataIntegrityServer) GetRatingQualityMetrics(ctx context.Context, req *pb.GetRatingQualityMetricsRequest) (*pb.RatingQualityMetrics, error) {
+	if s.qualityService == nil {
+		return nil, status.Error(codes.FailedPrecondition, "rating data quality metrics are not configured")
+	}
+
+	// Validate request
+	dateRange, err := utils.ParseDateRange(req.StartDate, req.EndDate)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// Call service layer
+	metrics, err := s.qualityService.GetRatingQualityMetrics(ctx, dateRange.Start, dateRange.End)
+	if err != nil {
+		return nil, apperror.ToGRPCError(err, "failed to get rating quality metrics")
+	}
+
+	// Convert to proto response
+	return &pb.RatingQualityMetrics{
+		CoverageRate:            metrics.CoverageRate,
+		AverageRatingsPerTicket: metrics.AverageRatingsPerTicket,
+		ReviewerDiversityIndex:  metrics.ReviewerDiversityIndex,
+		DuplicateRate:           metrics.DuplicateRate,
+	}, nil
+}
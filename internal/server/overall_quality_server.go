@@ -2,66 +2,232 @@ package server
 
 import (
 	"context"
+	"strings"
 	"time"
 
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"ticket-score-service/internal/apperror"
 	"ticket-score-service/internal/service"
+	"ticket-score-service/internal/utils"
 	pb "ticket-score-service/proto/generated/overall_quality"
 )
 
 // OverallQualityServiceInterface defines the interface for the overall quality service
 type OverallQualityServiceInterface interface {
 	GetOverallQualityScore(ctx context.Context, startDate, endDate time.Time) (*service.OverallQualityScore, error)
+	GetOverallQualityScoreStream(ctx context.Context, startDate, endDate time.Time) (<-chan service.QualityProgress, <-chan error)
+	GetOverallQualityScoreBreakdown(ctx context.Context, startDate, endDate time.Time) (*service.OverallQualityBreakdown, error)
+	GetOverallQualityScoreHistory(ctx context.Context, startDate, endDate time.Time) (*service.QualityHistory, error)
+}
+
+// SubjectGroupServiceInterface defines the interface for the subject group analytics service
+type SubjectGroupServiceInterface interface {
+	GetQualityBySubjectGroup(ctx context.Context, keywords []string, startDate, endDate time.Time) ([]service.SubjectGroupQuality, error)
 }
 
 // OverallQualityServer implements the gRPC OverallQualityService
 type OverallQualityServer struct {
 	pb.UnimplementedOverallQualityServiceServer
-	serviceLayer OverallQualityServiceInterface
+	serviceLayer  OverallQualityServiceInterface
+	subjectGroups SubjectGroupServiceInterface
+}
+
+// OverallQualityServerOption configures an OverallQualityServer
+type OverallQualityServerOption func(*OverallQualityServer)
+
+// WithSubjectGroupService enables GetQualityBySubjectGroup
+func WithSubjectGroupService(subjectGroups SubjectGroupServiceInterface) OverallQualityServerOption {
+	return func(s *OverallQualityServer) {
+		s.subjectGroups = subjectGroups
+	}
 }
 
 // NewOverallQualityServer creates a new gRPC server for overall quality operations
-func NewOverallQualityServer(serviceLayer OverallQualityServiceInterface) *OverallQualityServer {
-	return &OverallQualityServer{
+func NewOverallQualityServer(serviceLayer OverallQualityServiceInterface, opts ...OverallQualityServerOption) *OverallQualityServer {
+	s := &OverallQualityServer{
 		serviceLayer: serviceLayer,
 	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
 }
 
 // GetOverallQualityScore handles gRPC requests for calculating overall quality scores
 func (s *OverallQualityServer) GetOverallQualityScore(ctx context.Context, req *pb.GetOverallQualityScoreRequest) (*pb.GetOverallQualityScoreResponse, error) {
 	// Validate request
-	if req.StartDate == "" || req.EndDate == "" {
-		return nil, status.Errorf(codes.InvalidArgument, "start_date and end_date are required")
+	dateRange, err := utils.ParseDateRange(req.StartDate, req.EndDate)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
+	startDate, endDate := dateRange.Start, dateRange.End
 
-	// Parse dates
-	startDate, err := time.Parse("2006-01-02", req.StartDate)
+	// Call service layer
+	result, err := s.serviceLayer.GetOverallQualityScore(ctx, startDate, endDate)
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "invalid start_date format, expected YYYY-MM-DD: %v", err)
+		return nil, apperror.ToGRPCError(err, "failed to calculate overall quality score")
+	}
+
+	// Convert to proto response
+	response := &pb.GetOverallQualityScoreResponse{
+		Period: result.Period,
+		Score:  result.Score,
 	}
 
-	endDate, err := time.Parse("2006-01-02", req.EndDate)
+	return response, nil
+}
+
+// GetOverallQualityScoreStream handles the gRPC streaming request for overall quality score progress
+func (s *OverallQualityServer) GetOverallQualityScoreStream(req *pb.GetOverallQualityScoreRequest, stream grpc.ServerStreamingServer[pb.ProgressUpdate]) error {
+	// Validate request
+	dateRange, err := utils.ParseDateRange(req.StartDate, req.EndDate)
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "invalid end_date format, expected YYYY-MM-DD: %v", err)
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	startDate, endDate := dateRange.Start, dateRange.End
+
+	// Get progress stream
+	ctx := stream.Context()
+	progress, errorChan := s.serviceLayer.GetOverallQualityScoreStream(ctx, startDate, endDate)
+
+	// Stream results
+	for {
+		select {
+		case update, ok := <-progress:
+			if !ok {
+				// Channel closed, calculation finished
+				return nil
+			}
+
+			// Convert to proto message
+			protoUpdate := &pb.ProgressUpdate{
+				ChunksDone:   int32(update.ChunksDone),
+				TotalChunks:  int32(update.TotalChunks),
+				PartialScore: update.PartialScore,
+				IsFinal:      update.IsFinal,
+				Score:        update.Score,
+				Period:       update.Period,
+			}
+
+			// Send to client
+			if err := stream.Send(protoUpdate); err != nil {
+				return apperror.ToGRPCError(err, "failed to send progress update")
+			}
+
+		case err := <-errorChan:
+			if err != nil {
+				return apperror.ToGRPCError(err, "failed to calculate overall quality score")
+			}
+
+		case <-ctx.Done():
+			return status.Error(codes.Canceled, "request canceled")
+		}
 	}
+}
 
-	// Validate date range
-	if startDate.After(endDate) {
-		return nil, status.Errorf(codes.InvalidArgument, "start_date must be before or equal to end_date")
+// GetOverallQualityBreakdown handles gRPC requests for the per-category breakdown of the overall score
+func (s *OverallQualityServer) GetOverallQualityBreakdown(ctx context.Context, req *pb.GetOverallQualityScoreRequest) (*pb.OverallQualityBreakdown, error) {
+	// Validate request
+	dateRange, err := utils.ParseDateRange(req.StartDate, req.EndDate)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
 	// Call service layer
-	result, err := s.serviceLayer.GetOverallQualityScore(ctx, startDate, endDate)
+	breakdown, err := s.serviceLayer.GetOverallQualityScoreBreakdown(ctx, dateRange.Start, dateRange.End)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to calculate overall quality score: %v", err)
+		return nil, apperror.ToGRPCError(err, "failed to calculate overall quality breakdown")
 	}
 
 	// Convert to proto response
-	response := &pb.GetOverallQualityScoreResponse{
-		Period: result.Period,
-		Score:  result.Score,
+	contributions := make([]*pb.CategoryContribution, 0, len(breakdown.CategoryBreakdown))
+	for _, contribution := range breakdown.CategoryBreakdown {
+		contributions = append(contributions, &pb.CategoryContribution{
+			CategoryName:         contribution.CategoryName,
+			Weight:               contribution.Weight,
+			Score:                contribution.Score,
+			WeightedContribution: contribution.WeightedContribution,
+		})
+	}
+
+	return &pb.OverallQualityBreakdown{
+		Period:            breakdown.Period,
+		OverallScore:      breakdown.OverallScore,
+		CategoryBreakdown: contributions,
+	}, nil
+}
+
+// GetOverallQualityScoreHistory handles gRPC requests for the overall quality score of each day or week
+func (s *OverallQualityServer) GetOverallQualityScoreHistory(ctx context.Context, req *pb.GetOverallQualityScoreRequest) (*pb.QualityHistory, error) {
+	// Validate request
+	dateRange, err := utils.ParseDateRange(req.StartDate, req.EndDate)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// Call service layer
+	history, err := s.serviceLayer.GetOverallQualityScoreHistory(ctx, dateRange.Start, dateRange.End)
+	if err != nil {
+		return nil, apperror.ToGRPCError(err, "failed to calculate overall quality score history")
+	}
+
+	// Convert to proto response
+	dates := make([]*pb.PeriodScore, 0, len(history.Dates))
+	for _, date := range history.Dates {
+		dates = append(dates, &pb.PeriodScore{
+			Date:  date.Date,
+			Score: date.Score,
+		})
+	}
+
+	return &pb.QualityHistory{
+		Period: history.Period,
+		Dates:  dates,
+	}, nil
+}
+
+// GetQualityBySubjectGroup handles gRPC requests for the quality of tickets grouped by subject keyword
+func (s *OverallQualityServer) GetQualityBySubjectGroup(ctx context.Context, req *pb.GetQualityBySubjectGroupRequest) (*pb.GetQualityBySubjectGroupResponse, error) {
+	if s.subjectGroups == nil {
+		return nil, status.Error(codes.FailedPrecondition, "subject group analytics is not configured")
+	}
+
+	// Validate request
+	if len(req.Keywords) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "at least one keyword is required")
+	}
+	keywords := make([]string, len(req.Keywords))
+	for i, keyword := range req.Keywords {
+		keywords[i] = strings.TrimSpace(keyword)
+		if keywords[i] == "" {
+			return nil, status.Error(codes.InvalidArgument, "keywords must not be blank")
+		}
+	}
+
+	dateRange, err := utils.ParseDateRange(req.StartDate, req.EndDate)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// Call service layer
+	groups, err := s.subjectGroups.GetQualityBySubjectGroup(ctx, keywords, dateRange.Start, dateRange.End)
+	if err != nil {
+		return nil, apperror.ToGRPCError(err, "failed to calculate quality by subject group")
+	}
+
+	// Convert to proto response
+	response := &pb.GetQualityBySubjectGroupResponse{
+		Groups: make([]*pb.SubjectGroupQuality, 0, len(groups)),
+	}
+	for _, group := range groups {
+		response.Groups = append(response.Groups, &pb.SubjectGroupQuality{
+			Keyword:        group.Keyword,
+			MatchedTickets: int32(group.MatchedTickets),
+			AverageScore:   group.AverageScore,
+		})
 	}
 
 	return response, nil
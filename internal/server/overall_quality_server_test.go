@@ -3,9 +3,11 @@ package server
 import (
 	"context"
 	"errors"
+	"fmt"
 	"testing"
 	"time"
 
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
@@ -15,14 +17,39 @@ import (
 
 // Mock service for testing
 type mockOverallQualityService struct {
-	result *service.OverallQualityScore
-	err    error
+	result    *service.OverallQualityScore
+	progress  []service.QualityProgress
+	breakdown *service.OverallQualityBreakdown
+	history   *service.QualityHistory
+	err       error
 }
 
 func (m *mockOverallQualityService) GetOverallQualityScore(ctx context.Context, startDate, endDate time.Time) (*service.OverallQualityScore, error) {
 	return m.result, m.err
 }
 
+func (m *mockOverallQualityService) GetOverallQualityScoreStream(ctx context.Context, startDate, endDate time.Time) (<-chan service.QualityProgress, <-chan error) {
+	progressChan := make(chan service.QualityProgress, len(m.progress))
+	errorChan := make(chan error, 1)
+	for _, update := range m.progress {
+		progressChan <- update
+	}
+	if m.err != nil {
+		errorChan <- m.err
+	}
+	close(progressChan)
+	close(errorChan)
+	return progressChan, errorChan
+}
+
+func (m *mockOverallQualityService) GetOverallQualityScoreBreakdown(ctx context.Context, startDate, endDate time.Time) (*service.OverallQualityBreakdown, error) {
+	return m.breakdown, m.err
+}
+
+func (m *mockOverallQualityService) GetOverallQualityScoreHistory(ctx context.Context, startDate, endDate time.Time) (*service.QualityHistory, error) {
+	return m.history, m.err
+}
+
 func TestOverallQualityServer_GetOverallQualityScore(t *testing.T) {
 	tests := []struct {
 		name           string
@@ -94,6 +121,24 @@ func TestOverallQualityServer_GetOverallQualityScore(t *testing.T) {
 			serviceError:  errors.New("database connection failed"),
 			expectedError: codes.Internal,
 		},
+		{
+			name: "request canceled",
+			request: &pb.GetOverallQualityScoreRequest{
+				StartDate: "2024-01-01",
+				EndDate:   "2024-01-07",
+			},
+			serviceError:  fmt.Errorf("failed to get ratings count: %w", context.Canceled),
+			expectedError: codes.Canceled,
+		},
+		{
+			name: "deadline exceeded",
+			request: &pb.GetOverallQualityScoreRequest{
+				StartDate: "2024-01-01",
+				EndDate:   "2024-01-07",
+			},
+			serviceError:  fmt.Errorf("failed to get ratings count: %w", context.DeadlineExceeded),
+			expectedError: codes.DeadlineExceeded,
+		},
 		{
 			name: "no ratings found",
 			request: &pb.GetOverallQualityScoreRequest{
@@ -255,3 +300,155 @@ func TestOverallQualityServer_DateParsing(t *testing.T) {
 		})
 	}
 }
+
+// mockProgressStream collects messages sent on a ProgressUpdate stream
+type mockProgressStream struct {
+	grpc.ServerStream
+	ctx     context.Context
+	updates []*pb.ProgressUpdate
+}
+
+func (m *mockProgressStream) Context() context.Context {
+	return m.ctx
+}
+
+func (m *mockProgressStream) Send(update *pb.ProgressUpdate) error {
+	m.updates = append(m.updates, update)
+	return nil
+}
+
+func TestOverallQualityServer_GetOverallQualityScoreStream(t *testing.T) {
+	t.Run("streams all progress updates", func(t *testing.T) {
+		mockService := &mockOverallQualityService{
+			progress: []service.QualityProgress{
+				{ChunksDone: 1, TotalChunks: 2, PartialScore: "80%"},
+				{ChunksDone: 2, TotalChunks: 2, PartialScore: "85%"},
+				{ChunksDone: 2, TotalChunks: 2, IsFinal: true, Score: "85%", Period: "2024-01-01 to 2024-01-07"},
+			},
+		}
+		server := NewOverallQualityServer(mockService)
+		stream := &mockProgressStream{ctx: context.Background()}
+
+		err := server.GetOverallQualityScoreStream(&pb.GetOverallQualityScoreRequest{
+			StartDate: "2024-01-01",
+			EndDate:   "2024-01-07",
+		}, stream)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		if len(stream.updates) != 3 {
+			t.Fatalf("Expected 3 updates, got %d", len(stream.updates))
+		}
+
+		final := stream.updates[2]
+		if !final.IsFinal || final.Score != "85%" || final.Period != "2024-01-01 to 2024-01-07" {
+			t.Errorf("Unexpected final update: %v", final)
+		}
+		if stream.updates[0].ChunksDone != 1 || stream.updates[0].TotalChunks != 2 || stream.updates[0].PartialScore != "80%" {
+			t.Errorf("Unexpected first update: %v", stream.updates[0])
+		}
+	})
+
+	t.Run("invalid date range", func(t *testing.T) {
+		server := NewOverallQualityServer(&mockOverallQualityService{})
+		stream := &mockProgressStream{ctx: context.Background()}
+
+		err := server.GetOverallQualityScoreStream(&pb.GetOverallQualityScoreRequest{
+			StartDate: "2024-01-07",
+			EndDate:   "2024-01-01",
+		}, stream)
+
+		if status.Code(err) != codes.InvalidArgument {
+			t.Errorf("Expected InvalidArgument error, got %v", err)
+		}
+	})
+}
+
+// mockSubjectGroupService records the keywords it was called with
+type mockSubjectGroupService struct {
+	groups   []service.SubjectGroupQuality
+	err      error
+	keywords []string
+}
+
+func (m *mockSubjectGroupService) GetQualityBySubjectGroup(ctx context.Context, keywords []string, startDate, endDate time.Time) ([]service.SubjectGroupQuality, error) {
+	m.keywords = keywords
+	return m.groups, m.err
+}
+
+func TestOverallQualityServer_GetQualityBySubjectGroup(t *testing.T) {
+	tests := []struct {
+		name         string
+		request      *pb.GetQualityBySubjectGroupRequest
+		serviceErr   error
+		expectedCode codes.Code
+	}{
+		{
+			name:         "valid request",
+			request:      &pb.GetQualityBySubjectGroupRequest{Keywords: []string{" billing ", "technical"}, StartDate: "2024-01-01", EndDate: "2024-01-07"},
+			expectedCode: codes.OK,
+		},
+		{
+			name:         "no keywords",
+			request:      &pb.GetQualityBySubjectGroupRequest{StartDate: "2024-01-01", EndDate: "2024-01-07"},
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:         "blank keyword",
+			request:      &pb.GetQualityBySubjectGroupRequest{Keywords: []string{"billing", " "}, StartDate: "2024-01-01", EndDate: "2024-01-07"},
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:         "invalid date",
+			request:      &pb.GetQualityBySubjectGroupRequest{Keywords: []string{"billing"}, StartDate: "2024-01-01", EndDate: "invalid"},
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:         "service error",
+			request:      &pb.GetQualityBySubjectGroupRequest{Keywords: []string{"billing"}, StartDate: "2024-01-01", EndDate: "2024-01-07"},
+			serviceErr:   errors.New("database error"),
+			expectedCode: codes.Internal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			subjectGroups := &mockSubjectGroupService{
+				groups: []service.SubjectGroupQuality{
+					{Keyword: "billing", MatchedTickets: 2, AverageScore: "83%"},
+					{Keyword: "technical", MatchedTickets: 1, AverageScore: "35%"},
+				},
+				err: tt.serviceErr,
+			}
+			server := NewOverallQualityServer(&mockOverallQualityService{}, WithSubjectGroupService(subjectGroups))
+
+			response, err := server.GetQualityBySubjectGroup(context.Background(), tt.request)
+			if status.Code(err) != tt.expectedCode {
+				t.Fatalf("Expected code %v, got %v", tt.expectedCode, err)
+			}
+			if tt.expectedCode != codes.OK {
+				return
+			}
+
+			if len(subjectGroups.keywords) != 2 || subjectGroups.keywords[0] != "billing" {
+				t.Errorf("Expected trimmed keywords, got %q", subjectGroups.keywords)
+			}
+			if len(response.Groups) != 2 {
+				t.Fatalf("Expected 2 groups, got %d", len(response.Groups))
+			}
+			if response.Groups[0].MatchedTickets != 2 || response.Groups[0].AverageScore != "83%" {
+				t.Errorf("Unexpected first group: %v", response.Groups[0])
+			}
+		})
+	}
+
+	t.Run("not configured", func(t *testing.T) {
+		server := NewOverallQualityServer(&mockOverallQualityService{})
+
+		request := &pb.GetQualityBySubjectGroupRequest{Keywords: []string{"billing"}, StartDate: "2024-01-01", EndDate: "2024-01-07"}
+		if _, err := server.GetQualityBySubjectGroup(context.Background(), request); status.Code(err) != codes.FailedPrecondition {
+			t.Errorf("Expected FailedPrecondition, got %v", err)
+		}
+	})
+}
@@ -8,7 +8,9 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"ticket-score-service/internal/apperror"
 	"ticket-score-service/internal/service"
+	"ticket-score-service/internal/utils"
 	pb "ticket-score-service/proto/generated/period_comparison"
 )
 
@@ -36,7 +38,7 @@ func (s *PeriodComparisonServer) GetPeriodComparison(
 	}
 
 	// Parse starting date
-	startingDate, err := time.Parse("2006-01-02", req.StartingDate)
+	startingDate, err := time.Parse(utils.DateLayout, req.StartingDate)
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid starting_date format: %v", err)
 	}
@@ -56,16 +58,17 @@ func (s *PeriodComparisonServer) GetPeriodComparison(
 		secondEnd,
 	)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to get period comparison: %v", err)
+		return nil, apperror.ToGRPCError(err, "failed to get period comparison")
 	}
 
 	// Build response
 	response := &pb.GetPeriodComparisonResponse{
-		StartPeriod: result.StartPeriod,
-		StartScore:  result.StartScore,
-		EndPeriod:   result.EndPeriod,
-		EndScore:    result.EndScore,
-		Difference:  result.Difference,
+		PreviousPeriod:     result.PreviousPeriod,
+		PreviousScore:      result.PreviousScore,
+		CurrentPeriod:      result.CurrentPeriod,
+		CurrentScore:       result.CurrentScore,
+		Difference:         result.Difference,
+		AbsoluteDifference: result.AbsoluteDifference,
 	}
 
 	return response, nil
@@ -0,0 +1,93 @@
+package server
+
+import (
+	"testing"
+	"time"
+
+	pb "ticket-score-service/proto/generated/period_comparison"
+)
+
+func TestCalculatePeriodDates(t *testing.T) {
+	date := func(value string) time.Time {
+		parsed, err := time.Parse("2006-01-02", value)
+		if err != nil {
+			t.Fatalf("Invalid test date %q: %v", value, err)
+		}
+		return parsed
+	}
+
+	tests := []struct {
+		name                string
+		startingDate        string
+		periodType          pb.PeriodType
+		expectedFirstEnd    string
+		expectedSecondStart string
+		expectedSecondEnd   string
+	}{
+		{name: "week", startingDate: "2019-10-01", periodType: pb.PeriodType_WEEK, expectedFirstEnd: "2019-10-07", expectedSecondStart: "2019-10-08", expectedSecondEnd: "2019-10-14"},
+		{name: "week across leap day", startingDate: "2024-02-26", periodType: pb.PeriodType_WEEK, expectedFirstEnd: "2024-03-03", expectedSecondStart: "2024-03-04", expectedSecondEnd: "2024-03-10"},
+		{name: "month", startingDate: "2019-10-15", periodType: pb.PeriodType_MONTH, expectedFirstEnd: "2019-10-31", expectedSecondStart: "2019-11-01", expectedSecondEnd: "2019-11-30"},
+		{name: "month across year end", startingDate: "2023-12-15", periodType: pb.PeriodType_MONTH, expectedFirstEnd: "2023-12-31", expectedSecondStart: "2024-01-01", expectedSecondEnd: "2024-01-31"},
+		{name: "february in leap year", startingDate: "2024-02-10", periodType: pb.PeriodType_MONTH, expectedFirstEnd: "2024-02-29", expectedSecondStart: "2024-03-01", expectedSecondEnd: "2024-03-31"},
+		{name: "february in common year", startingDate: "2023-02-10", periodType: pb.PeriodType_MONTH, expectedFirstEnd: "2023-02-28", expectedSecondStart: "2023-03-01", expectedSecondEnd: "2023-03-31"},
+		{name: "month before leap february", startingDate: "2024-01-31", periodType: pb.PeriodType_MONTH, expectedFirstEnd: "2024-01-31", expectedSecondStart: "2024-02-01", expectedSecondEnd: "2024-02-29"},
+		{name: "quarter", startingDate: "2024-01-15", periodType: pb.PeriodType_QUARTER, expectedFirstEnd: "2024-04-14", expectedSecondStart: "2024-04-15", expectedSecondEnd: "2024-07-14"},
+		{name: "quarter ending in leap february", startingDate: "2023-11-29", periodType: pb.PeriodType_QUARTER, expectedFirstEnd: "2024-02-28", expectedSecondStart: "2024-02-29", expectedSecondEnd: "2024-05-28"},
+		{name: "year", startingDate: "2021-01-01", periodType: pb.PeriodType_YEAR, expectedFirstEnd: "2021-12-31", expectedSecondStart: "2022-01-01", expectedSecondEnd: "2022-12-31"},
+		{name: "year across leap day", startingDate: "2023-03-01", periodType: pb.PeriodType_YEAR, expectedFirstEnd: "2024-02-29", expectedSecondStart: "2024-03-01", expectedSecondEnd: "2025-02-28"},
+		{name: "year from leap day", startingDate: "2024-02-29", periodType: pb.PeriodType_YEAR, expectedFirstEnd: "2025-02-28", expectedSecondStart: "2025-03-01", expectedSecondEnd: "2026-02-28"},
+	}
+
+	server := &PeriodComparisonServer{}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start := date(tt.startingDate)
+
+			firstStart, firstEnd, secondStart, secondEnd, err := server.calculatePeriodDates(start, tt.periodType)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if !firstStart.Equal(start) {
+				t.Errorf("Expected first period to start on %s, got %s", tt.startingDate, firstStart.Format("2006-01-02"))
+			}
+			if !firstEnd.Equal(date(tt.expectedFirstEnd)) {
+				t.Errorf("Expected first period to end on %s, got %s", tt.expectedFirstEnd, firstEnd.Format("2006-01-02"))
+			}
+			if !secondStart.Equal(date(tt.expectedSecondStart)) {
+				t.Errorf("Expected second period to start on %s, got %s", tt.expectedSecondStart, secondStart.Format("2006-01-02"))
+			}
+			if !secondEnd.Equal(date(tt.expectedSecondEnd)) {
+				t.Errorf("Expected second period to end on %s, got %s", tt.expectedSecondEnd, secondEnd.Format("2006-01-02"))
+			}
+			if !secondStart.Equal(firstEnd.AddDate(0, 0, 1)) {
+				t.Errorf("Expected second period to start the day after the first ends, got %s and %s", firstEnd.Format("2006-01-02"), secondStart.Format("2006-01-02"))
+			}
+
+			switch tt.periodType {
+			case pb.PeriodType_WEEK:
+				if days := firstEnd.Sub(firstStart).Hours()/24 + 1; days != 7 {
+					t.Errorf("Expected a 7-day first period, got %v days", days)
+				}
+			case pb.PeriodType_MONTH:
+				if firstEnd.Month() != start.Month() || firstEnd.AddDate(0, 0, 1).Day() != 1 {
+					t.Errorf("Expected first period to end on the last day of %s, got %s", start.Month(), firstEnd.Format("2006-01-02"))
+				}
+			case pb.PeriodType_QUARTER:
+				if !secondStart.Equal(start.AddDate(0, 3, 0)) {
+					t.Errorf("Expected a first period of exactly 3 months, second period starts %s", secondStart.Format("2006-01-02"))
+				}
+			case pb.PeriodType_YEAR:
+				if days := secondStart.Sub(start).Hours() / 24; days != 365 && days != 366 {
+					t.Errorf("Expected second period to start 365 or 366 days after start, got %v days", days)
+				}
+			}
+		})
+	}
+
+	t.Run("unsupported period type", func(t *testing.T) {
+		if _, _, _, _, err := server.calculatePeriodDates(date("2019-10-01"), pb.PeriodType(99)); err == nil {
+			t.Error("Expected an error for an unsupported period type")
+		}
+	})
+}
@@ -2,23 +2,64 @@ package server
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"io"
 	"time"
 
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"ticket-score-service/internal/apperror"
+	"ticket-score-service/internal/models"
 	"ticket-score-service/internal/service"
+	"ticket-score-service/internal/utils"
 	pb "ticket-score-service/proto/generated/rating_analytics"
 )
 
+// importBatchSize is the number of streamed ratings inserted at once by BulkImportRatings
+const importBatchSize = 100
+
+// RatingAnalyticsServiceInterface defines the interface for the rating analytics service
+type RatingAnalyticsServiceInterface interface {
+	GetCategoryAnalytics(ctx context.Context, startDate, endDate time.Time) ([]service.CategoryAnalytics, error)
+	GetCategoryAnalyticsPaginated(ctx context.Context, startDate, endDate time.Time, limit, offset int) ([]service.CategoryAnalytics, int, error)
+	GetCategoryExtremeRatingCounts(ctx context.Context, categoryID, threshold int, startDate, endDate time.Time) (*service.ExtremeRatingCounts, error)
+	GetCategoryScoreComparison(ctx context.Context, categoryID1, categoryID2 int, startDate, endDate time.Time) (*service.CategoryComparison, error)
+	GetCategoryScoreConsistency(ctx context.Context, categoryID int, startDate, endDate time.Time) (*service.ConsistencyReport, error)
+	GetScoreGap(ctx context.Context, startDate, endDate time.Time) (*service.ScoreGap, error)
+	GetRatingCountTrend(ctx context.Context, startDate, endDate time.Time) (*service.RatingCountTrend, error)
+	GetCategoryWeightImpact(ctx context.Context, startDate, endDate time.Time) ([]service.WeightImpact, error)
+	GetCategoryScoreCorrelation(ctx context.Context, categoryID1, categoryID2 int, startDate, endDate time.Time) (*service.CorrelationReport, error)
+	GetCategoryScoreByWeekday(ctx context.Context, categoryID int, startDate, endDate time.Time) (*service.WeekdayBreakdown, error)
+	GetSeasonallyAdjustedScores(ctx context.Context, categoryID int, startDate, endDate time.Time) ([]service.DailyScore, error)
+	GetScoreChangeAlerts(ctx context.Context, categoryID int, startDate, endDate time.Time, minChangePct float64) ([]service.ScoreChangeAlert, error)
+	GetRatingsByCategoryAndReviewer(ctx context.Context, categoryID, reviewerID int, startDate, endDate time.Time) ([]models.Rating, error)
+	GetCategoryRank(ctx context.Context, categoryID int, startDate, endDate time.Time) (*service.CategoryRank, error)
+	GetCategoryScoreHeatmapByHourAndWeekday(ctx context.Context, categoryID int, startDate, endDate time.Time) (*service.HourWeekdayHeatmap, error)
+	GetTopImprovingCategories(ctx context.Context, currentStart, currentEnd, previousStart, previousEnd time.Time, limit int) ([]service.CategoryChange, error)
+	GetTopDecliningCategories(ctx context.Context, currentStart, currentEnd, previousStart, previousEnd time.Time, limit int) ([]service.CategoryChange, error)
+	GetCategoryScoreForecast(ctx context.Context, categoryID int, lookbackDays, forecastDays int) (*service.ScoreForecast, error)
+	GetCategoryMovingRange(ctx context.Context, categoryID int, startDate, endDate time.Time) (*service.MovingRangeReport, error)
+	GetScoreByReviewerCountBucket(ctx context.Context, categoryID int, startDate, endDate time.Time) ([]service.ReviewerCountBucket, error)
+	GetCategoryStabilityIndex(ctx context.Context, categoryID int, startDate, endDate time.Time) (*service.StabilityIndex, error)
+	GetCategoryScoreDrift(ctx context.Context, categoryID int, startDate, endDate time.Time) (*service.DriftReport, error)
+	GetCategoryCoverage(ctx context.Context, startDate, endDate time.Time) ([]service.CategoryCoverage, error)
+	GetCategoryScoreDayPercentile(ctx context.Context, categoryID int, date time.Time) (*service.DayPercentile, error)
+	GetMonthlyCategoryHeatmap(ctx context.Context, year int) (*service.CategoryHeatmap, error)
+	ImportRatings(ctx context.Context, ratings []models.Rating) error
+	ValidateRating(rating models.Rating) error
+}
+
 // RatingAnalyticsServer implements the gRPC RatingAnalyticsService
 type RatingAnalyticsServer struct {
 	pb.UnimplementedRatingAnalyticsServiceServer
-	analyticsService *service.RatingAnalyticsService
+	analyticsService RatingAnalyticsServiceInterface
 }
 
 // NewRatingAnalyticsServer creates a new gRPC server instance
-func NewRatingAnalyticsServer(analyticsService *service.RatingAnalyticsService) *RatingAnalyticsServer {
+func NewRatingAnalyticsServer(analyticsService RatingAnalyticsServiceInterface) *RatingAnalyticsServer {
 	return &RatingAnalyticsServer{
 		analyticsService: analyticsService,
 	}
@@ -27,49 +68,899 @@ func NewRatingAnalyticsServer(analyticsService *service.RatingAnalyticsService)
 // GetCategoryAnalytics handles the gRPC request for category analytics
 func (s *RatingAnalyticsServer) GetCategoryAnalytics(ctx context.Context, req *pb.GetCategoryAnalyticsRequest) (*pb.GetCategoryAnalyticsResponse, error) {
 	// Validate request
-	if req.StartDate == "" || req.EndDate == "" {
-		return nil, status.Error(codes.InvalidArgument, "start_date and end_date are required")
+	dateRange, err := utils.ParseDateRange(req.StartDate, req.EndDate)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
+	startDate, endDate := dateRange.Start, dateRange.End
 
-	// Parse dates
-	startDate, err := time.Parse("2006-01-02", req.StartDate)
+	// Call service layer
+	analytics, err := s.analyticsService.GetCategoryAnalytics(ctx, startDate, endDate)
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "invalid start_date format, expected YYYY-MM-DD: %v", err)
+		return nil, apperror.ToGRPCError(err, "failed to get category analytics")
 	}
 
-	endDate, err := time.Parse("2006-01-02", req.EndDate)
+	// Convert to proto response
+	response := &pb.GetCategoryAnalyticsResponse{
+		Analytics: make([]*pb.CategoryAnalytics, len(analytics)),
+	}
+
+	for i, analyticsItem := range analytics {
+		response.Analytics[i] = convertCategoryAnalytics(analyticsItem)
+	}
+
+	return response, nil
+}
+
+// GetCategoryAnalyticsPaginated handles the gRPC request for one page of category analytics
+func (s *RatingAnalyticsServer) GetCategoryAnalyticsPaginated(ctx context.Context, req *pb.GetCategoryAnalyticsPaginatedRequest) (*pb.GetCategoryAnalyticsPaginatedResponse, error) {
+	// Validate request
+	if req.Limit <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "limit must be positive")
+	}
+	if req.Offset < 0 {
+		return nil, status.Error(codes.InvalidArgument, "offset must not be negative")
+	}
+
+	dateRange, err := utils.ParseDateRange(req.StartDate, req.EndDate)
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "invalid end_date format, expected YYYY-MM-DD: %v", err)
+		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	// Validate date range
-	if startDate.After(endDate) {
-		return nil, status.Error(codes.InvalidArgument, "start_date must be before or equal to end_date")
+	// Call service layer
+	analytics, totalCount, err := s.analyticsService.GetCategoryAnalyticsPaginated(ctx, dateRange.Start, dateRange.End, int(req.Limit), int(req.Offset))
+	if err != nil {
+		return nil, apperror.ToGRPCError(err, "failed to get paginated category analytics")
 	}
 
+	// Convert to proto response
+	response := &pb.GetCategoryAnalyticsPaginatedResponse{
+		Analytics:  make([]*pb.CategoryAnalytics, len(analytics)),
+		Limit:      req.Limit,
+		Offset:     req.Offset,
+		TotalCount: int32(totalCount),
+	}
+	for i, analyticsItem := range analytics {
+		response.Analytics[i] = convertCategoryAnalytics(analyticsItem)
+	}
+
+	return response, nil
+}
+
+// GetCategoryExtremeRatingCounts handles the gRPC request for counting ratings above and below a threshold
+func (s *RatingAnalyticsServer) GetCategoryExtremeRatingCounts(ctx context.Context, req *pb.GetCategoryExtremeRatingCountsRequest) (*pb.ExtremeRatingCounts, error) {
+	// Validate request
+	if req.CategoryId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "category_id must be positive")
+	}
+
+	if req.Threshold < 1 || req.Threshold > 4 {
+		return nil, status.Error(codes.InvalidArgument, "threshold must be between 1 and 4")
+	}
+
+	// Parse and validate dates
+	dateRange, err := utils.ParseDateRange(req.StartDate, req.EndDate)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	startDate, endDate := dateRange.Start, dateRange.End
+
 	// Call service layer
-	analytics, err := s.analyticsService.GetCategoryAnalytics(ctx, startDate, endDate)
+	counts, err := s.analyticsService.GetCategoryExtremeRatingCounts(ctx, int(req.CategoryId), int(req.Threshold), startDate, endDate)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to get category analytics: %v", err)
+		return nil, apperror.ToGRPCError(err, "failed to get extreme rating counts")
 	}
 
 	// Convert to proto response
-	response := &pb.GetCategoryAnalyticsResponse{
-		Analytics: make([]*pb.CategoryAnalytics, len(analytics)),
+	return &pb.ExtremeRatingCounts{
+		CategoryId:     int32(counts.CategoryID),
+		AboveThreshold: int32(counts.AboveThreshold),
+		BelowThreshold: int32(counts.BelowThreshold),
+		Threshold:      int32(counts.Threshold),
+	}, nil
+}
+
+// GetCategoryScoreComparison handles the gRPC request for comparing two categories' daily scores
+func (s *RatingAnalyticsServer) GetCategoryScoreComparison(ctx context.Context, req *pb.GetCategoryScoreComparisonRequest) (*pb.CategoryComparison, error) {
+	// Validate request
+	if req.CategoryId_1 <= 0 || req.CategoryId_2 <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "category_id_1 and category_id_2 must be positive")
 	}
 
-	for i, analyticsItem := range analytics {
-		response.Analytics[i] = &pb.CategoryAnalytics{
-			Category: analyticsItem.Category,
-			Ratings:  int32(analyticsItem.Ratings),
-			Score:    analyticsItem.Score,
-			Dates:    convertDailyScores(analyticsItem.Dates),
+	dateRange, err := utils.ParseDateRange(req.StartDate, req.EndDate)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	startDate, endDate := dateRange.Start, dateRange.End
+
+	// Call service layer
+	comparison, err := s.analyticsService.GetCategoryScoreComparison(ctx, int(req.CategoryId_1), int(req.CategoryId_2), startDate, endDate)
+	if err != nil {
+		if errors.Is(err, service.ErrCategoryNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, apperror.ToGRPCError(err, "failed to compare category scores")
+	}
+
+	// Convert to proto response
+	points := make([]*pb.ComparisonPoint, len(comparison.Points))
+	for i, point := range comparison.Points {
+		points[i] = &pb.ComparisonPoint{
+			Date:       point.Date,
+			Score_1:    point.Score1,
+			Score_2:    point.Score2,
+			Difference: point.Difference,
+		}
+	}
+
+	return &pb.CategoryComparison{
+		Category_1: comparison.Category1,
+		Category_2: comparison.Category2,
+		Points:     points,
+	}, nil
+}
+
+// GetCategoryScoreConsistency handles the gRPC request for a category's score consistency
+func (s *RatingAnalyticsServer) GetCategoryScoreConsistency(ctx context.Context, req *pb.GetCategoryScoreConsistencyRequest) (*pb.ConsistencyReport, error) {
+	// Validate request
+	if req.CategoryId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "category_id must be positive")
+	}
+
+	dateRange, err := utils.ParseDateRange(req.StartDate, req.EndDate)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	startDate, endDate := dateRange.Start, dateRange.End
+
+	// Call service layer
+	report, err := s.analyticsService.GetCategoryScoreConsistency(ctx, int(req.CategoryId), startDate, endDate)
+	if err != nil {
+		if errors.Is(err, service.ErrCategoryNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, apperror.ToGRPCError(err, "failed to get category score consistency")
+	}
+
+	// Convert to proto response
+	return &pb.ConsistencyReport{
+		CategoryName:           report.CategoryName,
+		Mean:                   report.Mean,
+		StdDev:                 report.StdDev,
+		CoefficientOfVariation: report.CoefficientOfVariation,
+		ConsistencyGrade:       report.ConsistencyGrade,
+	}, nil
+}
+
+// GetCategoryScoreGap handles the gRPC request for the gap between the best and worst category scores
+func (s *RatingAnalyticsServer) GetCategoryScoreGap(ctx context.Context, req *pb.GetCategoryScoreGapRequest) (*pb.ScoreGap, error) {
+	// Validate request
+	dateRange, err := utils.ParseDateRange(req.StartDate, req.EndDate)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// Call service layer
+	gap, err := s.analyticsService.GetScoreGap(ctx, dateRange.Start, dateRange.End)
+	if err != nil {
+		return nil, apperror.ToGRPCError(err, "failed to get category score gap")
+	}
+
+	// Convert to proto response
+	return &pb.ScoreGap{
+		BestCategory:  gap.BestCategory,
+		BestScore:     gap.BestScore,
+		WorstCategory: gap.WorstCategory,
+		WorstScore:    gap.WorstScore,
+		Gap:           gap.Gap,
+		GapLabel:      gap.GapLabel,
+	}, nil
+}
+
+// GetRatingCountTrend handles the gRPC request for the number of ratings per period
+func (s *RatingAnalyticsServer) GetRatingCountTrend(ctx context.Context, req *pb.GetRatingCountTrendRequest) (*pb.RatingCountTrend, error) {
+	// Validate request
+	dateRange, err := utils.ParseDateRange(req.StartDate, req.EndDate)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// Call service layer
+	trend, err := s.analyticsService.GetRatingCountTrend(ctx, dateRange.Start, dateRange.End)
+	if err != nil {
+		return nil, apperror.ToGRPCError(err, "failed to get rating count trend")
+	}
+
+	// Convert to proto response
+	periods := make([]*pb.PeriodCount, len(trend.Periods))
+	for i, period := range trend.Periods {
+		periods[i] = &pb.PeriodCount{
+			DateLabel: period.DateLabel,
+			Count:     int32(period.Count),
+		}
+	}
+
+	return &pb.RatingCountTrend{Periods: periods}, nil
+}
+
+// GetCategoryWeightImpact handles the gRPC request for how category weights affect the overall score
+func (s *RatingAnalyticsServer) GetCategoryWeightImpact(ctx context.Context, req *pb.GetCategoryWeightImpactRequest) (*pb.GetCategoryWeightImpactResponse, error) {
+	// Validate request
+	dateRange, err := utils.ParseDateRange(req.StartDate, req.EndDate)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// Call service layer
+	impacts, err := s.analyticsService.GetCategoryWeightImpact(ctx, dateRange.Start, dateRange.End)
+	if err != nil {
+		return nil, apperror.ToGRPCError(err, "failed to get category weight impact")
+	}
+
+	// Convert to proto response
+	pbImpacts := make([]*pb.WeightImpact, len(impacts))
+	for i, impact := range impacts {
+		pbImpacts[i] = &pb.WeightImpact{
+			CategoryName:       impact.CategoryName,
+			CurrentScore:       impact.CurrentScore,
+			DoubledWeightScore: impact.DoubledWeightScore,
+			HalvedWeightScore:  impact.HalvedWeightScore,
+			Sensitivity:        impact.Sensitivity,
+		}
+	}
+
+	return &pb.GetCategoryWeightImpactResponse{Impacts: pbImpacts}, nil
+}
+
+// GetCategoryScoreCorrelation handles the gRPC request for correlating two categories' daily scores
+func (s *RatingAnalyticsServer) GetCategoryScoreCorrelation(ctx context.Context, req *pb.GetCategoryScoreCorrelationRequest) (*pb.CorrelationReport, error) {
+	// Validate request
+	if req.CategoryId_1 <= 0 || req.CategoryId_2 <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "category_id_1 and category_id_2 must be positive")
+	}
+
+	dateRange, err := utils.ParseDateRange(req.StartDate, req.EndDate)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// Call service layer
+	report, err := s.analyticsService.GetCategoryScoreCorrelation(ctx, int(req.CategoryId_1), int(req.CategoryId_2), dateRange.Start, dateRange.End)
+	if err != nil {
+		if errors.Is(err, service.ErrCategoryNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		if errors.Is(err, service.ErrInsufficientCorrelationData) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		return nil, apperror.ToGRPCError(err, "failed to correlate category scores")
+	}
+
+	return &pb.CorrelationReport{
+		Category_1:       report.Category1,
+		Category_2:       report.Category2,
+		PearsonR:         report.PearsonR,
+		CorrelationLabel: report.CorrelationLabel,
+		DataPointsUsed:   int32(report.DataPointsUsed),
+	}, nil
+}
+
+// GetCategoryScoreByWeekday handles the gRPC request for a category's score per day of the week
+func (s *RatingAnalyticsServer) GetCategoryScoreByWeekday(ctx context.Context, req *pb.GetCategoryScoreByWeekdayRequest) (*pb.WeekdayBreakdown, error) {
+	// Validate request
+	if req.CategoryId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "category_id must be positive")
+	}
+
+	dateRange, err := utils.ParseDateRange(req.StartDate, req.EndDate)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// Call service layer
+	breakdown, err := s.analyticsService.GetCategoryScoreByWeekday(ctx, int(req.CategoryId), dateRange.Start, dateRange.End)
+	if err != nil {
+		if errors.Is(err, service.ErrCategoryNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, apperror.ToGRPCError(err, "failed to get category score by weekday")
+	}
+
+	weekdays := make([]*pb.WeekdayScore, 0, len(breakdown.Weekdays))
+	for _, weekday := range breakdown.Weekdays {
+		weekdays = append(weekdays, &pb.WeekdayScore{
+			Weekday:     weekday.Weekday,
+			Score:       weekday.Score,
+			RatingCount: int32(weekday.RatingCount),
+		})
+	}
+
+	return &pb.WeekdayBreakdown{
+		CategoryName: breakdown.CategoryName,
+		Weekdays:     weekdays,
+	}, nil
+}
+
+// GetSeasonallyAdjustedCategoryScores handles the gRPC request for a category's daily scores adjusted for the day of the week
+func (s *RatingAnalyticsServer) GetSeasonallyAdjustedCategoryScores(ctx context.Context, req *pb.GetSeasonallyAdjustedCategoryScoresRequest) (*pb.GetSeasonallyAdjustedCategoryScoresResponse, error) {
+	// Validate request
+	if req.CategoryId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "category_id must be positive")
+	}
+
+	dateRange, err := utils.ParseDateRange(req.StartDate, req.EndDate)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// Call service layer
+	scores, err := s.analyticsService.GetSeasonallyAdjustedScores(ctx, int(req.CategoryId), dateRange.Start, dateRange.End)
+	if err != nil {
+		if errors.Is(err, service.ErrCategoryNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, apperror.ToGRPCError(err, "failed to get seasonally adjusted scores")
+	}
+
+	response := &pb.GetSeasonallyAdjustedCategoryScoresResponse{
+		Scores: make([]*pb.DailyScore, 0, len(scores)),
+	}
+	for _, score := range scores {
+		response.Scores = append(response.Scores, &pb.DailyScore{
+			Date:  score.Date,
+			Score: score.Score,
+		})
+	}
+
+	return response, nil
+}
+
+// GetScoreChangeAlerts handles the gRPC request for large day-to-day changes in a category's score
+func (s *RatingAnalyticsServer) GetScoreChangeAlerts(ctx context.Context, req *pb.GetScoreChangeAlertsRequest) (*pb.GetScoreChangeAlertsResponse, error) {
+	// Validate request
+	if req.CategoryId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "category_id must be positive")
+	}
+	if req.MinChangePct <= 0 || req.MinChangePct > 100 {
+		return nil, status.Error(codes.InvalidArgument, "min_change_pct must be greater than 0 and at most 100")
+	}
+
+	dateRange, err := utils.ParseDateRange(req.StartDate, req.EndDate)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// Call service layer
+	alerts, err := s.analyticsService.GetScoreChangeAlerts(ctx, int(req.CategoryId), dateRange.Start, dateRange.End, req.MinChangePct)
+	if err != nil {
+		if errors.Is(err, service.ErrCategoryNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, apperror.ToGRPCError(err, "failed to get score change alerts")
+	}
+
+	response := &pb.GetScoreChangeAlertsResponse{
+		Alerts: make([]*pb.ScoreChangeAlert, 0, len(alerts)),
+	}
+	for _, alert := range alerts {
+		response.Alerts = append(response.Alerts, &pb.ScoreChangeAlert{
+			FromDate:  alert.FromDate,
+			ToDate:    alert.ToDate,
+			FromScore: alert.FromScore,
+			ToScore:   alert.ToScore,
+			Change:    alert.Change,
+			Direction: alert.Direction,
+		})
+	}
+
+	return response, nil
+}
+
+// GetRatingsByCategoryAndReviewer handles the gRPC request for the ratings a reviewer gave in a category
+func (s *RatingAnalyticsServer) GetRatingsByCategoryAndReviewer(ctx context.Context, req *pb.GetRatingsByCategoryAndReviewerRequest) (*pb.GetRatingsByCategoryAndReviewerResponse, error) {
+	// Validate request
+	if req.CategoryId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "category_id must be positive")
+	}
+	if req.ReviewerId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "reviewer_id must be positive")
+	}
+
+	dateRange, err := utils.ParseDateRange(req.StartDate, req.EndDate)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// Call service layer
+	ratings, err := s.analyticsService.GetRatingsByCategoryAndReviewer(ctx, int(req.CategoryId), int(req.ReviewerId), dateRange.Start, dateRange.End)
+	if err != nil {
+		if errors.Is(err, service.ErrCategoryNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, apperror.ToGRPCError(err, "failed to get ratings")
+	}
+
+	response := &pb.GetRatingsByCategoryAndReviewerResponse{
+		Ratings: make([]*pb.Rating, 0, len(ratings)),
+	}
+	for _, rating := range ratings {
+		response.Ratings = append(response.Ratings, &pb.Rating{
+			Id:         int32(rating.ID),
+			Rating:     int32(rating.Rating),
+			TicketId:   int32(rating.TicketID),
+			CategoryId: int32(rating.RatingCategoryID),
+			ReviewerId: int32(rating.ReviewerID),
+			RevieweeId: int32(rating.RevieweeID),
+			CreatedAt:  rating.CreatedAt.Format(time.RFC3339),
+		})
+	}
+
+	return response, nil
+}
+
+// GetCategoryRank handles the gRPC request for where a category's score ranks among every category's
+func (s *RatingAnalyticsServer) GetCategoryRank(ctx context.Context, req *pb.GetCategoryRankRequest) (*pb.CategoryRank, error) {
+	// Validate request
+	if req.CategoryId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "category_id must be positive")
+	}
+
+	dateRange, err := utils.ParseDateRange(req.StartDate, req.EndDate)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// Call service layer
+	rank, err := s.analyticsService.GetCategoryRank(ctx, int(req.CategoryId), dateRange.Start, dateRange.End)
+	if err != nil {
+		if errors.Is(err, service.ErrCategoryNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, apperror.ToGRPCError(err, "failed to get category rank")
+	}
+
+	return &pb.CategoryRank{
+		CategoryId:      int32(rank.CategoryID),
+		CategoryName:    rank.CategoryName,
+		Score:           rank.Score,
+		Rank:            int32(rank.Rank),
+		TotalCategories: int32(rank.TotalCategories),
+		Percentile:      rank.Percentile,
+	}, nil
+}
+
+// GetHourWeekdayHeatmap handles the gRPC request for a category's score per hour of each day of the week
+func (s *RatingAnalyticsServer) GetHourWeekdayHeatmap(ctx context.Context, req *pb.GetHourWeekdayHeatmapRequest) (*pb.HourWeekdayHeatmap, error) {
+	// Validate request
+	if req.CategoryId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "category_id must be positive")
+	}
+
+	dateRange, err := utils.ParseDateRange(req.StartDate, req.EndDate)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// Call service layer
+	heatmap, err := s.analyticsService.GetCategoryScoreHeatmapByHourAndWeekday(ctx, int(req.CategoryId), dateRange.Start, dateRange.End)
+	if err != nil {
+		if errors.Is(err, service.ErrCategoryNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, apperror.ToGRPCError(err, "failed to get hour and weekday heatmap")
+	}
+
+	// Convert to proto response
+	response := &pb.HourWeekdayHeatmap{
+		CategoryName: heatmap.CategoryName,
+		Weekdays:     make([]string, 0, len(heatmap.Matrix)),
+		Matrix:       make([]*pb.HourlyScores, 0, len(heatmap.Matrix)),
+	}
+	for weekday, scores := range heatmap.Matrix {
+		response.Weekdays = append(response.Weekdays, time.Weekday(weekday).String())
+		response.Matrix = append(response.Matrix, &pb.HourlyScores{Scores: append([]string(nil), scores[:]...)})
+	}
+
+	return response, nil
+}
+
+// GetTopImprovingCategories handles the gRPC request for the categories whose score rose most
+func (s *RatingAnalyticsServer) GetTopImprovingCategories(ctx context.Context, req *pb.GetTopCategoryChangesRequest) (*pb.GetTopCategoryChangesResponse, error) {
+	return s.getTopCategoryChanges(ctx, req, s.analyticsService.GetTopImprovingCategories)
+}
+
+// GetTopDecliningCategories handles the gRPC request for the categories whose score fell most
+func (s *RatingAnalyticsServer) GetTopDecliningCategories(ctx context.Context, req *pb.GetTopCategoryChangesRequest) (*pb.GetTopCategoryChangesResponse, error) {
+	return s.getTopCategoryChanges(ctx, req, s.analyticsService.GetTopDecliningCategories)
+}
+
+// getTopCategoryChanges validates a top category changes request and converts the changes found by get
+func (s *RatingAnalyticsServer) getTopCategoryChanges(
+	ctx context.Context,
+	req *pb.GetTopCategoryChangesRequest,
+	get func(ctx context.Context, currentStart, currentEnd, previousStart, previousEnd time.Time, limit int) ([]service.CategoryChange, error),
+) (*pb.GetTopCategoryChangesResponse, error) {
+	// Validate request
+	if req.Limit <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "limit must be positive")
+	}
+
+	current, err := utils.ParseDateRange(req.CurrentStartDate, req.CurrentEndDate)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid current period: %v", err)
+	}
+	previous, err := utils.ParseDateRange(req.PreviousStartDate, req.PreviousEndDate)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid previous period: %v", err)
+	}
+
+	// Call service layer
+	changes, err := get(ctx, current.Start, current.End, previous.Start, previous.End, int(req.Limit))
+	if err != nil {
+		return nil, apperror.ToGRPCError(err, "failed to get category changes")
+	}
+
+	response := &pb.GetTopCategoryChangesResponse{
+		Categories: make([]*pb.CategoryChange, 0, len(changes)),
+	}
+	for _, change := range changes {
+		response.Categories = append(response.Categories, &pb.CategoryChange{
+			CategoryName:      change.CategoryName,
+			CurrentScore:      change.CurrentScore,
+			PreviousScore:     change.PreviousScore,
+			Change:            change.Change,
+			AbsoluteChangePct: change.AbsoluteChangePct,
+		})
+	}
+
+	return response, nil
+}
+
+// GetCategoryScoreForecast handles the gRPC request for a category's projected daily scores
+func (s *RatingAnalyticsServer) GetCategoryScoreForecast(ctx context.Context, req *pb.GetCategoryScoreForecastRequest) (*pb.ScoreForecast, error) {
+	// Validate request
+	if req.CategoryId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "category_id must be positive")
+	}
+	if req.LookbackDays < 2 || req.LookbackDays > 365 {
+		return nil, status.Error(codes.InvalidArgument, "lookback_days must be between 2 and 365")
+	}
+	if req.ForecastDays < 1 || req.ForecastDays > 90 {
+		return nil, status.Error(codes.InvalidArgument, "forecast_days must be between 1 and 90")
+	}
+
+	// Call service layer
+	forecast, err := s.analyticsService.GetCategoryScoreForecast(ctx, int(req.CategoryId), int(req.LookbackDays), int(req.ForecastDays))
+	if err != nil {
+		if errors.Is(err, service.ErrCategoryNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		if errors.Is(err, service.ErrInsufficientForecastData) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		return nil, apperror.ToGRPCError(err, "failed to forecast category score")
+	}
+
+	response := &pb.ScoreForecast{
+		CategoryName:   forecast.CategoryName,
+		Slope:          forecast.Slope,
+		HistoricalDays: convertDailyScores(forecast.HistoricalDays),
+		ForecastedDays: make([]*pb.ForecastDay, len(forecast.ForecastedDays)),
+	}
+	for i, day := range forecast.ForecastedDays {
+		response.ForecastedDays[i] = &pb.ForecastDay{
+			Date:        day.Date,
+			Score:       day.Score,
+			IsProjected: day.IsProjected,
+		}
+	}
+
+	return response, nil
+}
+
+// GetCategoryMovingRange handles the gRPC request for a moving range control chart of a category's daily scores
+func (s *RatingAnalyticsServer) GetCategoryMovingRange(ctx context.Context, req *pb.GetCategoryMovingRangeRequest) (*pb.MovingRangeReport, error) {
+	// Validate request
+	if req.CategoryId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "category_id must be positive")
+	}
+
+	dateRange, err := utils.ParseDateRange(req.StartDate, req.EndDate)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// Call service layer
+	report, err := s.analyticsService.GetCategoryMovingRange(ctx, int(req.CategoryId), dateRange.Start, dateRange.End)
+	if err != nil {
+		if errors.Is(err, service.ErrCategoryNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, apperror.ToGRPCError(err, "failed to get category moving range")
+	}
+
+	response := &pb.MovingRangeReport{
+		CategoryName:      report.CategoryName,
+		MovingRanges:      make([]*pb.MRPoint, len(report.MovingRanges)),
+		AverageMr:         report.AverageMR,
+		UpperControlLimit: report.UpperControlLimit,
+	}
+	for i, point := range report.MovingRanges {
+		response.MovingRanges[i] = &pb.MRPoint{
+			Date:         point.Date,
+			Mr:           point.MR,
+			OutOfControl: point.OutOfControl,
+		}
+	}
+
+	return response, nil
+}
+
+// GetScoreByReviewerCount handles the gRPC request for a category's average ticket score per reviewer count
+func (s *RatingAnalyticsServer) GetScoreByReviewerCount(ctx context.Context, req *pb.GetScoreByReviewerCountRequest) (*pb.GetScoreByReviewerCountResponse, error) {
+	// Validate request
+	if req.CategoryId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "category_id must be positive")
+	}
+
+	dateRange, err := utils.ParseDateRange(req.StartDate, req.EndDate)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// Call service layer
+	buckets, err := s.analyticsService.GetScoreByReviewerCountBucket(ctx, int(req.CategoryId), dateRange.Start, dateRange.End)
+	if err != nil {
+		if errors.Is(err, service.ErrCategoryNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, apperror.ToGRPCError(err, "failed to get score by reviewer count")
+	}
+
+	response := &pb.GetScoreByReviewerCountResponse{
+		Buckets: make([]*pb.ReviewerCountBucket, len(buckets)),
+	}
+	for i, bucket := range buckets {
+		response.Buckets[i] = &pb.ReviewerCountBucket{
+			ReviewerCountLabel: bucket.ReviewerCountLabel,
+			TicketCount:        int32(bucket.TicketCount),
+			AverageScore:       bucket.AverageScore,
 		}
 	}
 
 	return response, nil
 }
 
+// GetCategoryStabilityIndex handles the gRPC request for a category's stability index
+func (s *RatingAnalyticsServer) GetCategoryStabilityIndex(ctx context.Context, req *pb.GetCategoryStabilityIndexRequest) (*pb.StabilityIndex, error) {
+	// Validate request
+	if req.CategoryId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "category_id must be positive")
+	}
+
+	dateRange, err := utils.ParseDateRange(req.StartDate, req.EndDate)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// Call service layer
+	index, err := s.analyticsService.GetCategoryStabilityIndex(ctx, int(req.CategoryId), dateRange.Start, dateRange.End)
+	if err != nil {
+		if errors.Is(err, service.ErrCategoryNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, apperror.ToGRPCError(err, "failed to get category stability index")
+	}
+
+	return &pb.StabilityIndex{
+		CategoryName:      index.CategoryName,
+		Index:             index.Index,
+		Grade:             index.Grade,
+		CoverageComponent: index.CoverageComponent,
+		VarianceComponent: index.VarianceComponent,
+		VolumeComponent:   index.VolumeComponent,
+	}, nil
+}
+
+// GetCategoryScoreDrift handles the gRPC request for a trend test on a category's daily scores
+func (s *RatingAnalyticsServer) GetCategoryScoreDrift(ctx context.Context, req *pb.GetCategoryScoreDriftRequest) (*pb.DriftReport, error) {
+	// Validate request
+	if req.CategoryId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "category_id must be positive")
+	}
+
+	dateRange, err := utils.ParseDateRange(req.StartDate, req.EndDate)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// Call service layer
+	report, err := s.analyticsService.GetCategoryScoreDrift(ctx, int(req.CategoryId), dateRange.Start, dateRange.End)
+	if err != nil {
+		if errors.Is(err, service.ErrCategoryNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, apperror.ToGRPCError(err, "failed to get category score drift")
+	}
+
+	return &pb.DriftReport{
+		CategoryName:   report.CategoryName,
+		ZScore:         report.ZScore,
+		TrendDirection: report.TrendDirection,
+		PValue:         report.PValue,
+		DataPoints:     int32(report.DataPoints),
+	}, nil
+}
+
+// GetCategoryCoverage handles the gRPC request for the share of tickets rated in each category
+func (s *RatingAnalyticsServer) GetCategoryCoverage(ctx context.Context, req *pb.GetCategoryCoverageRequest) (*pb.GetCategoryCoverageResponse, error) {
+	// Validate request
+	dateRange, err := utils.ParseDateRange(req.StartDate, req.EndDate)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// Call service layer
+	coverage, err := s.analyticsService.GetCategoryCoverage(ctx, dateRange.Start, dateRange.End)
+	if err != nil {
+		return nil, apperror.ToGRPCError(err, "failed to get category coverage")
+	}
+
+	// Convert to proto response
+	pbCoverage := make([]*pb.CategoryCoverage, len(coverage))
+	for i, category := range coverage {
+		pbCoverage[i] = &pb.CategoryCoverage{
+			CategoryName: category.CategoryName,
+			RatedTickets: int32(category.RatedTickets),
+			TotalTickets: int32(category.TotalTickets),
+			CoveragePct:  category.CoveragePct,
+		}
+	}
+
+	return &pb.GetCategoryCoverageResponse{Categories: pbCoverage}, nil
+}
+
+// GetCategoryScoreDayPercentile handles the gRPC request for ranking a day's category score
+func (s *RatingAnalyticsServer) GetCategoryScoreDayPercentile(ctx context.Context, req *pb.GetCategoryScoreDayPercentileRequest) (*pb.DayPercentile, error) {
+	// Validate request
+	if req.CategoryId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "category_id must be positive")
+	}
+	date, err := time.Parse(utils.DateLayout, req.Date)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid date format, expected YYYY-MM-DD")
+	}
+
+	// Call service layer
+	percentile, err := s.analyticsService.GetCategoryScoreDayPercentile(ctx, int(req.CategoryId), date)
+	if err != nil {
+		if errors.Is(err, service.ErrCategoryNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, apperror.ToGRPCError(err, "failed to get category score day percentile")
+	}
+
+	return &pb.DayPercentile{
+		Date:                percentile.Date,
+		Score:               percentile.Score,
+		Percentile:          percentile.Percentile,
+		HistoricalDaysCount: int32(percentile.HistoricalDaysCount),
+	}, nil
+}
+
+// GetMonthlyCategoryHeatmap handles the gRPC request for the monthly category heat map
+func (s *RatingAnalyticsServer) GetMonthlyCategoryHeatmap(ctx context.Context, req *pb.GetMonthlyCategoryHeatmapRequest) (*pb.CategoryHeatmap, error) {
+	// Validate request
+	if req.Year < 1 || req.Year > 9999 {
+		return nil, status.Error(codes.InvalidArgument, "year must be between 1 and 9999")
+	}
+
+	// Call service layer
+	heatmap, err := s.analyticsService.GetMonthlyCategoryHeatmap(ctx, int(req.Year))
+	if err != nil {
+		return nil, apperror.ToGRPCError(err, "failed to get monthly category heatmap")
+	}
+
+	// Convert to proto response
+	scoreMatrix := make([]*pb.HeatmapRow, len(heatmap.ScoreMatrix))
+	for i, scores := range heatmap.ScoreMatrix {
+		scoreMatrix[i] = &pb.HeatmapRow{Scores: scores}
+	}
+
+	return &pb.CategoryHeatmap{
+		Year:        int32(heatmap.Year),
+		Categories:  heatmap.Categories,
+		Months:      heatmap.Months,
+		ScoreMatrix: scoreMatrix,
+	}, nil
+}
+
+// BulkImportRatings handles the gRPC client streaming request for importing ratings.
+// Ratings are inserted in batches of importBatchSize; batches inserted before an error are kept.
+func (s *RatingAnalyticsServer) BulkImportRatings(stream grpc.ClientStreamingServer[pb.ImportRating, pb.BulkImportRatingsResponse]) error {
+	ctx := stream.Context()
+	batch := make([]models.Rating, 0, importBatchSize)
+	imported := 0
+
+	flush := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+		if err := s.analyticsService.ImportRatings(ctx, batch); err != nil {
+			if errors.Is(err, service.ErrInvalidRating) {
+				return status.Errorf(codes.InvalidArgument, "failed to import ratings after %d imported: %v", imported, err)
+			}
+			return apperror.ToGRPCError(err, fmt.Sprintf("failed to import ratings after %d imported", imported))
+		}
+		imported += len(batch)
+		batch = make([]models.Rating, 0, importBatchSize)
+		return nil
+	}
+
+	for row := 0; ; row++ {
+		req, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		rating, err := importRatingFromProto(req)
+		if err == nil {
+			err = s.analyticsService.ValidateRating(rating)
+		}
+		if err != nil {
+			return status.Errorf(codes.InvalidArgument, "rating %d: %v", row, err)
+		}
+
+		batch = append(batch, rating)
+		if len(batch) == importBatchSize {
+			if err := flush(); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := flush(); err != nil {
+		return err
+	}
+
+	return stream.SendAndClose(&pb.BulkImportRatingsResponse{
+		ImportedCount: int32(imported),
+	})
+}
+
+// importRatingFromProto converts a proto ImportRating to a rating model
+func importRatingFromProto(req *pb.ImportRating) (models.Rating, error) {
+	createdAt, err := time.Parse(time.RFC3339, req.CreatedAt)
+	if err != nil {
+		return models.Rating{}, errors.New("created_at must be in RFC 3339 format")
+	}
+
+	return models.Rating{
+		ID:               int(req.Id),
+		Rating:           int(req.Rating),
+		TicketID:         int(req.TicketId),
+		RatingCategoryID: int(req.RatingCategoryId),
+		ReviewerID:       int(req.ReviewerId),
+		RevieweeID:       int(req.RevieweeId),
+		CreatedAt:        createdAt,
+	}, nil
+}
+
+// convertCategoryAnalytics converts service layer CategoryAnalytics to proto CategoryAnalytics
+func convertCategoryAnalytics(analytics service.CategoryAnalytics) *pb.CategoryAnalytics {
+	return &pb.CategoryAnalytics{
+		Category:    analytics.Category,
+		Ratings:     int32(analytics.Ratings),
+		Score:       analytics.Score,
+		Dates:       convertDailyScores(analytics.Dates),
+		Aggregation: analytics.Aggregation,
+		StdDev:      analytics.StdDev,
+	}
+}
+
 // convertDailyScores converts service layer DailyScore to proto DailyScore
 func convertDailyScores(dailyScores []service.DailyScore) []*pb.DailyScore {
 	protoScores := make([]*pb.DailyScore, len(dailyScores))
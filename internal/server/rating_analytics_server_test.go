@@ -0,0 +1,636 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"ticket-score-service/internal/mocks"
+	"ticket-score-service/internal/models"
+	"ticket-score-service/internal/service"
+	pb "ticket-score-service/proto/generated/rating_analytics"
+)
+
+// mockAnalyticsService records the dates it is called with and returns canned analytics
+type mockAnalyticsService struct {
+	analytics []service.CategoryAnalytics
+	err       error
+	startDate time.Time
+	endDate   time.Time
+	calls     int
+}
+
+func (m *mockAnalyticsService) GetCategoryAnalytics(ctx context.Context, startDate, endDate time.Time) ([]service.CategoryAnalytics, error) {
+	m.calls++
+	m.startDate, m.endDate = startDate, endDate
+	return m.analytics, m.err
+}
+
+func (m *mockAnalyticsService) GetCategoryAnalyticsPaginated(ctx context.Context, startDate, endDate time.Time, limit, offset int) ([]service.CategoryAnalytics, int, error) {
+	m.calls++
+	return m.analytics, len(m.analytics), m.err
+}
+
+func (m *mockAnalyticsService) GetCategoryExtremeRatingCounts(ctx context.Context, categoryID, threshold int, startDate, endDate time.Time) (*service.ExtremeRatingCounts, error) {
+	return nil, m.err
+}
+
+func (m *mockAnalyticsService) GetCategoryScoreComparison(ctx context.Context, categoryID1, categoryID2 int, startDate, endDate time.Time) (*service.CategoryComparison, error) {
+	return nil, m.err
+}
+
+func (m *mockAnalyticsService) GetCategoryScoreConsistency(ctx context.Context, categoryID int, startDate, endDate time.Time) (*service.ConsistencyReport, error) {
+	return nil, m.err
+}
+
+func (m *mockAnalyticsService) GetScoreGap(ctx context.Context, startDate, endDate time.Time) (*service.ScoreGap, error) {
+	return nil, m.err
+}
+
+func (m *mockAnalyticsService) GetRatingCountTrend(ctx context.Context, startDate, endDate time.Time) (*service.RatingCountTrend, error) {
+	return nil, m.err
+}
+
+func (m *mockAnalyticsService) GetCategoryWeightImpact(ctx context.Context, startDate, endDate time.Time) ([]service.WeightImpact, error) {
+	return nil, m.err
+}
+
+func (m *mockAnalyticsService) GetCategoryScoreCorrelation(ctx context.Context, categoryID1, categoryID2 int, startDate, endDate time.Time) (*service.CorrelationReport, error) {
+	return nil, m.err
+}
+
+func (m *mockAnalyticsService) GetCategoryScoreByWeekday(ctx context.Context, categoryID int, startDate, endDate time.Time) (*service.WeekdayBreakdown, error) {
+	return nil, m.err
+}
+
+func (m *mockAnalyticsService) GetSeasonallyAdjustedScores(ctx context.Context, categoryID int, startDate, endDate time.Time) ([]service.DailyScore, error) {
+	return nil, m.err
+}
+
+func (m *mockAnalyticsService) GetScoreChangeAlerts(ctx context.Context, categoryID int, startDate, endDate time.Time, minChangePct float64) ([]service.ScoreChangeAlert, error) {
+	m.calls++
+	return nil, m.err
+}
+
+func (m *mockAnalyticsService) GetRatingsByCategoryAndReviewer(ctx context.Context, categoryID, reviewerID int, startDate, endDate time.Time) ([]models.Rating, error) {
+	m.calls++
+	return nil, m.err
+}
+
+func (m *mockAnalyticsService) GetCategoryRank(ctx context.Context, categoryID int, startDate, endDate time.Time) (*service.CategoryRank, error) {
+	return nil, m.err
+}
+
+func (m *mockAnalyticsService) GetCategoryScoreHeatmapByHourAndWeekday(ctx context.Context, categoryID int, startDate, endDate time.Time) (*service.HourWeekdayHeatmap, error) {
+	return nil, m.err
+}
+
+func (m *mockAnalyticsService) GetTopImprovingCategories(ctx context.Context, currentStart, currentEnd, previousStart, previousEnd time.Time, limit int) ([]service.CategoryChange, error) {
+	return nil, m.err
+}
+
+func (m *mockAnalyticsService) GetTopDecliningCategories(ctx context.Context, currentStart, currentEnd, previousStart, previousEnd time.Time, limit int) ([]service.CategoryChange, error) {
+	return nil, m.err
+}
+
+func (m *mockAnalyticsService) GetCategoryScoreForecast(ctx context.Context, categoryID int, lookbackDays, forecastDays int) (*service.ScoreForecast, error) {
+	m.calls++
+	if m.err != nil {
+		return nil, m.err
+	}
+	return &service.ScoreForecast{}, nil
+}
+
+func (m *mockAnalyticsService) GetCategoryMovingRange(ctx context.Context, categoryID int, startDate, endDate time.Time) (*service.MovingRangeReport, error) {
+	return nil, m.err
+}
+
+func (m *mockAnalyticsService) GetScoreByReviewerCountBucket(ctx context.Context, categoryID int, startDate, endDate time.Time) ([]service.ReviewerCountBucket, error) {
+	return nil, m.err
+}
+
+func (m *mockAnalyticsService) GetCategoryStabilityIndex(ctx context.Context, categoryID int, startDate, endDate time.Time) (*service.StabilityIndex, error) {
+	return nil, m.err
+}
+
+func (m *mockAnalyticsService) GetCategoryScoreDrift(ctx context.Context, categoryID int, startDate, endDate time.Time) (*service.DriftReport, error) {
+	return nil, m.err
+}
+
+func (m *mockAnalyticsService) GetCategoryCoverage(ctx context.Context, startDate, endDate time.Time) ([]service.CategoryCoverage, error) {
+	return nil, m.err
+}
+
+func (m *mockAnalyticsService) GetCategoryScoreDayPercentile(ctx context.Context, categoryID int, date time.Time) (*service.DayPercentile, error) {
+	return nil, m.err
+}
+
+func (m *mockAnalyticsService) GetMonthlyCategoryHeatmap(ctx context.Context, year int) (*service.CategoryHeatmap, error) {
+	return nil, m.err
+}
+
+func (m *mockAnalyticsService) ValidateRating(rating models.Rating) error {
+	return nil
+}
+
+func (m *mockAnalyticsService) ImportRatings(ctx context.Context, ratings []models.Rating) error {
+	return m.err
+}
+
+// mockImportStream replays ImportRating messages and records the response
+type mockImportStream struct {
+	grpc.ServerStream
+	ctx      context.Context
+	ratings  []*pb.ImportRating
+	response *pb.BulkImportRatingsResponse
+}
+
+func (m *mockImportStream) Context() context.Context {
+	return m.ctx
+}
+
+func (m *mockImportStream) Recv() (*pb.ImportRating, error) {
+	if len(m.ratings) == 0 {
+		return nil, io.EOF
+	}
+	rating := m.ratings[0]
+	m.ratings = m.ratings[1:]
+	return rating, nil
+}
+
+func (m *mockImportStream) SendAndClose(response *pb.BulkImportRatingsResponse) error {
+	m.response = response
+	return nil
+}
+
+// importRatings builds count ImportRating messages with sequential IDs
+func importRatings(count int) []*pb.ImportRating {
+	ratings := make([]*pb.ImportRating, count)
+	for i := range ratings {
+		ratings[i] = &pb.ImportRating{
+			Id:               int32(i + 1),
+			Rating:           int32(i % 6),
+			TicketId:         int32(i/4 + 1),
+			RatingCategoryId: int32(i%4 + 1),
+			ReviewerId:       1,
+			RevieweeId:       2,
+			CreatedAt:        "2019-10-01T12:00:00Z",
+		}
+	}
+	return ratings
+}
+
+func TestRatingAnalyticsServer_BulkImportRatings(t *testing.T) {
+	tests := []struct {
+		name              string
+		ratings           []*pb.ImportRating
+		insertErr         error
+		expectedBatches   []int
+		expectedImported  int32
+		expectedErrorCode codes.Code
+		expectedMessage   string
+	}{
+		{
+			name:             "partial batch",
+			ratings:          importRatings(97),
+			expectedBatches:  []int{97},
+			expectedImported: 97,
+		},
+		{
+			name:             "full batch",
+			ratings:          importRatings(100),
+			expectedBatches:  []int{100},
+			expectedImported: 100,
+		},
+		{
+			name:             "multiple batches",
+			ratings:          importRatings(250),
+			expectedBatches:  []int{100, 100, 50},
+			expectedImported: 250,
+		},
+		{
+			name:             "empty stream",
+			ratings:          nil,
+			expectedImported: 0,
+		},
+		{
+			name: "invalid created_at",
+			ratings: []*pb.ImportRating{
+				{Id: 1, Rating: 5, TicketId: 1, RatingCategoryId: 1, ReviewerId: 1, RevieweeId: 2, CreatedAt: "2019-10-01"},
+			},
+			expectedErrorCode: codes.InvalidArgument,
+		},
+		{
+			name:              "rating above the maximum",
+			ratings:           append(importRatings(120), &pb.ImportRating{Id: 121, Rating: 6, TicketId: 1, RatingCategoryId: 1, ReviewerId: 1, RevieweeId: 2, CreatedAt: "2019-10-01T12:00:00Z"}),
+			expectedErrorCode: codes.InvalidArgument,
+			expectedMessage:   "rating 120",
+		},
+		{
+			name: "negative rating",
+			ratings: []*pb.ImportRating{
+				{Id: 1, Rating: -1, TicketId: 1, RatingCategoryId: 1, ReviewerId: 1, RevieweeId: 2, CreatedAt: "2019-10-01T12:00:00Z"},
+			},
+			expectedErrorCode: codes.InvalidArgument,
+			expectedMessage:   "rating 0",
+		},
+		{
+			name: "missing ticket ID",
+			ratings: []*pb.ImportRating{
+				{Id: 1, Rating: 4, RatingCategoryId: 1, ReviewerId: 1, RevieweeId: 2, CreatedAt: "2019-10-01T12:00:00Z"},
+			},
+			expectedErrorCode: codes.InvalidArgument,
+			expectedMessage:   "ticket_id",
+		},
+		{
+			name:              "insert error",
+			ratings:           importRatings(10),
+			insertErr:         errors.New("UNIQUE constraint failed: ratings.id"),
+			expectedErrorCode: codes.Internal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ratingsRepo := &mocks.MockRatingsRepo{InsertErr: tt.insertErr}
+			server := NewRatingAnalyticsServer(service.NewRatingAnalyticsService(nil, ratingsRepo, service.NewTicketScoreService()))
+			stream := &mockImportStream{ctx: context.Background(), ratings: tt.ratings}
+
+			err := server.BulkImportRatings(stream)
+
+			if tt.expectedErrorCode != codes.OK {
+				if status.Code(err) != tt.expectedErrorCode {
+					t.Fatalf("Expected error code %v, got %v", tt.expectedErrorCode, err)
+				}
+				if !strings.Contains(status.Convert(err).Message(), tt.expectedMessage) {
+					t.Errorf("Expected message containing %q, got %q", tt.expectedMessage, status.Convert(err).Message())
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if stream.response == nil || stream.response.ImportedCount != tt.expectedImported {
+				t.Errorf("Expected %d ratings imported, got %+v", tt.expectedImported, stream.response)
+			}
+
+			if len(ratingsRepo.Inserted) != len(tt.expectedBatches) {
+				t.Fatalf("Expected %d batches, got %d", len(tt.expectedBatches), len(ratingsRepo.Inserted))
+			}
+			for i, size := range tt.expectedBatches {
+				if len(ratingsRepo.Inserted[i]) != size {
+					t.Errorf("Expected batch %d to have %d ratings, got %d", i, size, len(ratingsRepo.Inserted[i]))
+				}
+			}
+
+			// Ratings must arrive in stream order with their fields intact
+			if len(ratingsRepo.Inserted) > 0 {
+				first := ratingsRepo.Inserted[0][0]
+				expectedCreatedAt := time.Date(2019, 10, 1, 12, 0, 0, 0, time.UTC)
+				if first.ID != 1 || first.TicketID != 1 || first.RatingCategoryID != 1 || !first.CreatedAt.Equal(expectedCreatedAt) {
+					t.Errorf("Unexpected first rating: %+v", first)
+				}
+			}
+		})
+	}
+}
+
+func TestConvertDailyScores(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    []service.DailyScore
+		expected []*pb.DailyScore
+	}{
+		{
+			name:     "empty input",
+			input:    []service.DailyScore{},
+			expected: []*pb.DailyScore{},
+		},
+		{
+			name:     "nil input",
+			input:    nil,
+			expected: []*pb.DailyScore{},
+		},
+		{
+			name:     "single N/A score",
+			input:    []service.DailyScore{{Date: "2019-10-01", Score: "N/A"}},
+			expected: []*pb.DailyScore{{Date: "2019-10-01", Score: "N/A"}},
+		},
+		{
+			name: "multiple scores",
+			input: []service.DailyScore{
+				{Date: "2019-10-01", Score: "85%"},
+				{Date: "2019-10-02", Score: "N/A"},
+				{Date: "2019-10-03", Score: "100%"},
+			},
+			expected: []*pb.DailyScore{
+				{Date: "2019-10-01", Score: "85%"},
+				{Date: "2019-10-02", Score: "N/A"},
+				{Date: "2019-10-03", Score: "100%"},
+			},
+		},
+		{
+			name: "weekly format",
+			input: []service.DailyScore{
+				{Date: "2019-09-30 to 2019-10-06", Score: "78%"},
+				{Date: "2019-10-07 to 2019-10-13", Score: "82%"},
+			},
+			expected: []*pb.DailyScore{
+				{Date: "2019-09-30 to 2019-10-06", Score: "78%"},
+				{Date: "2019-10-07 to 2019-10-13", Score: "82%"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := convertDailyScores(tt.input)
+
+			if result == nil {
+				t.Fatal("Expected an empty slice, got nil")
+			}
+			if len(result) != len(tt.expected) {
+				t.Fatalf("Expected %d scores, got %d", len(tt.expected), len(result))
+			}
+			for i, expected := range tt.expected {
+				if result[i].Date != expected.Date || result[i].Score != expected.Score {
+					t.Errorf("Score %d: expected %s %s, got %s %s", i, expected.Date, expected.Score, result[i].Date, result[i].Score)
+				}
+			}
+		})
+	}
+}
+
+func TestGetCategoryAnalytics_ServerValidation(t *testing.T) {
+	analytics := []service.CategoryAnalytics{
+		{
+			CategoryID: 1,
+			Category:   "Spelling",
+			Ratings:    3,
+			Dates:      []service.DailyScore{{Date: "2019-10-01", Score: "80%"}},
+			Score:      "80%",
+			StdDev:     "16%",
+		},
+		{
+			CategoryID: 2,
+			Category:   "Grammar",
+			Ratings:    0,
+			Dates:      []service.DailyScore{{Date: "2019-10-01", Score: "N/A"}},
+			Score:      "N/A",
+			StdDev:     "N/A",
+		},
+	}
+
+	tests := []struct {
+		name              string
+		startDate         string
+		endDate           string
+		serviceErr        error
+		expectedErrorCode codes.Code
+		expectedStart     time.Time
+		expectedEnd       time.Time
+	}{
+		{name: "missing start_date", endDate: "2019-10-07", expectedErrorCode: codes.InvalidArgument},
+		{name: "missing end_date", startDate: "2019-10-01", expectedErrorCode: codes.InvalidArgument},
+		{name: "missing both dates", expectedErrorCode: codes.InvalidArgument},
+		{name: "start after end", startDate: "2019-10-07", endDate: "2019-10-01", expectedErrorCode: codes.InvalidArgument},
+		{name: "invalid start_date format", startDate: "10/01/2019", endDate: "2019-10-07", expectedErrorCode: codes.InvalidArgument},
+		{name: "invalid end_date format", startDate: "2019-10-01", endDate: "2019-10-7", expectedErrorCode: codes.InvalidArgument},
+		{name: "invalid calendar date", startDate: "2019-02-30", endDate: "2019-03-01", expectedErrorCode: codes.InvalidArgument},
+		{
+			name:          "same date range",
+			startDate:     "2019-10-01",
+			endDate:       "2019-10-01",
+			expectedStart: time.Date(2019, 10, 1, 0, 0, 0, 0, time.UTC),
+			expectedEnd:   time.Date(2019, 10, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:          "valid range",
+			startDate:     "2019-10-01",
+			endDate:       "2019-10-07",
+			expectedStart: time.Date(2019, 10, 1, 0, 0, 0, 0, time.UTC),
+			expectedEnd:   time.Date(2019, 10, 7, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:              "service error",
+			startDate:         "2019-10-01",
+			endDate:           "2019-10-07",
+			serviceErr:        errors.New("database error"),
+			expectedErrorCode: codes.Internal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := &mockAnalyticsService{analytics: analytics, err: tt.serviceErr}
+			server := NewRatingAnalyticsServer(mockService)
+
+			response, err := server.GetCategoryAnalytics(context.Background(), &pb.GetCategoryAnalyticsRequest{
+				StartDate: tt.startDate,
+				EndDate:   tt.endDate,
+			})
+
+			if tt.expectedErrorCode != codes.OK {
+				if status.Code(err) != tt.expectedErrorCode {
+					t.Fatalf("Expected error code %v, got %v", tt.expectedErrorCode, err)
+				}
+				if tt.expectedErrorCode == codes.InvalidArgument && mockService.calls != 0 {
+					t.Error("Expected the service not to be called for an invalid request")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if !mockService.startDate.Equal(tt.expectedStart) || !mockService.endDate.Equal(tt.expectedEnd) {
+				t.Errorf("Expected service to be called with %v to %v, got %v to %v",
+					tt.expectedStart, tt.expectedEnd, mockService.startDate, mockService.endDate)
+			}
+
+			if len(response.Analytics) != len(analytics) {
+				t.Fatalf("Expected %d categories, got %d", len(analytics), len(response.Analytics))
+			}
+			for i, expected := range analytics {
+				actual := response.Analytics[i]
+				if actual.Category != expected.Category || actual.Ratings != int32(expected.Ratings) || actual.Score != expected.Score || actual.StdDev != expected.StdDev {
+					t.Errorf("Category %d: expected %+v, got %+v", i, expected, actual)
+				}
+				dates := make([]service.DailyScore, len(actual.Dates))
+				for j, date := range actual.Dates {
+					dates[j] = service.DailyScore{Date: date.Date, Score: date.Score}
+				}
+				if !reflect.DeepEqual(dates, expected.Dates) {
+					t.Errorf("Category %d: expected dates %+v, got %+v", i, expected.Dates, dates)
+				}
+			}
+		})
+	}
+}
+
+func TestGetCategoryAnalyticsPaginated_ServerValidation(t *testing.T) {
+	analytics := []service.CategoryAnalytics{{CategoryID: 1, Category: "Spelling", Ratings: 1, Score: "80%"}}
+
+	tests := []struct {
+		name              string
+		limit             int32
+		offset            int32
+		startDate         string
+		serviceErr        error
+		expectedErrorCode codes.Code
+	}{
+		{name: "valid page", limit: 10, offset: 0, startDate: "2019-10-01"},
+		{name: "zero limit", limit: 0, startDate: "2019-10-01", expectedErrorCode: codes.InvalidArgument},
+		{name: "negative offset", limit: 10, offset: -1, startDate: "2019-10-01", expectedErrorCode: codes.InvalidArgument},
+		{name: "missing start_date", limit: 10, expectedErrorCode: codes.InvalidArgument},
+		{name: "service error", limit: 10, startDate: "2019-10-01", serviceErr: errors.New("database error"), expectedErrorCode: codes.Internal},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := &mockAnalyticsService{analytics: analytics, err: tt.serviceErr}
+			server := NewRatingAnalyticsServer(mockService)
+
+			response, err := server.GetCategoryAnalyticsPaginated(context.Background(), &pb.GetCategoryAnalyticsPaginatedRequest{
+				StartDate: tt.startDate,
+				EndDate:   "2019-10-07",
+				Limit:     tt.limit,
+				Offset:    tt.offset,
+			})
+
+			if tt.expectedErrorCode != codes.OK {
+				if status.Code(err) != tt.expectedErrorCode {
+					t.Fatalf("Expected error code %v, got %v", tt.expectedErrorCode, err)
+				}
+				if tt.expectedErrorCode == codes.InvalidArgument && mockService.calls != 0 {
+					t.Error("Expected the service not to be called for an invalid request")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if response.TotalCount != 1 || response.Limit != tt.limit || response.Offset != tt.offset || len(response.Analytics) != 1 {
+				t.Errorf("Unexpected response %+v", response)
+			}
+		})
+	}
+}
+
+func TestGetScoreChangeAlerts_ServerValidation(t *testing.T) {
+	tests := []struct {
+		name              string
+		minChangePct      float64
+		expectedErrorCode codes.Code
+	}{
+		{name: "smallest threshold", minChangePct: 0.01},
+		{name: "largest threshold", minChangePct: 100},
+		{name: "zero threshold", minChangePct: 0, expectedErrorCode: codes.InvalidArgument},
+		{name: "negative threshold", minChangePct: -5, expectedErrorCode: codes.InvalidArgument},
+		{name: "threshold above 100", minChangePct: 100.5, expectedErrorCode: codes.InvalidArgument},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := &mockAnalyticsService{}
+			server := NewRatingAnalyticsServer(mockService)
+
+			_, err := server.GetScoreChangeAlerts(context.Background(), &pb.GetScoreChangeAlertsRequest{
+				CategoryId:   1,
+				StartDate:    "2019-10-01",
+				EndDate:      "2019-10-07",
+				MinChangePct: tt.minChangePct,
+			})
+
+			if status.Code(err) != tt.expectedErrorCode {
+				t.Fatalf("Expected error code %v, got %v", tt.expectedErrorCode, err)
+			}
+			if tt.expectedErrorCode == codes.InvalidArgument && mockService.calls != 0 {
+				t.Error("Expected the service not to be called for an invalid request")
+			}
+		})
+	}
+}
+
+func TestGetRatingsByCategoryAndReviewer_ServerValidation(t *testing.T) {
+	tests := []struct {
+		name              string
+		categoryID        int32
+		reviewerID        int32
+		serviceErr        error
+		expectedErrorCode codes.Code
+	}{
+		{name: "valid request", categoryID: 1, reviewerID: 2},
+		{name: "invalid category_id", categoryID: 0, reviewerID: 2, expectedErrorCode: codes.InvalidArgument},
+		{name: "invalid reviewer_id", categoryID: 1, reviewerID: -1, expectedErrorCode: codes.InvalidArgument},
+		{name: "unknown category", categoryID: 99, reviewerID: 2, serviceErr: service.ErrCategoryNotFound, expectedErrorCode: codes.NotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := &mockAnalyticsService{err: tt.serviceErr}
+			server := NewRatingAnalyticsServer(mockService)
+
+			_, err := server.GetRatingsByCategoryAndReviewer(context.Background(), &pb.GetRatingsByCategoryAndReviewerRequest{
+				CategoryId: tt.categoryID,
+				ReviewerId: tt.reviewerID,
+				StartDate:  "2019-10-01",
+				EndDate:    "2019-10-07",
+			})
+
+			if status.Code(err) != tt.expectedErrorCode {
+				t.Fatalf("Expected error code %v, got %v", tt.expectedErrorCode, err)
+			}
+			if tt.expectedErrorCode == codes.InvalidArgument && mockService.calls != 0 {
+				t.Error("Expected the service not to be called for an invalid request")
+			}
+		})
+	}
+}
+
+func TestGetCategoryScoreForecast_ServerValidation(t *testing.T) {
+	tests := []struct {
+		name              string
+		lookbackDays      int32
+		forecastDays      int32
+		serviceErr        error
+		expectedErrorCode codes.Code
+	}{
+		{name: "valid request", lookbackDays: 30, forecastDays: 7},
+		{name: "lookback too short", lookbackDays: 1, forecastDays: 7, expectedErrorCode: codes.InvalidArgument},
+		{name: "lookback too long", lookbackDays: 366, forecastDays: 7, expectedErrorCode: codes.InvalidArgument},
+		{name: "no forecast days", lookbackDays: 30, forecastDays: 0, expectedErrorCode: codes.InvalidArgument},
+		{name: "too many forecast days", lookbackDays: 30, forecastDays: 91, expectedErrorCode: codes.InvalidArgument},
+		{name: "unknown category", lookbackDays: 30, forecastDays: 7, serviceErr: service.ErrCategoryNotFound, expectedErrorCode: codes.NotFound},
+		{name: "insufficient data", lookbackDays: 30, forecastDays: 7, serviceErr: service.ErrInsufficientForecastData, expectedErrorCode: codes.FailedPrecondition},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := &mockAnalyticsService{err: tt.serviceErr}
+			server := NewRatingAnalyticsServer(mockService)
+
+			_, err := server.GetCategoryScoreForecast(context.Background(), &pb.GetCategoryScoreForecastRequest{
+				CategoryId:   1,
+				LookbackDays: tt.lookbackDays,
+				ForecastDays: tt.forecastDays,
+			})
+
+			if status.Code(err) != tt.expectedErrorCode {
+				t.Fatalf("Expected error code %v, got %v", tt.expectedErrorCode, err)
+			}
+			if tt.expectedErrorCode == codes.InvalidArgument && mockService.calls != 0 {
+				t.Error("Expected the service not to be called for an invalid request")
+			}
+		})
+	}
+}
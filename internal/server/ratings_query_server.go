@@ -0,0 +1,138 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"ticket-score-service/internal/apperror"
+	"ticket-score-service/internal/models"
+	"ticket-score-service/internal/service"
+	"ticket-score-service/internal/utils"
+	pb "ticket-score-service/proto/generated/ratings_query"
+)
+
+// RatingsQueryServer implements the gRPC server for raw ratings queries
+type RatingsQueryServer struct {
+	pb.UnimplementedRatingsQueryServiceServer
+	ratingsQueryService *service.RatingsQueryService
+}
+
+// NewRatingsQueryServer creates a new gRPC server instance
+func NewRatingsQueryServer(ratingsQueryService *service.RatingsQueryService) *RatingsQueryServer {
+	return &RatingsQueryServer{
+		ratingsQueryService: ratingsQueryService,
+	}
+}
+
+// GetRatingsPage handles the gRPC request for a page of ratings
+func (s *RatingsQueryServer) GetRatingsPage(ctx context.Context, req *pb.GetRatingsPageRequest) (*pb.GetRatingsPageResponse, error) {
+	// Build the filter, validating the optional dates
+	filter := models.RatingsFilter{
+		CategoryIDs: toInts(req.CategoryIds),
+		ReviewerIDs: toInts(req.ReviewerIds),
+		RevieweeIDs: toInts(req.RevieweeIds),
+	}
+	if req.MinRating != nil {
+		minRating := int(*req.MinRating)
+		filter.MinRating = &minRating
+	}
+	if req.MaxRating != nil {
+		maxRating := int(*req.MaxRating)
+		filter.MaxRating = &maxRating
+	}
+
+	var err error
+	if req.StartDate != "" {
+		if filter.StartDate, err = time.Parse(utils.DateLayout, req.StartDate); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid start_date format: %v", err)
+		}
+	}
+	if req.EndDate != "" {
+		if filter.EndDate, err = time.Parse(utils.DateLayout, req.EndDate); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid end_date format: %v", err)
+		}
+	}
+
+	field, ok := sortFields[req.SortField]
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "unsupported sort_field %v", req.SortField)
+	}
+	direction := models.SortAscending
+	switch req.SortDirection {
+	case pb.SortDirection_ASC:
+	case pb.SortDirection_DESC:
+		direction = models.SortDescending
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unsupported sort_direction %v", req.SortDirection)
+	}
+
+	// Call service layer
+	page, err := s.ratingsQueryService.GetRatingsPage(ctx, filter, field, direction, int(req.Limit), int(req.Offset))
+	if err != nil {
+		if errors.Is(err, service.ErrInvalidRatingsQuery) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, apperror.ToGRPCError(err, "failed to get ratings page")
+	}
+
+	// Convert to proto response
+	return &pb.GetRatingsPageResponse{
+		Ratings:    ratingsToProto(page.Ratings),
+		TotalCount: int32(page.TotalCount),
+		HasMore:    page.HasMore,
+	}, nil
+}
+
+// GetRatingAuditTrail handles the gRPC request for every rating a reviewer gave a ticket
+func (s *RatingsQueryServer) GetRatingAuditTrail(ctx context.Context, req *pb.GetRatingAuditTrailRequest) (*pb.GetRatingAuditTrailResponse, error) {
+	ratings, err := s.ratingsQueryService.GetRatingAuditTrail(ctx, int(req.ReviewerId), int(req.TicketId))
+	if err != nil {
+		if errors.Is(err, service.ErrInvalidRatingsQuery) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, apperror.ToGRPCError(err, "failed to get rating audit trail")
+	}
+
+	return &pb.GetRatingAuditTrailResponse{Ratings: ratingsToProto(ratings)}, nil
+}
+
+// ratingsToProto converts ratings to proto messages
+func ratingsToProto(ratings []models.Rating) []*pb.Rating {
+	pbRatings := make([]*pb.Rating, 0, len(ratings))
+	for _, rating := range ratings {
+		pbRatings = append(pbRatings, &pb.Rating{
+			Id:         int32(rating.ID),
+			Rating:     int32(rating.Rating),
+			TicketId:   int32(rating.TicketID),
+			CategoryId: int32(rating.RatingCategoryID),
+			ReviewerId: int32(rating.ReviewerID),
+			RevieweeId: int32(rating.RevieweeID),
+			CreatedAt:  rating.CreatedAt.Format(time.RFC3339),
+		})
+	}
+	return pbRatings
+}
+
+// sortFields maps the proto sort fields to the model ones
+var sortFields = map[pb.RatingsSortField]models.RatingsSortField{
+	pb.RatingsSortField_CREATED_AT: models.SortByCreatedAt,
+	pb.RatingsSortField_RATING:     models.SortByRating,
+	pb.RatingsSortField_TICKET_ID:  models.SortByTicketID,
+	pb.RatingsSortField_ID:         models.SortByID,
+}
+
+// toInts converts proto IDs to ints
+func toInts(ids []int32) []int {
+	if len(ids) == 0 {
+		return nil
+	}
+	result := make([]int, len(ids))
+	for i, id := range ids {
+		result[i] = int(id)
+	}
+	return result
+}
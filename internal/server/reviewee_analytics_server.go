@@ -0,0 +1,188 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"ticket-score-service/internal/apperror"
+	"ticket-score-service/internal/service"
+	"ticket-score-service/internal/utils"
+	pb "ticket-score-service/proto/generated/reviewee_analytics"
+)
+
+// RevieweeAnalyticsServer implements the gRPC server for reviewee analytics
+type RevieweeAnalyticsServer struct {
+	pb.UnimplementedRevieweeAnalyticsServiceServer
+	revieweeService *service.RevieweeAnalyticsService
+}
+
+// NewRevieweeAnalyticsServer creates a new gRPC server instance
+func NewRevieweeAnalyticsServer(revieweeService *service.RevieweeAnalyticsService) *RevieweeAnalyticsServer {
+	return &RevieweeAnalyticsServer{
+		revieweeService: revieweeService,
+	}
+}
+
+// GetCategoryScoreForReviewee handles the gRPC request for a reviewee's score in a category
+func (s *RevieweeAnalyticsServer) GetCategoryScoreForReviewee(ctx context.Context, req *pb.GetCategoryScoreForRevieweeRequest) (*pb.RevieweeCategoryScore, error) {
+	// Validate request
+	if req.RevieweeId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "reviewee_id must be positive")
+	}
+	if req.CategoryId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "category_id must be positive")
+	}
+
+	dateRange, err := utils.ParseDateRange(req.StartDate, req.EndDate)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// Call service layer
+	result, err := s.revieweeService.GetRevieweeCategoryScores(ctx, int(req.RevieweeId), int(req.CategoryId), dateRange.Start, dateRange.End)
+	if err != nil {
+		if errors.Is(err, service.ErrCategoryNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, apperror.ToGRPCError(err, "failed to get reviewee category score")
+	}
+
+	// Convert to proto response
+	pbDaily := make([]*pb.DailyScore, 0, len(result.DailyBreakdown))
+	for _, daily := range result.DailyBreakdown {
+		pbDaily = append(pbDaily, &pb.DailyScore{
+			Date:  daily.Date,
+			Score: daily.Score,
+		})
+	}
+
+	return &pb.RevieweeCategoryScore{
+		RevieweeId:     int32(result.RevieweeID),
+		CategoryId:     int32(result.CategoryID),
+		CategoryName:   result.CategoryName,
+		TotalRatings:   int32(result.TotalRatings),
+		Score:          result.Score,
+		DailyBreakdown: pbDaily,
+	}, nil
+}
+
+// GetRevieweeTickets handles the gRPC request for one page of a reviewee's tickets
+func (s *RevieweeAnalyticsServer) GetRevieweeTickets(ctx context.Context, req *pb.GetRevieweeTicketsRequest) (*pb.GetRevieweeTicketsResponse, error) {
+	// Validate request
+	if req.RevieweeId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "reviewee_id must be positive")
+	}
+	if req.Limit <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "limit must be positive")
+	}
+	if req.Offset < 0 {
+		return nil, status.Error(codes.InvalidArgument, "offset must not be negative")
+	}
+
+	dateRange, err := utils.ParseDateRange(req.StartDate, req.EndDate)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// Call service layer
+	tickets, totalCount, err := s.revieweeService.GetRevieweeTickets(ctx, int(req.RevieweeId), dateRange.Start, dateRange.End, int(req.Limit), int(req.Offset))
+	if err != nil {
+		return nil, apperror.ToGRPCError(err, "failed to get reviewee tickets")
+	}
+
+	// Convert to proto response
+	response := &pb.GetRevieweeTicketsResponse{
+		Tickets:    make([]*pb.RevieweeTicket, len(tickets)),
+		Limit:      req.Limit,
+		Offset:     req.Offset,
+		TotalCount: int32(totalCount),
+	}
+	for i, ticket := range tickets {
+		response.Tickets[i] = &pb.RevieweeTicket{
+			Id:        int32(ticket.ID),
+			Subject:   ticket.Subject,
+			CreatedAt: ticket.CreatedAt.Format(time.RFC3339),
+		}
+	}
+
+	return response, nil
+}
+
+// GetRevieweeCategoryHeatmap handles the gRPC request for every reviewee's score in every category
+func (s *RevieweeAnalyticsServer) GetRevieweeCategoryHeatmap(ctx context.Context, req *pb.GetRevieweeCategoryHeatmapRequest) (*pb.RevieweeCategoryHeatmap, error) {
+	// Validate request
+	dateRange, err := utils.ParseDateRange(req.StartDate, req.EndDate)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// Call service layer
+	heatmap, err := s.revieweeService.GetRevieweeCategoryHeatmap(ctx, dateRange.Start, dateRange.End)
+	if err != nil {
+		return nil, apperror.ToGRPCError(err, "failed to get reviewee category heatmap")
+	}
+
+	// Convert to proto response
+	response := &pb.RevieweeCategoryHeatmap{
+		RevieweeIds:   make([]int32, 0, len(heatmap.RevieweeIDs)),
+		CategoryNames: heatmap.CategoryNames,
+		ScoreMatrix:   make([]*pb.RevieweeScoreRow, 0, len(heatmap.ScoreMatrix)),
+	}
+	for _, revieweeID := range heatmap.RevieweeIDs {
+		response.RevieweeIds = append(response.RevieweeIds, int32(revieweeID))
+	}
+	for _, row := range heatmap.ScoreMatrix {
+		response.ScoreMatrix = append(response.ScoreMatrix, &pb.RevieweeScoreRow{Scores: row})
+	}
+
+	return response, nil
+}
+
+// GetRevieweeAnalytics handles the gRPC request for a reviewee's scores in each category
+func (s *RevieweeAnalyticsServer) GetRevieweeAnalytics(ctx context.Context, req *pb.GetRevieweeAnalyticsRequest) (*pb.GetRevieweeAnalyticsResponse, error) {
+	// Validate request
+	if req.RevieweeId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "reviewee_id must be positive")
+	}
+
+	dateRange, err := utils.ParseDateRange(req.StartDate, req.EndDate)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// Call service layer
+	analytics, err := s.revieweeService.GetRevieweeAnalytics(ctx, int(req.RevieweeId), dateRange.Start, dateRange.End)
+	if err != nil {
+		return nil, apperror.ToGRPCError(err, "failed to get reviewee analytics")
+	}
+
+	// Convert to proto response
+	pbAnalytics := make([]*pb.CategoryAnalytics, 0, len(analytics))
+	for _, categoryAnalytics := range analytics {
+		pbDates := make([]*pb.DailyScore, 0, len(categoryAnalytics.Dates))
+		for _, date := range categoryAnalytics.Dates {
+			pbDates = append(pbDates, &pb.DailyScore{
+				Date:  date.Date,
+				Score: date.Score,
+			})
+		}
+
+		pbAnalytics = append(pbAnalytics, &pb.CategoryAnalytics{
+			Category:    categoryAnalytics.Category,
+			Ratings:     int32(categoryAnalytics.Ratings),
+			Dates:       pbDates,
+			Score:       categoryAnalytics.Score,
+			Aggregation: categoryAnalytics.Aggregation,
+			StdDev:      categoryAnalytics.StdDev,
+		})
+	}
+
+	return &pb.GetRevieweeAnalyticsResponse{
+		RevieweeId: req.RevieweeId,
+		Analytics:  pbAnalytics,
+	}, nil
+}
@@ -0,0 +1,201 @@
+package server
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"ticket-score-service/internal/apperror"
+	"ticket-score-service/internal/service"
+	"ticket-score-service/internal/utils"
+	pb "ticket-score-service/proto/generated/reviewer_analytics"
+)
+
+// ReviewerAnalyticsServer implements the gRPC server for reviewer analytics
+type ReviewerAnalyticsServer struct {
+	pb.UnimplementedReviewerAnalyticsServiceServer
+	reviewerService *service.ReviewerAnalyticsService
+}
+
+// NewReviewerAnalyticsServer creates a new gRPC server instance
+func NewReviewerAnalyticsServer(reviewerService *service.ReviewerAnalyticsService) *ReviewerAnalyticsServer {
+	return &ReviewerAnalyticsServer{
+		reviewerService: reviewerService,
+	}
+}
+
+// GetReviewerCategoryBreakdown handles the gRPC request for a reviewer's ratings per category
+func (s *ReviewerAnalyticsServer) GetReviewerCategoryBreakdown(ctx context.Context, req *pb.GetReviewerCategoryBreakdownRequest) (*pb.GetReviewerCategoryBreakdownResponse, error) {
+	// Validate request
+	if req.ReviewerId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "reviewer_id must be positive")
+	}
+
+	dateRange, err := utils.ParseDateRange(req.StartDate, req.EndDate)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// Call service layer
+	scores, err := s.reviewerService.GetReviewerCategoryScores(ctx, int(req.ReviewerId), dateRange.Start, dateRange.End)
+	if err != nil {
+		return nil, apperror.ToGRPCError(err, "failed to get reviewer category breakdown")
+	}
+
+	// Convert to proto response
+	pbScores := make([]*pb.ReviewerCategoryScore, 0, len(scores))
+	for _, score := range scores {
+		pbScores = append(pbScores, &pb.ReviewerCategoryScore{
+			CategoryName:  score.CategoryName,
+			RatingCount:   int32(score.RatingCount),
+			AverageRating: score.AverageRating,
+			WeightedScore: score.WeightedScore,
+		})
+	}
+
+	return &pb.GetReviewerCategoryBreakdownResponse{Categories: pbScores}, nil
+}
+
+// GetReviewerConsistency handles the gRPC request for how consistently a reviewer rates each category
+func (s *ReviewerAnalyticsServer) GetReviewerConsistency(ctx context.Context, req *pb.GetReviewerConsistencyRequest) (*pb.GetReviewerConsistencyResponse, error) {
+	// Validate request
+	if req.ReviewerId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "reviewer_id must be positive")
+	}
+
+	dateRange, err := utils.ParseDateRange(req.StartDate, req.EndDate)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// Call service layer
+	consistency, err := s.reviewerService.GetReviewerConsistency(ctx, int(req.ReviewerId), dateRange.Start, dateRange.End)
+	if err != nil {
+		return nil, apperror.ToGRPCError(err, "failed to get reviewer consistency")
+	}
+
+	// Convert to proto response
+	pbConsistency := make([]*pb.ReviewerConsistency, 0, len(consistency))
+	for _, category := range consistency {
+		pbConsistency = append(pbConsistency, &pb.ReviewerConsistency{
+			CategoryName:     category.CategoryName,
+			RatingCount:      int32(category.RatingCount),
+			StdDev:           category.StdDev,
+			ConsistencyGrade: category.ConsistencyGrade,
+		})
+	}
+
+	return &pb.GetReviewerConsistencyResponse{Categories: pbConsistency}, nil
+}
+
+// GetWorkloadBalance handles the gRPC request for how ratings are spread across reviewers
+func (s *ReviewerAnalyticsServer) GetWorkloadBalance(ctx context.Context, req *pb.GetWorkloadBalanceRequest) (*pb.GetWorkloadBalanceResponse, error) {
+	// Validate request
+	dateRange, err := utils.ParseDateRange(req.StartDate, req.EndDate)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// Call service layer
+	report, err := s.reviewerService.GetWorkloadBalance(ctx, dateRange.Start, dateRange.End)
+	if err != nil {
+		return nil, apperror.ToGRPCError(err, "failed to get workload balance")
+	}
+
+	// Convert to proto response
+	pbReviewers := make([]*pb.ReviewerWorkload, 0, len(report.Reviewers))
+	for _, reviewer := range report.Reviewers {
+		pbReviewers = append(pbReviewers, &pb.ReviewerWorkload{
+			ReviewerId:         int32(reviewer.ReviewerID),
+			RatingCount:        int32(reviewer.RatingCount),
+			SharePct:           reviewer.SharePct,
+			CategoriesReviewed: int32(reviewer.CategoriesReviewed),
+		})
+	}
+
+	return &pb.GetWorkloadBalanceResponse{
+		TotalRatings: int32(report.TotalRatings),
+		Reviewers:    pbReviewers,
+	}, nil
+}
+
+// GetReviewerBiasAnalysis handles the gRPC request for how a reviewer's ratings deviate from other reviewers'
+func (s *ReviewerAnalyticsServer) GetReviewerBiasAnalysis(ctx context.Context, req *pb.GetReviewerBiasAnalysisRequest) (*pb.GetReviewerBiasAnalysisResponse, error) {
+	// Validate request
+	if req.ReviewerId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "reviewer_id must be positive")
+	}
+
+	dateRange, err := utils.ParseDateRange(req.StartDate, req.EndDate)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// Call service layer
+	analysis, err := s.reviewerService.GetReviewerBiasAnalysis(ctx, int(req.ReviewerId), dateRange.Start, dateRange.End)
+	if err != nil {
+		return nil, apperror.ToGRPCError(err, "failed to get reviewer bias analysis")
+	}
+
+	// Convert to proto response
+	pbBiases := make([]*pb.CategoryBias, 0, len(analysis.CategoryBiases))
+	for _, bias := range analysis.CategoryBiases {
+		pbBiases = append(pbBiases, &pb.CategoryBias{
+			CategoryName: bias.CategoryName,
+			MeanBias:     bias.MeanBias,
+			SampleSize:   int32(bias.SampleSize),
+			BiasLabel:    bias.BiasLabel,
+		})
+	}
+
+	return &pb.GetReviewerBiasAnalysisResponse{
+		ReviewerId:     int32(analysis.ReviewerID),
+		CategoryBiases: pbBiases,
+	}, nil
+}
+
+// GetReviewerAnalytics handles the gRPC request for a reviewer's scores in each category
+func (s *ReviewerAnalyticsServer) GetReviewerAnalytics(ctx context.Context, req *pb.GetReviewerAnalyticsRequest) (*pb.GetReviewerAnalyticsResponse, error) {
+	// Validate request
+	if req.ReviewerId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "reviewer_id must be positive")
+	}
+
+	dateRange, err := utils.ParseDateRange(req.StartDate, req.EndDate)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// Call service layer
+	analytics, err := s.reviewerService.GetReviewerAnalytics(ctx, int(req.ReviewerId), dateRange.Start, dateRange.End)
+	if err != nil {
+		return nil, apperror.ToGRPCError(err, "failed to get reviewer analytics")
+	}
+
+	// Convert to proto response
+	pbAnalytics := make([]*pb.CategoryAnalytics, 0, len(analytics))
+	for _, categoryAnalytics := range analytics {
+		pbDates := make([]*pb.DailyScore, 0, len(categoryAnalytics.Dates))
+		for _, date := range categoryAnalytics.Dates {
+			pbDates = append(pbDates, &pb.DailyScore{
+				Date:  date.Date,
+				Score: date.Score,
+			})
+		}
+
+		pbAnalytics = append(pbAnalytics, &pb.CategoryAnalytics{
+			Category:    categoryAnalytics.Category,
+			Ratings:     int32(categoryAnalytics.Ratings),
+			Dates:       pbDates,
+			Score:       categoryAnalytics.Score,
+			Aggregation: categoryAnalytics.Aggregation,
+			StdDev:      categoryAnalytics.StdDev,
+		})
+	}
+
+	return &pb.GetReviewerAnalyticsResponse{
+		ReviewerId: req.ReviewerId,
+		Analytics:  pbAnalytics,
+	}, nil
+}
@@ -0,0 +1,114 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"ticket-score-service/internal/apperror"
+	"ticket-score-service/internal/service"
+	"ticket-score-service/internal/utils"
+	pb "ticket-score-service/proto/generated/score_snapshots"
+)
+
+// ScoreSnapshotsServer implements the gRPC server for weekly score snapshots
+type ScoreSnapshotsServer struct {
+	pb.UnimplementedScoreSnapshotsServiceServer
+	snapshotService *service.SnapshotService
+}
+
+// NewScoreSnapshotsServer creates a new gRPC server instance
+func NewScoreSnapshotsServer(snapshotService *service.SnapshotService) *ScoreSnapshotsServer {
+	return &ScoreSnapshotsServer{
+		snapshotService: snapshotService,
+	}
+}
+
+// TakeWeeklySnapshot handles the gRPC request for taking a weekly snapshot
+func (s *ScoreSnapshotsServer) TakeWeeklySnapshot(ctx context.Context, req *pb.TakeWeeklySnapshotRequest) (*pb.TakeWeeklySnapshotResponse, error) {
+	// Validate request
+	if req.WeekStart == "" {
+		return nil, status.Error(codes.InvalidArgument, "week_start is required")
+	}
+
+	weekStart, err := time.Parse(utils.DateLayout, req.WeekStart)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid week_start format: %v", err)
+	}
+
+	// Call service layer
+	snapshotID, err := s.snapshotService.TakeWeeklySnapshot(ctx, weekStart)
+	if err != nil {
+		if errors.Is(err, service.ErrLockNotAcquired) {
+			return nil, status.Error(codes.Aborted, err.Error())
+		}
+		return nil, apperror.ToGRPCError(err, "failed to take weekly snapshot")
+	}
+
+	return &pb.TakeWeeklySnapshotResponse{SnapshotId: int32(snapshotID)}, nil
+}
+
+// GetSnapshot handles the gRPC request for getting a snapshot
+func (s *ScoreSnapshotsServer) GetSnapshot(ctx context.Context, req *pb.GetSnapshotRequest) (*pb.WeeklySnapshot, error) {
+	// Validate request
+	if req.SnapshotId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "snapshot_id must be positive")
+	}
+
+	// Call service layer
+	snapshot, err := s.snapshotService.GetSnapshot(ctx, int(req.SnapshotId))
+	if err != nil {
+		if errors.Is(err, service.ErrSnapshotNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, apperror.ToGRPCError(err, "failed to get snapshot")
+	}
+
+	// Convert to proto response
+	pbScores := make([]*pb.SnapshotCategoryScore, 0, len(snapshot.Scores))
+	for _, score := range snapshot.Scores {
+		pbScores = append(pbScores, &pb.SnapshotCategoryScore{
+			CategoryId:   int32(score.CategoryID),
+			CategoryName: score.CategoryName,
+			Score:        score.Score,
+			RatingCount:  int32(score.RatingCount),
+		})
+	}
+
+	return &pb.WeeklySnapshot{
+		SnapshotId: int32(snapshot.SnapshotID),
+		WeekLabel:  snapshot.WeekLabel,
+		CreatedAt:  snapshot.CreatedAt.Format(time.RFC3339),
+		Scores:     pbScores,
+	}, nil
+}
+
+// ListSnapshots handles the gRPC request for listing snapshots
+func (s *ScoreSnapshotsServer) ListSnapshots(ctx context.Context, req *pb.ListSnapshotsRequest) (*pb.ListSnapshotsResponse, error) {
+	dateRange, err := utils.ParseDateRange(req.StartDate, req.EndDate)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// Call service layer
+	metas, err := s.snapshotService.ListSnapshots(ctx, dateRange.Start, dateRange.End)
+	if err != nil {
+		return nil, apperror.ToGRPCError(err, "failed to list snapshots")
+	}
+
+	// Convert to proto response
+	pbSnapshots := make([]*pb.SnapshotMeta, 0, len(metas))
+	for _, meta := range metas {
+		pbSnapshots = append(pbSnapshots, &pb.SnapshotMeta{
+			SnapshotId:    int32(meta.SnapshotID),
+			WeekLabel:     meta.WeekLabel,
+			CategoryCount: int32(meta.CategoryCount),
+			CreatedAt:     meta.CreatedAt.Format(time.RFC3339),
+		})
+	}
+
+	return &pb.ListSnapshotsResponse{Snapshots: pbSnapshots}, nil
+}
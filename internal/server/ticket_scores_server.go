@@ -1,13 +1,17 @@
 package server
 
 import (
+	"context"
 	"time"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"ticket-score-service/internal/apperror"
+	"ticket-score-service/internal/models"
 	"ticket-score-service/internal/service"
+	"ticket-score-service/internal/utils"
 	pb "ticket-score-service/proto/generated/ticket_scores"
 )
 
@@ -15,72 +19,177 @@ import (
 type TicketScoresServer struct {
 	pb.UnimplementedTicketScoresServiceServer
 	ticketScoresService *service.TicketScoresService
+	ticketScoreService  *service.TicketScoreService
+	abTestService       *service.ABTestScoreService
+}
+
+// TicketScoresServerOption configures a TicketScoresServer
+type TicketScoresServerOption func(*TicketScoresServer)
+
+// WithABTestService enables GetTicketScoreABTest
+func WithABTestService(abTestService *service.ABTestScoreService) TicketScoresServerOption {
+	return func(s *TicketScoresServer) {
+		s.abTestService = abTestService
+	}
 }
 
 // NewTicketScoresServer creates a new gRPC server instance
-func NewTicketScoresServer(ticketScoresService *service.TicketScoresService) *TicketScoresServer {
-	return &TicketScoresServer{
+func NewTicketScoresServer(
+	ticketScoresService *service.TicketScoresService,
+	ticketScoreService *service.TicketScoreService,
+	opts ...TicketScoresServerOption,
+) *TicketScoresServer {
+	s := &TicketScoresServer{
 		ticketScoresService: ticketScoresService,
+		ticketScoreService:  ticketScoreService,
 	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
 }
 
 // GetTicketScores handles the gRPC streaming request for ticket scores
 func (s *TicketScoresServer) GetTicketScores(req *pb.GetTicketScoresRequest, stream grpc.ServerStreamingServer[pb.TicketScore]) error {
 	// Validate request
-	if req.StartDate == "" || req.EndDate == "" {
-		return status.Error(codes.InvalidArgument, "start_date and end_date are required")
+	dateRange, err := utils.ParseDateRange(req.StartDate, req.EndDate)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	startDate, endDate := dateRange.Start, dateRange.End
+
+	// Get ticket scores stream
+	ctx := stream.Context()
+	ticketScores, errorChan := s.ticketScoresService.GetTicketScores(ctx, startDate, endDate)
+
+	// Stream results
+	for {
+		select {
+		case ticketScore, ok := <-ticketScores:
+			if !ok {
+				// Channel closed, all tickets processed
+				return nil
+			}
+
+			// Send to client
+			if err := stream.Send(ticketScoreToProto(ticketScore)); err != nil {
+				return apperror.ToGRPCError(err, "failed to send ticket score")
+			}
+
+		case err := <-errorChan:
+			if err != nil {
+				return apperror.ToGRPCError(err, "failed to calculate ticket scores")
+			}
+
+		case <-ctx.Done():
+			return status.Error(codes.Canceled, "request canceled")
+		}
+	}
+}
+
+// GetMultipleTicketScores handles the gRPC request for scoring a list of tickets
+func (s *TicketScoresServer) GetMultipleTicketScores(ctx context.Context, req *pb.GetMultipleTicketScoresRequest) (*pb.GetMultipleTicketScoresResponse, error) {
+	// Validate request
+	if len(req.TicketIds) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "ticket_ids are required")
+	}
+	if len(req.TicketIds) > service.MaxMultipleTicketScores {
+		return nil, status.Errorf(codes.InvalidArgument, "at most %d ticket_ids are allowed", service.MaxMultipleTicketScores)
+	}
+	ticketIDs := make([]int, len(req.TicketIds))
+	for i, ticketID := range req.TicketIds {
+		if ticketID <= 0 {
+			return nil, status.Error(codes.InvalidArgument, "ticket_ids must be positive")
+		}
+		ticketIDs[i] = int(ticketID)
 	}
 
-	// Parse dates
-	startDate, err := time.Parse("2006-01-02", req.StartDate)
+	dateRange, err := utils.ParseDateRange(req.StartDate, req.EndDate)
 	if err != nil {
-		return status.Errorf(codes.InvalidArgument, "invalid start_date format, expected YYYY-MM-DD: %v", err)
+		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	endDate, err := time.Parse("2006-01-02", req.EndDate)
+	scores, err := s.ticketScoresService.GetMultipleTicketScores(ctx, ticketIDs, dateRange.Start, dateRange.End)
 	if err != nil {
-		return status.Errorf(codes.InvalidArgument, "invalid end_date format, expected YYYY-MM-DD: %v", err)
+		return nil, apperror.ToGRPCError(err, "failed to calculate ticket scores")
 	}
 
-	// Validate date range
-	if startDate.After(endDate) {
-		return status.Error(codes.InvalidArgument, "start_date must be before or equal to end_date")
+	response := &pb.GetMultipleTicketScoresResponse{
+		Scores: make([]*pb.TicketScore, 0, len(scores)),
+	}
+	for _, score := range scores {
+		response.Scores = append(response.Scores, ticketScoreToProto(score))
 	}
 
-	// Get ticket scores stream
+	return response, nil
+}
+
+// GetTicketGroupCategoryScores handles the gRPC request for scoring a group of tickets together
+func (s *TicketScoresServer) GetTicketGroupCategoryScores(ctx context.Context, req *pb.GetTicketGroupCategoryScoresRequest) (*pb.GetTicketGroupCategoryScoresResponse, error) {
+	// Validate request
+	if len(req.TicketIds) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "ticket_ids are required")
+	}
+	if len(req.TicketIds) > service.MaxTicketGroupSize {
+		return nil, status.Errorf(codes.InvalidArgument, "at most %d ticket_ids are allowed", service.MaxTicketGroupSize)
+	}
+	ticketIDs := make([]int, len(req.TicketIds))
+	for i, ticketID := range req.TicketIds {
+		if ticketID <= 0 {
+			return nil, status.Error(codes.InvalidArgument, "ticket_ids must be positive")
+		}
+		ticketIDs[i] = int(ticketID)
+	}
+
+	scores, err := s.ticketScoresService.GetCategoryScoreForTicketGroup(ctx, ticketIDs, nil)
+	if err != nil {
+		return nil, apperror.ToGRPCError(err, "failed to calculate ticket group scores")
+	}
+
+	return &pb.GetTicketGroupCategoryScoresResponse{
+		Categories: ticketCategoryScoresToProto(scores),
+	}, nil
+}
+
+// GetTicketScoresGroupedByReviewer handles the gRPC streaming request for ticket scores grouped by reviewer
+func (s *TicketScoresServer) GetTicketScoresGroupedByReviewer(req *pb.GetTicketScoresRequest, stream grpc.ServerStreamingServer[pb.ReviewerTicketScores]) error {
+	// Validate request
+	dateRange, err := utils.ParseDateRange(req.StartDate, req.EndDate)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	startDate, endDate := dateRange.Start, dateRange.End
+
+	// Get reviewer ticket scores stream
 	ctx := stream.Context()
-	ticketScores, errorChan := s.ticketScoresService.GetTicketScores(ctx, startDate, endDate)
+	reviewerScores, errorChan := s.ticketScoresService.GetTicketScoresGroupedByReviewer(ctx, startDate, endDate)
 
 	// Stream results
 	for {
 		select {
-		case ticketScore, ok := <-ticketScores:
+		case reviewerScore, ok := <-reviewerScores:
 			if !ok {
-				// Channel closed, all tickets processed
+				// Channel closed, all reviewers processed
 				return nil
 			}
 
 			// Convert to proto message
-			protoTicketScore := &pb.TicketScore{
-				TicketId:   int32(ticketScore.TicketID),
-				Categories: make([]*pb.TicketCategoryScore, len(ticketScore.Categories)),
+			protoReviewerScores := &pb.ReviewerTicketScores{
+				ReviewerId: int32(reviewerScore.ReviewerID),
+				Scores:     make([]*pb.TicketScore, len(reviewerScore.Scores)),
 			}
-
-			for i, category := range ticketScore.Categories {
-				protoTicketScore.Categories[i] = &pb.TicketCategoryScore{
-					CategoryName: category.CategoryName,
-					Score:        category.Score,
-				}
+			for i, ticketScore := range reviewerScore.Scores {
+				protoReviewerScores.Scores[i] = ticketScoreToProto(ticketScore)
 			}
 
 			// Send to client
-			if err := stream.Send(protoTicketScore); err != nil {
-				return status.Errorf(codes.Internal, "failed to send ticket score: %v", err)
+			if err := stream.Send(protoReviewerScores); err != nil {
+				return apperror.ToGRPCError(err, "failed to send reviewer ticket scores")
 			}
 
 		case err := <-errorChan:
 			if err != nil {
-				return status.Errorf(codes.Internal, "failed to calculate ticket scores: %v", err)
+				return apperror.ToGRPCError(err, "failed to calculate reviewer ticket scores")
 			}
 
 		case <-ctx.Done():
@@ -88,3 +197,423 @@ func (s *TicketScoresServer) GetTicketScores(req *pb.GetTicketScoresRequest, str
 		}
 	}
 }
+
+// ticketScoreToProto converts a ticket score to its proto message
+func ticketScoreToProto(ticketScore service.TicketScore) *pb.TicketScore {
+	return &pb.TicketScore{
+		TicketId:   int32(ticketScore.TicketID),
+		Categories: ticketCategoryScoresToProto(ticketScore.Categories),
+		Violations: ticketScore.Violations,
+	}
+}
+
+// ticketCategoryScoresToProto converts category scores to their proto messages
+func ticketCategoryScoresToProto(categories []service.TicketCategoryScore) []*pb.TicketCategoryScore {
+	protoCategories := make([]*pb.TicketCategoryScore, len(categories))
+	for i, category := range categories {
+		protoCategories[i] = &pb.TicketCategoryScore{
+			CategoryName: category.CategoryName,
+			Score:        category.Score,
+		}
+	}
+	return protoCategories
+}
+
+// SimulateTicketScore handles the gRPC request for simulating a hypothetical rating
+func (s *TicketScoresServer) SimulateTicketScore(ctx context.Context, req *pb.SimulateTicketScoreRequest) (*pb.SimulateTicketScoreResponse, error) {
+	// Validate request
+	if req.HypotheticalRating == nil {
+		return nil, status.Error(codes.InvalidArgument, "hypothetical_rating is required")
+	}
+	if len(req.Categories) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "categories are required")
+	}
+
+	existing := make([]models.Rating, len(req.ExistingRatings))
+	for i, rating := range req.ExistingRatings {
+		existing[i] = simulationRatingFromProto(rating)
+	}
+
+	current, projected, err := s.ticketScoreService.SimulateScore(existing, simulationRatingFromProto(req.HypotheticalRating), simulationCategoriesFromProto(req.Categories))
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to simulate score: %v", err)
+	}
+
+	currentScore := "N/A"
+	if len(existing) > 0 {
+		currentScore = s.ticketScoreService.FormatScore(current)
+	}
+
+	return &pb.SimulateTicketScoreResponse{
+		CurrentScore:   currentScore,
+		ProjectedScore: s.ticketScoreService.FormatScore(projected),
+	}, nil
+}
+
+// GetTicketScoreABTest handles the gRPC request for scoring ratings with both A/B test algorithms
+func (s *TicketScoresServer) GetTicketScoreABTest(ctx context.Context, req *pb.GetTicketScoreABTestRequest) (*pb.TicketScoreABTestResponse, error) {
+	if s.abTestService == nil {
+		return nil, status.Error(codes.FailedPrecondition, "A/B testing of scoring algorithms is disabled")
+	}
+
+	// Validate request
+	if len(req.Ratings) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "ratings are required")
+	}
+	if len(req.Categories) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "categories are required")
+	}
+
+	ratings := make([]models.Rating, len(req.Ratings))
+	for i, rating := range req.Ratings {
+		ratings[i] = simulationRatingFromProto(rating)
+	}
+
+	result, err := s.abTestService.CalculateBoth(ratings, simulationCategoriesFromProto(req.Categories))
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to calculate scores: %v", err)
+	}
+
+	return &pb.TicketScoreABTestResponse{
+		AlgorithmA: result.AlgorithmA,
+		AlgorithmB: result.AlgorithmB,
+		ScoreA:     result.ScoreA,
+		ScoreB:     result.ScoreB,
+	}, nil
+}
+
+// GetTicketMetrics handles the gRPC request for raw ticket score statistics
+func (s *TicketScoresServer) GetTicketMetrics(ctx context.Context, req *pb.GetTicketMetricsRequest) (*pb.TicketMetrics, error) {
+	// Validate request
+	if req.TicketId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "ticket_id must be positive")
+	}
+
+	metrics, err := s.ticketScoresService.GetTicketMetrics(ctx, int(req.TicketId), nil)
+	if err != nil {
+		return nil, apperror.ToGRPCError(err, "failed to get ticket metrics")
+	}
+
+	return &pb.TicketMetrics{
+		TicketId:       int32(metrics.TicketID),
+		TotalRatings:   int32(metrics.TotalRatings),
+		WeightedSum:    metrics.WeightedSum,
+		MaxPossibleSum: metrics.MaxPossibleSum,
+		ScoreFloat:     metrics.ScoreFloat,
+	}, nil
+}
+
+// CompareTickets handles the gRPC request for comparing two tickets
+func (s *TicketScoresServer) CompareTickets(ctx context.Context, req *pb.CompareTicketsRequest) (*pb.TicketComparison, error) {
+	// Validate request
+	if req.TicketId_1 <= 0 || req.TicketId_2 <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "ticket_id_1 and ticket_id_2 must be positive")
+	}
+
+	comparison, err := s.ticketScoresService.CompareTwoTickets(ctx, int(req.TicketId_1), int(req.TicketId_2))
+	if err != nil {
+		return nil, apperror.ToGRPCError(err, "failed to compare tickets")
+	}
+
+	pbComparisons := make([]*pb.TicketCategoryComparison, 0, len(comparison.CategoryComparisons))
+	for _, categoryComparison := range comparison.CategoryComparisons {
+		pbComparisons = append(pbComparisons, &pb.TicketCategoryComparison{
+			CategoryName: categoryComparison.CategoryName,
+			Score1:       categoryComparison.Score1,
+			Score2:       categoryComparison.Score2,
+			Difference:   categoryComparison.Difference,
+		})
+	}
+
+	return &pb.TicketComparison{
+		Ticket1:             int32(comparison.Ticket1),
+		Ticket2:             int32(comparison.Ticket2),
+		CategoryComparisons: pbComparisons,
+	}, nil
+}
+
+// GetTicketScoreAsOf handles the gRPC request for a ticket's score at a point in the past
+func (s *TicketScoresServer) GetTicketScoreAsOf(ctx context.Context, req *pb.GetTicketScoreAsOfRequest) (*pb.TicketScoreAsOf, error) {
+	// Validate request
+	if req.TicketId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "ticket_id must be positive")
+	}
+	asOfDate, err := time.Parse(utils.DateLayout, req.AsOfDate)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid as_of_date format, expected YYYY-MM-DD")
+	}
+
+	// Count every rating created on the as-of day
+	asOf := asOfDate.AddDate(0, 0, 1).Add(-time.Nanosecond)
+	score, err := s.ticketScoresService.GetScoreForTicketAtTime(ctx, int(req.TicketId), asOf)
+	if err != nil {
+		return nil, apperror.ToGRPCError(err, "failed to get ticket score as of "+req.AsOfDate)
+	}
+
+	return &pb.TicketScoreAsOf{
+		TicketId: req.TicketId,
+		AsOfDate: req.AsOfDate,
+		Score:    score,
+	}, nil
+}
+
+// GetScorecardForDateRange handles the gRPC request for a ticket's daily scorecards
+func (s *TicketScoresServer) GetScorecardForDateRange(ctx context.Context, req *pb.GetScorecardForDateRangeRequest) (*pb.ScorecardTimeSeries, error) {
+	// Validate request
+	if req.TicketId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "ticket_id must be positive")
+	}
+	dateRange, err := utils.ParseDateRange(req.StartDate, req.EndDate)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	series, err := s.ticketScoresService.GetScorecardTimeSeries(ctx, int(req.TicketId), dateRange.Start, dateRange.End)
+	if err != nil {
+		return nil, apperror.ToGRPCError(err, "failed to get ticket scorecards")
+	}
+
+	pbDays := make([]*pb.DayScorecard, len(series.Days))
+	for i, day := range series.Days {
+		pbDays[i] = &pb.DayScorecard{
+			Date:         day.Date,
+			Categories:   ticketCategoryScoresToProto(day.Categories),
+			OverallScore: day.OverallScore,
+		}
+	}
+
+	return &pb.ScorecardTimeSeries{
+		TicketId: int32(series.TicketID),
+		Days:     pbDays,
+	}, nil
+}
+
+// GetTicketRatingTimeline handles the gRPC request for a ticket's rating timeline
+func (s *TicketScoresServer) GetTicketRatingTimeline(ctx context.Context, req *pb.GetTicketRatingTimelineRequest) (*pb.RatingTimeline, error) {
+	// Validate request
+	if req.TicketId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "ticket_id must be positive")
+	}
+
+	timeline, err := s.ticketScoresService.GetTicketRatingTimeline(ctx, int(req.TicketId))
+	if err != nil {
+		return nil, apperror.ToGRPCError(err, "failed to get ticket rating timeline")
+	}
+
+	pbEvents := make([]*pb.RatingEvent, 0, len(timeline.Events))
+	for _, event := range timeline.Events {
+		pbEvents = append(pbEvents, &pb.RatingEvent{
+			Timestamp:    event.Timestamp.Format(time.RFC3339),
+			ReviewerId:   int32(event.ReviewerID),
+			CategoryName: event.CategoryName,
+			Rating:       int32(event.Rating),
+			RunningScore: event.RunningScore,
+		})
+	}
+
+	return &pb.RatingTimeline{
+		TicketId: int32(timeline.TicketID),
+		Events:   pbEvents,
+	}, nil
+}
+
+// GetTicketScoreConvergence handles the gRPC request for a ticket's score after each of its ratings
+func (s *TicketScoresServer) GetTicketScoreConvergence(ctx context.Context, req *pb.GetTicketScoreConvergenceRequest) (*pb.ScoreConvergence, error) {
+	// Validate request
+	if req.TicketId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "ticket_id must be positive")
+	}
+
+	convergence, err := s.ticketScoresService.GetScoreConvergence(ctx, int(req.TicketId))
+	if err != nil {
+		return nil, apperror.ToGRPCError(err, "failed to get ticket score convergence")
+	}
+
+	pbSnapshots := make([]*pb.ConvergencePoint, 0, len(convergence.Snapshots))
+	for _, snapshot := range convergence.Snapshots {
+		pbSnapshots = append(pbSnapshots, &pb.ConvergencePoint{
+			RatingCount:     int32(snapshot.RatingCount),
+			CumulativeScore: snapshot.CumulativeScore,
+		})
+	}
+
+	return &pb.ScoreConvergence{
+		TicketId:  int32(convergence.TicketID),
+		Snapshots: pbSnapshots,
+	}, nil
+}
+
+// GetTicketScoreBuckets handles the gRPC request for ticket counts per score bucket
+func (s *TicketScoresServer) GetTicketScoreBuckets(ctx context.Context, req *pb.GetTicketScoreBucketsRequest) (*pb.GetTicketScoreBucketsResponse, error) {
+	// Validate request
+	dateRange, err := utils.ParseDateRange(req.StartDate, req.EndDate)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	startDate, endDate := dateRange.Start, dateRange.End
+
+	buckets := service.DefaultScoreBuckets
+	if len(req.Buckets) > 0 {
+		buckets = make([]service.ScoreBucket, len(req.Buckets))
+		for i, bucket := range req.Buckets {
+			buckets[i] = service.ScoreBucket{
+				Min:   bucket.Min,
+				Max:   bucket.Max,
+				Label: bucket.Label,
+			}
+		}
+	}
+
+	if err := service.ValidateScoreBuckets(buckets); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid buckets: %v", err)
+	}
+
+	report, err := s.ticketScoresService.GetTicketCountByScoreBucket(ctx, startDate, endDate, buckets)
+	if err != nil {
+		return nil, apperror.ToGRPCError(err, "failed to count ticket scores")
+	}
+
+	response := &pb.GetTicketScoreBucketsResponse{
+		Buckets: make([]*pb.BucketResult, len(report.Buckets)),
+	}
+	for i, bucket := range report.Buckets {
+		response.Buckets[i] = &pb.BucketResult{
+			Label:      bucket.Label,
+			Count:      int32(bucket.Count),
+			Percentage: bucket.Percentage,
+		}
+	}
+
+	return response, nil
+}
+
+// GetTicketRatingStats handles the gRPC request for per-ticket rating statistics
+func (s *TicketScoresServer) GetTicketRatingStats(ctx context.Context, req *pb.GetTicketRatingStatsRequest) (*pb.GetTicketRatingStatsResponse, error) {
+	// Validate request
+	dateRange, err := utils.ParseDateRange(req.StartDate, req.EndDate)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	stats, err := s.ticketScoresService.GetTicketRatingStats(ctx, dateRange.Start, dateRange.End)
+	if err != nil {
+		return nil, apperror.ToGRPCError(err, "failed to get ticket rating stats")
+	}
+
+	response := &pb.GetTicketRatingStatsResponse{
+		Stats: make([]*pb.TicketRatingStat, len(stats)),
+	}
+	for i, stat := range stats {
+		response.Stats[i] = &pb.TicketRatingStat{
+			TicketId:      int32(stat.TicketID),
+			RatingCount:   int32(stat.RatingCount),
+			AverageRating: stat.AverageRating,
+		}
+	}
+
+	return response, nil
+}
+
+// GetTicketScoreQuantiles handles the gRPC request for the quartiles of ticket scores
+func (s *TicketScoresServer) GetTicketScoreQuantiles(ctx context.Context, req *pb.GetTicketScoreQuantilesRequest) (*pb.ScoreQuantiles, error) {
+	// Validate request
+	dateRange, err := utils.ParseDateRange(req.StartDate, req.EndDate)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	quantiles, err := s.ticketScoresService.GetTicketScoreQuantiles(ctx, dateRange.Start, dateRange.End)
+	if err != nil {
+		return nil, apperror.ToGRPCError(err, "failed to get ticket score quantiles")
+	}
+
+	return &pb.ScoreQuantiles{
+		Period:     quantiles.Period,
+		Q1:         quantiles.Q1,
+		Median:     quantiles.Median,
+		Q3:         quantiles.Q3,
+		Iqr:        quantiles.IQR,
+		SampleSize: int32(quantiles.SampleSize),
+	}, nil
+}
+
+// simulationRatingFromProto converts a proto simulation rating to a model rating
+func simulationRatingFromProto(rating *pb.SimulationRating) models.Rating {
+	return models.Rating{
+		RatingCategoryID: int(rating.RatingCategoryId),
+		Rating:           int(rating.Rating),
+	}
+}
+
+// simulationCategoriesFromProto converts proto simulation categories to model categories
+func simulationCategoriesFromProto(categories []*pb.SimulationCategory) []models.RatingCategory {
+	result := make([]models.RatingCategory, len(categories))
+	for i, category := range categories {
+		result[i] = models.RatingCategory{
+			ID:     int(category.Id),
+			Name:   category.Name,
+			Weight: category.Weight,
+		}
+	}
+	return result
+}
+
+// ExplainTicketScore handles the gRPC request for a step-by-step breakdown of a ticket's score
+func (s *TicketScoresServer) ExplainTicketScore(ctx context.Context, req *pb.ExplainTicketScoreRequest) (*pb.ScoreExplanation, error) {
+	// Validate request
+	if req.TicketId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "ticket_id must be positive")
+	}
+
+	explanation, err := s.ticketScoresService.GetScoreExplanation(ctx, int(req.TicketId))
+	if err != nil {
+		return nil, apperror.ToGRPCError(err, "failed to explain ticket score")
+	}
+
+	pbSteps := make([]*pb.ExplanationStep, 0, len(explanation.Steps))
+	for _, step := range explanation.Steps {
+		pbSteps = append(pbSteps, &pb.ExplanationStep{
+			CategoryName:    step.CategoryName,
+			Rating:          int32(step.Rating),
+			Weight:          step.Weight,
+			Contribution:    step.Contribution,
+			MaxContribution: step.MaxContribution,
+		})
+	}
+
+	return &pb.ScoreExplanation{
+		TicketId:         int32(explanation.TicketID),
+		FinalScore:       explanation.FinalScore,
+		TotalWeightedSum: explanation.TotalWeightedSum,
+		TotalMaxSum:      explanation.TotalMaxSum,
+		Steps:            pbSteps,
+	}, nil
+}
+
+// GetLatestScorecardDelta handles the gRPC request for how a ticket's latest ratings changed its category scores
+func (s *TicketScoresServer) GetLatestScorecardDelta(ctx context.Context, req *pb.GetLatestScorecardDeltaRequest) (*pb.ScorecardDelta, error) {
+	// Validate request
+	if req.TicketId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "ticket_id must be positive")
+	}
+
+	delta, err := s.ticketScoresService.GetLatestScorecardDelta(ctx, int(req.TicketId))
+	if err != nil {
+		return nil, apperror.ToGRPCError(err, "failed to get scorecard delta")
+	}
+
+	pbDeltas := make([]*pb.CategoryDelta, 0, len(delta.CategoryDeltas))
+	for _, categoryDelta := range delta.CategoryDeltas {
+		pbDeltas = append(pbDeltas, &pb.CategoryDelta{
+			CategoryName: categoryDelta.CategoryName,
+			ScoreBefore:  categoryDelta.ScoreBefore,
+			ScoreAfter:   categoryDelta.ScoreAfter,
+			Delta:        categoryDelta.Delta,
+		})
+	}
+
+	return &pb.ScorecardDelta{
+		TicketId:       int32(delta.TicketID),
+		CategoryDeltas: pbDeltas,
+	}, nil
+}
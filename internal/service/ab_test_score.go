@@ -0,0 +1,110 @@
+package service
+
+import (
+	"fmt"
+	"sync"
+
+	"ticket-score-service/internal/models"
+	"ticket-score-service/internal/utils"
+)
+
+// Names of the scoring algorithms that can be compared in an A/B test
+const (
+	AlgorithmWeighted = "weighted"
+	AlgorithmBayesian = "bayesian"
+)
+
+// MultiAlgorithmScoreResult holds the scores of the same ratings under two algorithms
+type MultiAlgorithmScoreResult struct {
+	AlgorithmA string `json:"algorithm_a"`
+	AlgorithmB string `json:"algorithm_b"`
+	ScoreA     string `json:"score_a"`
+	ScoreB     string `json:"score_b"`
+}
+
+// ABTestScoreService scores ratings with two algorithms side by side
+type ABTestScoreService struct {
+	algorithmA     string
+	algorithmB     string
+	calculatorA    ScoreCalculator
+	calculatorB    ScoreCalculator
+	scorePrecision int
+}
+
+// ABTestScoreOption configures an ABTestScoreService
+type ABTestScoreOption func(*ABTestScoreService)
+
+// WithABTestScorePrecision sets the number of decimal places in formatted scores. Defaults to 0.
+func WithABTestScorePrecision(decimals int) ABTestScoreOption {
+	return func(s *ABTestScoreService) {
+		s.scorePrecision = decimals
+	}
+}
+
+// NewABTestScoreService creates a new A/B test score service comparing two algorithms by name.
+// Both algorithms score ratings from 0 to maxRating.
+func NewABTestScoreService(algorithmA, algorithmB string, maxRating int, opts ...ABTestScoreOption) (*ABTestScoreService, error) {
+	calculatorA, err := newScoreCalculator(algorithmA, maxRating)
+	if err != nil {
+		return nil, err
+	}
+	calculatorB, err := newScoreCalculator(algorithmB, maxRating)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &ABTestScoreService{
+		algorithmA:  algorithmA,
+		algorithmB:  algorithmB,
+		calculatorA: calculatorA,
+		calculatorB: calculatorB,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s, nil
+}
+
+// newScoreCalculator creates the score calculator of a named algorithm
+func newScoreCalculator(algorithm string, maxRating int) (ScoreCalculator, error) {
+	switch algorithm {
+	case AlgorithmWeighted:
+		return NewTicketScoreService(WithMaxRating(maxRating)), nil
+	case AlgorithmBayesian:
+		return NewBayesianTicketScoreService(DefaultBayesianPriorRating, DefaultBayesianPriorWeight, maxRating), nil
+	default:
+		return nil, fmt.Errorf("unknown scoring algorithm %q", algorithm)
+	}
+}
+
+// CalculateBoth scores the ratings with both algorithms concurrently
+func (s *ABTestScoreService) CalculateBoth(ratings []models.Rating, categories []models.RatingCategory) (*MultiAlgorithmScoreResult, error) {
+	var wg sync.WaitGroup
+	var scoreA, scoreB float64
+	var errA, errB error
+
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		scoreA, errA = s.calculatorA.CalculateScore(ratings, categories)
+	}()
+	go func() {
+		defer wg.Done()
+		scoreB, errB = s.calculatorB.CalculateScore(ratings, categories)
+	}()
+	wg.Wait()
+
+	if errA != nil {
+		return nil, fmt.Errorf("failed to calculate %s score: %w", s.algorithmA, errA)
+	}
+	if errB != nil {
+		return nil, fmt.Errorf("failed to calculate %s score: %w", s.algorithmB, errB)
+	}
+
+	return &MultiAlgorithmScoreResult{
+		AlgorithmA: s.algorithmA,
+		AlgorithmB: s.algorithmB,
+		ScoreA:     utils.FormatScoreWithPrecision(scoreA, s.scorePrecision),
+		ScoreB:     utils.FormatScoreWithPrecision(scoreB, s.scorePrecision),
+	}, nil
+}
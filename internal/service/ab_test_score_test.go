@@ -0,0 +1,163 @@
+package service
+
+import (
+	"math"
+	"testing"
+
+	"ticket-score-service/internal/models"
+)
+
+func TestBayesianTicketScoreService_CalculateScore(t *testing.T) {
+	categories := []models.RatingCategory{
+		{ID: 1, Weight: 1},
+		{ID: 2, Weight: 3},
+	}
+
+	tests := []struct {
+		name        string
+		ratings     []models.Rating
+		maxRating   int
+		expected    float64
+		expectError bool
+	}{
+		{
+			name:     "single perfect rating is pulled towards the prior",
+			ratings:  []models.Rating{{RatingCategoryID: 1, Rating: 5}},
+			expected: 220.0 / 3, // (2*3 + 5*1) / (2*5 + 1*5) * 100
+		},
+		{
+			name: "weighted ratings",
+			ratings: []models.Rating{
+				{RatingCategoryID: 1, Rating: 4},
+				{RatingCategoryID: 2, Rating: 2},
+			},
+			expected: 160.0 / 3, // (2*3 + 4*1 + 2*3) / (2*5 + 1*5 + 3*5) * 100
+		},
+		{
+			name:        "no ratings",
+			ratings:     nil,
+			expectError: true,
+		},
+		{
+			name:        "unknown category",
+			ratings:     []models.Rating{{RatingCategoryID: 9, Rating: 5}},
+			expectError: true,
+		},
+		{
+			name:        "rating out of range",
+			ratings:     []models.Rating{{RatingCategoryID: 1, Rating: 6}},
+			expectError: true,
+		},
+		{
+			name:      "ten-point scale",
+			ratings:   []models.Rating{{RatingCategoryID: 1, Rating: 9}},
+			maxRating: 10,
+			expected:  50, // (2*3 + 9*1) / (2*10 + 1*10) * 100
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			maxRating := defaultMaxRating
+			if tt.maxRating > 0 {
+				maxRating = tt.maxRating
+			}
+			service := NewBayesianTicketScoreService(DefaultBayesianPriorRating, DefaultBayesianPriorWeight, maxRating)
+			score, err := service.CalculateScore(tt.ratings, categories)
+			if tt.expectError {
+				if err == nil {
+					t.Error("Expected error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if math.Abs(score-tt.expected) > 1e-9 {
+				t.Errorf("Expected score %f, got %f", tt.expected, score)
+			}
+		})
+	}
+}
+
+func TestABTestScoreService_CalculateBoth(t *testing.T) {
+	categories := []models.RatingCategory{
+		{ID: 1, Weight: 1},
+		{ID: 2, Weight: 1},
+	}
+	ratings := []models.Rating{
+		{RatingCategoryID: 1, Rating: 5},
+		{RatingCategoryID: 2, Rating: 5},
+	}
+
+	tests := []struct {
+		name       string
+		algorithmA string
+		algorithmB string
+		expectedA  string
+		expectedB  string
+	}{
+		{
+			name:       "weighted against bayesian",
+			algorithmA: AlgorithmWeighted,
+			algorithmB: AlgorithmBayesian,
+			expectedA:  "100%",
+			expectedB:  "80%", // (2*3 + 5 + 5) / (2*5 + 5 + 5) * 100
+		},
+		{
+			name:       "equal weighted algorithms",
+			algorithmA: AlgorithmWeighted,
+			algorithmB: AlgorithmWeighted,
+			expectedA:  "100%",
+			expectedB:  "100%",
+		},
+		{
+			name:       "equal bayesian algorithms",
+			algorithmA: AlgorithmBayesian,
+			algorithmB: AlgorithmBayesian,
+			expectedA:  "80%",
+			expectedB:  "80%",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, err := NewABTestScoreService(tt.algorithmA, tt.algorithmB, defaultMaxRating)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			result, err := service.CalculateBoth(ratings, categories)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			expected := MultiAlgorithmScoreResult{
+				AlgorithmA: tt.algorithmA,
+				AlgorithmB: tt.algorithmB,
+				ScoreA:     tt.expectedA,
+				ScoreB:     tt.expectedB,
+			}
+			if *result != expected {
+				t.Errorf("Expected %+v, got %+v", expected, *result)
+			}
+		})
+	}
+
+	t.Run("unknown algorithm", func(t *testing.T) {
+		if _, err := NewABTestScoreService(AlgorithmWeighted, "median", defaultMaxRating); err == nil {
+			t.Error("Expected error but got none")
+		}
+	})
+
+	t.Run("invalid ratings", func(t *testing.T) {
+		service, err := NewABTestScoreService(AlgorithmWeighted, AlgorithmBayesian, defaultMaxRating)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		if _, err := service.CalculateBoth([]models.Rating{{RatingCategoryID: 9, Rating: 5}}, categories); err == nil {
+			t.Error("Expected error but got none")
+		}
+	})
+}
@@ -0,0 +1,68 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// HourActivity is the rating activity within one hour of the day (UTC)
+type HourActivity struct {
+	Hour         int     `json:"hour"`
+	RatingCount  int     `json:"rating_count"`
+	AverageScore float64 `json:"average_score"`
+}
+
+// PeakHourReport holds the rating activity of every hour of the day, ordered from 0 to 23
+type PeakHourReport struct {
+	Hours []HourActivity `json:"hours"`
+}
+
+// ActivityAnalyticsService handles analytics about when ratings are made
+type ActivityAnalyticsService struct {
+	ratingsRepo RatingsRepository
+	maxRating   int
+}
+
+// NewActivityAnalyticsService creates a new activity analytics service instance that scores
+// ratings out of maxRating
+func NewActivityAnalyticsService(ratingsRepo RatingsRepository, maxRating int) *ActivityAnalyticsService {
+	return &ActivityAnalyticsService{
+		ratingsRepo: ratingsRepo,
+		maxRating:   maxRating,
+	}
+}
+
+// GetPeakHourAnalysis gets the number of ratings and their average score (0-100) for each
+// hour of the day over a date range. All 24 hours are returned; hours without ratings score 0.
+func (s *ActivityAnalyticsService) GetPeakHourAnalysis(ctx context.Context, startDate, endDate time.Time) (*PeakHourReport, error) {
+	hourlyRatings, err := s.ratingsRepo.GetRatingsByHourOfDay(ctx, startDate, endDate)
+	if err != nil {
+		return nil, logError(ctx, fmt.Errorf("failed to get ratings by hour: %w", err))
+	}
+
+	report := &PeakHourReport{Hours: make([]HourActivity, 24)}
+	for hour := range report.Hours {
+		report.Hours[hour].Hour = hour
+	}
+	for _, hourly := range hourlyRatings {
+		if hourly.Hour < 0 || hourly.Hour > 23 {
+			continue
+		}
+		report.Hours[hourly.Hour].RatingCount = hourly.Count
+		report.Hours[hourly.Hour].AverageScore = ratingToPercent(hourly.AverageRating, s.maxRating)
+	}
+
+	return report, nil
+}
+
+// GetWeekdayVolume gets the number of ratings made on each day of the week (UTC) over a date range,
+// indexed from 0 for Sunday
+func (s *ActivityAnalyticsService) GetWeekdayVolume(ctx context.Context, startDate, endDate time.Time) ([7]int, error) {
+	counts, err := s.ratingsRepo.GetRatingCountByDayOfWeek(ctx, startDate, endDate)
+	if err != nil {
+		return counts, logError(ctx, fmt.Errorf("failed to get rating counts by day of week: %w", err))
+	}
+
+	return counts, nil
+}
@@ -0,0 +1,104 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"ticket-score-service/internal/mocks"
+	"ticket-score-service/internal/models"
+)
+
+func TestGetPeakHourAnalysis(t *testing.T) {
+	startDate := time.Date(2019, 10, 1, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2019, 10, 2, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name          string
+		ratings       []models.Rating
+		expectedCount map[int]int
+		expectedScore map[int]float64
+	}{
+		{
+			name: "ratings spread over hours",
+			ratings: []models.Rating{
+				{ID: 1, Rating: 5, CreatedAt: startDate.Add(9*time.Hour + 5*time.Minute)},
+				{ID: 2, Rating: 3, CreatedAt: startDate.Add(9*time.Hour + 55*time.Minute)},
+				{ID: 3, Rating: 4, CreatedAt: startDate.Add(33 * time.Hour)}, // 09:00 on the second day
+				{ID: 4, Rating: 2, CreatedAt: startDate.Add(23*time.Hour + 59*time.Minute)},
+				{ID: 5, Rating: 5, CreatedAt: startDate},
+				{ID: 6, Rating: 1, CreatedAt: startDate.Add(-time.Hour)}, // before range
+			},
+			expectedCount: map[int]int{0: 1, 9: 3, 23: 1},
+			expectedScore: map[int]float64{0: 100, 9: 80, 23: 40},
+		},
+		{
+			name:          "no ratings",
+			expectedCount: map[int]int{},
+			expectedScore: map[int]float64{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ratingsRepo := &mocks.MockRatingsRepo{Ratings: map[string][]models.Rating{"all": tt.ratings}}
+			service := NewActivityAnalyticsService(ratingsRepo, defaultMaxRating)
+
+			report, err := service.GetPeakHourAnalysis(context.Background(), startDate, endDate)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if len(report.Hours) != 24 {
+				t.Fatalf("Expected 24 hours, got %d", len(report.Hours))
+			}
+
+			total := 0
+			for hour, activity := range report.Hours {
+				if activity.Hour != hour {
+					t.Errorf("Expected hour %d at index %d, got %d", hour, hour, activity.Hour)
+				}
+				if activity.RatingCount != tt.expectedCount[hour] {
+					t.Errorf("Hour %d: expected %d ratings, got %d", hour, tt.expectedCount[hour], activity.RatingCount)
+				}
+				if math.Abs(activity.AverageScore-tt.expectedScore[hour]) > 0.01 {
+					t.Errorf("Hour %d: expected average score %.2f, got %.2f", hour, tt.expectedScore[hour], activity.AverageScore)
+				}
+				total += activity.RatingCount
+			}
+
+			expectedTotal := 0
+			for _, count := range tt.expectedCount {
+				expectedTotal += count
+			}
+			if total != expectedTotal {
+				t.Errorf("Expected counts to sum to %d ratings, got %d", expectedTotal, total)
+			}
+		})
+	}
+
+	t.Run("ten-point scale", func(t *testing.T) {
+		ratingsRepo := &mocks.MockRatingsRepo{Ratings: map[string][]models.Rating{"all": {
+			{ID: 1, Rating: 8, CreatedAt: startDate.Add(9 * time.Hour)},
+		}}}
+		service := NewActivityAnalyticsService(ratingsRepo, 10)
+
+		report, err := service.GetPeakHourAnalysis(context.Background(), startDate, endDate)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if math.Abs(report.Hours[9].AverageScore-80) > 0.01 {
+			t.Errorf("Expected average score 80.00, got %.2f", report.Hours[9].AverageScore)
+		}
+	})
+
+	t.Run("repository error", func(t *testing.T) {
+		service := NewActivityAnalyticsService(&mocks.MockRatingsRepo{Err: errors.New("database error")}, defaultMaxRating)
+
+		if _, err := service.GetPeakHourAnalysis(context.Background(), startDate, endDate); err == nil {
+			t.Error("Expected error but got none")
+		}
+	})
+}
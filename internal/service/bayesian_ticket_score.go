@@ -0,0 +1,89 @@
+package service
+
+import (
+	"fmt"
+
+	"ticket-score-service/internal/models"
+)
+
+// Default prior of the Bayesian score: two ratings of 3 at weight 1
+const (
+	DefaultBayesianPriorRating = 3.0
+	DefaultBayesianPriorWeight = 2.0
+)
+
+// BayesianTicketScoreService scores tickets like TicketScoreService, but pulls scores backed by
+// few ratings towards a prior rating. Each score behaves as if the prior rating had also been
+// given at a total weight of priorWeight, so the prior matters less as ratings accumulate.
+type BayesianTicketScoreService struct {
+	priorRating float64
+	priorWeight float64
+	maxRating   int
+}
+
+// NewBayesianTicketScoreService creates a new Bayesian ticket score service instance
+func NewBayesianTicketScoreService(priorRating, priorWeight float64, maxRating int) *BayesianTicketScoreService {
+	return &BayesianTicketScoreService{
+		priorRating: priorRating,
+		priorWeight: priorWeight,
+		maxRating:   maxRating,
+	}
+}
+
+// MaxRating returns the highest rating value, which scores 100%
+func (s *BayesianTicketScoreService) MaxRating() int {
+	return s.maxRating
+}
+
+// CalculateScore calculates the Bayesian weighted score percentage for the given ratings
+func (s *BayesianTicketScoreService) CalculateScore(ratings []models.Rating,
+	categories []models.RatingCategory) (float64, error) {
+	result, err := s.CalculateScoreResult(ratings, categories)
+	if err != nil {
+		return 0, err
+	}
+	return result.Score, nil
+}
+
+// CalculateScoreResult calculates the Bayesian score. The sums include the prior:
+// (prior weight × prior rating + Σ rating × weight) / (prior weight × max + Σ weight × max) × 100
+func (s *BayesianTicketScoreService) CalculateScoreResult(ratings []models.Rating,
+	categories []models.RatingCategory) (*ScoreResult, error) {
+	if len(ratings) == 0 {
+		return nil, fmt.Errorf("no ratings provided")
+	}
+
+	categoryWeights := make(map[int]float64)
+	for _, category := range categories {
+		categoryWeights[category.ID] = category.Weight
+	}
+
+	weightedSum := s.priorWeight * s.priorRating
+	maxSum := s.priorWeight * float64(s.maxRating)
+
+	for _, rating := range ratings {
+		weight, exists := categoryWeights[rating.RatingCategoryID]
+		if !exists {
+			return nil, fmt.Errorf("rating category %d not found",
+				rating.RatingCategoryID)
+		}
+
+		if rating.Rating < 0 || rating.Rating > s.maxRating {
+			return nil, fmt.Errorf("rating value %d is out of range (0-%d)",
+				rating.Rating, s.maxRating)
+		}
+
+		weightedSum += float64(rating.Rating) * weight
+		maxSum += weight * float64(s.maxRating)
+	}
+
+	if maxSum == 0 {
+		return nil, fmt.Errorf("total possible score is zero")
+	}
+
+	return &ScoreResult{
+		Score:       (weightedSum / maxSum) * 100,
+		WeightedSum: weightedSum,
+		MaxSum:      maxSum,
+	}, nil
+}
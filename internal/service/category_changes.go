@@ -0,0 +1,79 @@
+package service
+
+import (
+	"context"
+	"math"
+	"sort"
+	"time"
+)
+
+// CategoryChange is how much a category's score changed between two periods
+type CategoryChange struct {
+	CategoryName      string  `json:"categoryName"`
+	CurrentScore      string  `json:"currentScore"`
+	PreviousScore     string  `json:"previousScore"`
+	Change            string  `json:"change"`
+	AbsoluteChangePct float64 `json:"absoluteChangePct"`
+}
+
+// GetTopImprovingCategories gets up to limit categories whose score rose the most from the previous
+// period to the current one, biggest rise first
+func (s *RatingAnalyticsService) GetTopImprovingCategories(ctx context.Context, currentStart, currentEnd, previousStart, previousEnd time.Time, limit int) ([]CategoryChange, error) {
+	return s.getTopCategoryChanges(ctx, currentStart, currentEnd, previousStart, previousEnd, limit, true)
+}
+
+// GetTopDecliningCategories gets up to limit categories whose score fell the most from the previous
+// period to the current one, biggest fall first
+func (s *RatingAnalyticsService) GetTopDecliningCategories(ctx context.Context, currentStart, currentEnd, previousStart, previousEnd time.Time, limit int) ([]CategoryChange, error) {
+	return s.getTopCategoryChanges(ctx, currentStart, currentEnd, previousStart, previousEnd, limit, false)
+}
+
+// getTopCategoryChanges gets up to limit categories whose score rose (or fell) between the periods,
+// largest change first. Categories without ratings in either period are left out.
+func (s *RatingAnalyticsService) getTopCategoryChanges(ctx context.Context, currentStart, currentEnd, previousStart, previousEnd time.Time, limit int, improving bool) ([]CategoryChange, error) {
+	categories, err := s.categoryRepo.GetAll(ctx)
+	if err != nil {
+		return nil, logError(ctx, err)
+	}
+
+	currentScores, err := s.scoreCategories(ctx, categories, currentStart, currentEnd)
+	if err != nil {
+		return nil, err
+	}
+	previousScores, err := s.scoreCategories(ctx, categories, previousStart, previousEnd)
+	if err != nil {
+		return nil, err
+	}
+
+	var changes []CategoryChange
+	for _, category := range categories {
+		current, previous := currentScores[category.ID], previousScores[category.ID]
+		if !current.rated || !previous.rated {
+			continue
+		}
+
+		difference := current.score - previous.score
+		if (improving && difference <= 0) || (!improving && difference >= 0) {
+			continue
+		}
+
+		currentScore, previousScore := s.formatScore(current.score), s.formatScore(previous.score)
+		changes = append(changes, CategoryChange{
+			CategoryName:      category.Name,
+			CurrentScore:      currentScore,
+			PreviousScore:     previousScore,
+			Change:            s.formatScoreDifference(currentScore, previousScore),
+			AbsoluteChangePct: math.Abs(difference),
+		})
+	}
+
+	sort.SliceStable(changes, func(i, j int) bool {
+		return changes[i].AbsoluteChangePct > changes[j].AbsoluteChangePct
+	})
+
+	if len(changes) > limit {
+		changes = changes[:limit]
+	}
+
+	return changes, nil
+}
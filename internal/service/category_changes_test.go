@@ -0,0 +1,91 @@
+package service
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"ticket-score-service/internal/mocks"
+	"ticket-score-service/internal/models"
+)
+
+func TestGetTopCategoryChanges(t *testing.T) {
+	previousStart := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	previousEnd := time.Date(2024, 1, 7, 0, 0, 0, 0, time.UTC)
+	currentStart := time.Date(2024, 1, 8, 0, 0, 0, 0, time.UTC)
+	currentEnd := time.Date(2024, 1, 14, 0, 0, 0, 0, time.UTC)
+	previous, current := previousStart.Add(time.Hour), currentStart.Add(time.Hour)
+
+	categories := []models.RatingCategory{
+		{ID: 1, Name: "Spelling", Weight: 1},
+		{ID: 2, Name: "Grammar", Weight: 1},
+		{ID: 3, Name: "GDPR", Weight: 1},
+		{ID: 4, Name: "Randomness", Weight: 1},
+	}
+	ratings := map[string][]models.Rating{
+		"all": {
+			{ID: 1, RatingCategoryID: 1, Rating: 3, CreatedAt: previous}, // Spelling 60% -> 80%
+			{ID: 2, RatingCategoryID: 1, Rating: 4, CreatedAt: current},
+			{ID: 3, RatingCategoryID: 2, Rating: 1, CreatedAt: previous}, // Grammar 20% -> 100%
+			{ID: 4, RatingCategoryID: 2, Rating: 5, CreatedAt: current},
+			{ID: 5, RatingCategoryID: 3, Rating: 5, CreatedAt: previous}, // GDPR 100% -> 40%
+			{ID: 6, RatingCategoryID: 3, Rating: 2, CreatedAt: current},
+			{ID: 7, RatingCategoryID: 4, Rating: 4, CreatedAt: previous}, // Randomness 80% -> 60%
+			{ID: 8, RatingCategoryID: 4, Rating: 3, CreatedAt: current},
+		},
+	}
+
+	service := NewRatingAnalyticsService(&mockCategoryRepo{categories: categories}, &mocks.MockRatingsRepo{Ratings: ratings}, NewTicketScoreService())
+
+	tests := []struct {
+		name      string
+		improving bool
+		limit     int
+		expected  []CategoryChange
+	}{
+		{
+			name:      "top 2 improvers",
+			improving: true,
+			limit:     2,
+			expected: []CategoryChange{
+				{CategoryName: "Grammar", CurrentScore: "100%", PreviousScore: "20%", Change: "+80%", AbsoluteChangePct: 80},
+				{CategoryName: "Spelling", CurrentScore: "80%", PreviousScore: "60%", Change: "+20%", AbsoluteChangePct: 20},
+			},
+		},
+		{
+			name:      "top 2 decliners",
+			improving: false,
+			limit:     2,
+			expected: []CategoryChange{
+				{CategoryName: "GDPR", CurrentScore: "40%", PreviousScore: "100%", Change: "-60%", AbsoluteChangePct: 60},
+				{CategoryName: "Randomness", CurrentScore: "60%", PreviousScore: "80%", Change: "-20%", AbsoluteChangePct: 20},
+			},
+		},
+		{
+			name:      "limit below the number of changes",
+			improving: false,
+			limit:     1,
+			expected: []CategoryChange{
+				{CategoryName: "GDPR", CurrentScore: "40%", PreviousScore: "100%", Change: "-60%", AbsoluteChangePct: 60},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			get := service.GetTopDecliningCategories
+			if tt.improving {
+				get = service.GetTopImprovingCategories
+			}
+
+			changes, err := get(context.Background(), currentStart, currentEnd, previousStart, previousEnd, tt.limit)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(changes, tt.expected) {
+				t.Errorf("Expected %+v, got %+v", tt.expected, changes)
+			}
+		})
+	}
+}
@@ -0,0 +1,52 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// CategoryCoverage describes how many of the tickets rated in a period were rated in one category
+type CategoryCoverage struct {
+	CategoryName string `json:"categoryName"`
+	RatedTickets int    `json:"ratedTickets"`
+	TotalTickets int    `json:"totalTickets"`
+	CoveragePct  string `json:"coveragePct"`
+}
+
+// GetCategoryCoverage calculates, for each category in category order, the percentage of the tickets
+// rated in a date range that have at least one rating in that category. Without tickets the
+// coverage is "N/A".
+func (s *RatingAnalyticsService) GetCategoryCoverage(ctx context.Context, startDate, endDate time.Time) ([]CategoryCoverage, error) {
+	categories, err := s.categoryRepo.GetAll(ctx)
+	if err != nil {
+		return nil, logError(ctx, err)
+	}
+
+	ticketIDs, err := s.ratingsRepo.GetDistinctTicketIDsByDateRange(ctx, startDate, endDate)
+	if err != nil {
+		return nil, logError(ctx, fmt.Errorf("failed to get ticket IDs: %w", err))
+	}
+	totalTickets := len(ticketIDs)
+
+	coverage := make([]CategoryCoverage, 0, len(categories))
+	for _, category := range categories {
+		ratedTickets, err := s.ratingsRepo.GetDistinctTicketCountByCategoryIDAndDateRange(ctx, category.ID, startDate, endDate)
+		if err != nil {
+			return nil, logError(ctx, fmt.Errorf("failed to count rated tickets for category %s: %w", category.Name, err))
+		}
+
+		categoryCoverage := CategoryCoverage{
+			CategoryName: category.Name,
+			RatedTickets: ratedTickets,
+			TotalTickets: totalTickets,
+			CoveragePct:  "N/A",
+		}
+		if totalTickets > 0 {
+			categoryCoverage.CoveragePct = s.formatScore(float64(ratedTickets) / float64(totalTickets) * 100)
+		}
+		coverage = append(coverage, categoryCoverage)
+	}
+
+	return coverage, nil
+}
@@ -0,0 +1,97 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"ticket-score-service/internal/mocks"
+	"ticket-score-service/internal/models"
+)
+
+func TestGetCategoryCoverage(t *testing.T) {
+	startDate := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2024, 1, 7, 0, 0, 0, 0, time.UTC)
+	createdAt := startDate.Add(time.Hour)
+	categories := []models.RatingCategory{
+		{ID: 1, Name: "Spelling", Weight: 1},
+		{ID: 2, Name: "Grammar", Weight: 1},
+	}
+
+	tests := []struct {
+		name     string
+		ratings  map[string][]models.Rating
+		expected []CategoryCoverage
+	}{
+		{
+			name: "every ticket rated in every category",
+			ratings: map[string][]models.Rating{
+				"1-2024-01-01": {
+					{ID: 1, TicketID: 1, RatingCategoryID: 1, Rating: 4, CreatedAt: createdAt},
+					{ID: 2, TicketID: 2, RatingCategoryID: 1, Rating: 3, CreatedAt: createdAt},
+					{ID: 3, TicketID: 2, RatingCategoryID: 1, Rating: 5, CreatedAt: createdAt},
+				},
+				"2-2024-01-01": {
+					{ID: 4, TicketID: 1, RatingCategoryID: 2, Rating: 2, CreatedAt: createdAt},
+					{ID: 5, TicketID: 2, RatingCategoryID: 2, Rating: 1, CreatedAt: createdAt},
+				},
+			},
+			expected: []CategoryCoverage{
+				{CategoryName: "Spelling", RatedTickets: 2, TotalTickets: 2, CoveragePct: "100%"},
+				{CategoryName: "Grammar", RatedTickets: 2, TotalTickets: 2, CoveragePct: "100%"},
+			},
+		},
+		{
+			name: "some tickets missing a category",
+			ratings: map[string][]models.Rating{
+				"1-2024-01-01": {
+					{ID: 1, TicketID: 1, RatingCategoryID: 1, Rating: 4, CreatedAt: createdAt},
+					{ID: 2, TicketID: 2, RatingCategoryID: 1, Rating: 3, CreatedAt: createdAt},
+					{ID: 3, TicketID: 3, RatingCategoryID: 1, Rating: 5, CreatedAt: createdAt},
+					{ID: 4, TicketID: 4, RatingCategoryID: 1, Rating: 5, CreatedAt: createdAt},
+				},
+				"2-2024-01-01": {
+					{ID: 5, TicketID: 1, RatingCategoryID: 2, Rating: 2, CreatedAt: createdAt},
+				},
+				"2-2024-01-09": {
+					{ID: 6, TicketID: 2, RatingCategoryID: 2, Rating: 2, CreatedAt: endDate.AddDate(0, 0, 2)}, // after range
+				},
+			},
+			expected: []CategoryCoverage{
+				{CategoryName: "Spelling", RatedTickets: 4, TotalTickets: 4, CoveragePct: "100%"},
+				{CategoryName: "Grammar", RatedTickets: 1, TotalTickets: 4, CoveragePct: "25%"},
+			},
+		},
+		{
+			name: "no tickets",
+			expected: []CategoryCoverage{
+				{CategoryName: "Spelling", CoveragePct: "N/A"},
+				{CategoryName: "Grammar", CoveragePct: "N/A"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := NewRatingAnalyticsService(&mockCategoryRepo{categories: categories}, &mocks.MockRatingsRepo{Ratings: tt.ratings}, NewTicketScoreService())
+
+			coverage, err := service.GetCategoryCoverage(context.Background(), startDate, endDate)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(coverage, tt.expected) {
+				t.Errorf("Expected %+v, got %+v", tt.expected, coverage)
+			}
+		})
+	}
+
+	t.Run("repository error", func(t *testing.T) {
+		service := NewRatingAnalyticsService(&mockCategoryRepo{categories: categories}, &mocks.MockRatingsRepo{CountErr: errors.New("database error")}, NewTicketScoreService())
+
+		if _, err := service.GetCategoryCoverage(context.Background(), startDate, endDate); err == nil {
+			t.Error("Expected error but got none")
+		}
+	})
+}
@@ -0,0 +1,106 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"ticket-score-service/internal/models"
+)
+
+// CategoryRank positions a category's score for a period among the scores of every category
+type CategoryRank struct {
+	CategoryID      int     `json:"categoryId"`
+	CategoryName    string  `json:"categoryName"`
+	Score           string  `json:"score"`
+	Rank            int     `json:"rank"`
+	TotalCategories int     `json:"totalCategories"`
+	Percentile      float64 `json:"percentile"`
+}
+
+// rankedScore is a category's score for a period; rated is false if it has no ratings
+type rankedScore struct {
+	score float64
+	rated bool
+}
+
+// beats reports whether a ranks strictly above b. Categories without ratings rank below every
+// category with ratings.
+func (a rankedScore) beats(b rankedScore) bool {
+	if !a.rated {
+		return false
+	}
+	return !b.rated || a.score > b.score
+}
+
+// GetCategoryRank ranks a category's score from startDate to endDate against every category's
+// score, highest first. Categories with equal scores share a rank. Percentile is the percentage
+// of categories scoring at or below the category, so the top category is at 100.
+func (s *RatingAnalyticsService) GetCategoryRank(ctx context.Context, categoryID int, startDate, endDate time.Time) (*CategoryRank, error) {
+	categories, err := s.categoryRepo.GetAll(ctx)
+	if err != nil {
+		return nil, logError(ctx, err)
+	}
+
+	var target *models.RatingCategory
+	for i, category := range categories {
+		if category.ID == categoryID {
+			target = &categories[i]
+		}
+	}
+	if target == nil {
+		return nil, fmt.Errorf("%w: %d", ErrCategoryNotFound, categoryID)
+	}
+
+	scores, err := s.scoreCategories(ctx, categories, startDate, endDate)
+	if err != nil {
+		return nil, err
+	}
+
+	targetScore := scores[categoryID]
+	rank, atOrBelow := 1, 0
+	for _, score := range scores {
+		if score.beats(targetScore) {
+			rank++
+		} else {
+			atOrBelow++
+		}
+	}
+
+	result := &CategoryRank{
+		CategoryID:      target.ID,
+		CategoryName:    target.Name,
+		Score:           "N/A",
+		Rank:            rank,
+		TotalCategories: len(categories),
+		Percentile:      float64(atOrBelow) / float64(len(categories)) * 100,
+	}
+	if targetScore.rated {
+		result.Score = s.formatScore(targetScore.score)
+	}
+
+	return result, nil
+}
+
+// scoreCategories calculates each category's score from startDate to endDate, keyed by category ID
+func (s *RatingAnalyticsService) scoreCategories(ctx context.Context, categories []models.RatingCategory, startDate, endDate time.Time) (map[int]rankedScore, error) {
+	scores := make(map[int]rankedScore, len(categories))
+	for _, category := range categories {
+		ratings, err := s.ratingsRepo.GetByCategoryIDAndDateRange(ctx, category.ID, startDate, endDate)
+		if err != nil {
+			return nil, logError(ctx, fmt.Errorf("failed to get ratings for category %s: %w", category.Name, err))
+		}
+		if len(ratings) == 0 {
+			scores[category.ID] = rankedScore{}
+			continue
+		}
+
+		score, err := s.ticketScoreServ.CalculateScore(ratings, []models.RatingCategory{category})
+		if err != nil {
+			return nil, fmt.Errorf("failed to calculate score for category %s: %w", category.Name, err)
+		}
+		scores[category.ID] = rankedScore{score: score, rated: true}
+	}
+
+	return scores, nil
+}
@@ -0,0 +1,88 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"ticket-score-service/internal/mocks"
+	"ticket-score-service/internal/models"
+)
+
+func TestGetCategoryRank(t *testing.T) {
+	startDate := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2024, 1, 7, 0, 0, 0, 0, time.UTC)
+	day := startDate.Add(time.Hour)
+
+	categories := []models.RatingCategory{
+		{ID: 1, Name: "Spelling", Weight: 1},
+		{ID: 2, Name: "Grammar", Weight: 1},
+		{ID: 3, Name: "GDPR", Weight: 1},
+	}
+	ratings := map[string][]models.Rating{
+		"1-2024-01-01": {{ID: 1, RatingCategoryID: 1, Rating: 4, CreatedAt: day}}, // 80%
+		"2-2024-01-01": {{ID: 2, RatingCategoryID: 2, Rating: 5, CreatedAt: day}}, // 100%
+		"3-2024-01-01": {{ID: 3, RatingCategoryID: 3, Rating: 2, CreatedAt: day}}, // 40%
+	}
+
+	service := NewRatingAnalyticsService(&mockCategoryRepo{categories: categories}, &mocks.MockRatingsRepo{Ratings: ratings}, NewTicketScoreService())
+
+	tests := []struct {
+		name               string
+		categoryID         int
+		expectedName       string
+		expectedScore      string
+		expectedRank       int
+		expectedPercentile float64
+	}{
+		{name: "highest score", categoryID: 2, expectedName: "Grammar", expectedScore: "100%", expectedRank: 1, expectedPercentile: 100},
+		{name: "middle score", categoryID: 1, expectedName: "Spelling", expectedScore: "80%", expectedRank: 2, expectedPercentile: 66.67},
+		{name: "lowest score", categoryID: 3, expectedName: "GDPR", expectedScore: "40%", expectedRank: 3, expectedPercentile: 33.33},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rank, err := service.GetCategoryRank(context.Background(), tt.categoryID, startDate, endDate)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if rank.CategoryID != tt.categoryID || rank.CategoryName != tt.expectedName {
+				t.Errorf("Expected category %d %s, got %d %s", tt.categoryID, tt.expectedName, rank.CategoryID, rank.CategoryName)
+			}
+			if rank.Score != tt.expectedScore {
+				t.Errorf("Expected score %s, got %s", tt.expectedScore, rank.Score)
+			}
+			if rank.Rank != tt.expectedRank {
+				t.Errorf("Expected rank %d, got %d", tt.expectedRank, rank.Rank)
+			}
+			if rank.TotalCategories != 3 {
+				t.Errorf("Expected 3 categories, got %d", rank.TotalCategories)
+			}
+			if math.Abs(rank.Percentile-tt.expectedPercentile) > 0.01 {
+				t.Errorf("Expected percentile %.2f, got %.2f", tt.expectedPercentile, rank.Percentile)
+			}
+		})
+	}
+
+	t.Run("category without ratings ranks last", func(t *testing.T) {
+		unrated := append(categories, models.RatingCategory{ID: 4, Name: "Randomness", Weight: 1})
+		service := NewRatingAnalyticsService(&mockCategoryRepo{categories: unrated}, &mocks.MockRatingsRepo{Ratings: ratings}, NewTicketScoreService())
+
+		rank, err := service.GetCategoryRank(context.Background(), 4, startDate, endDate)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if rank.Score != "N/A" || rank.Rank != 4 || rank.Percentile != 25 {
+			t.Errorf("Expected N/A at rank 4 and percentile 25, got %s at rank %d and percentile %.2f", rank.Score, rank.Rank, rank.Percentile)
+		}
+	})
+
+	t.Run("unknown category", func(t *testing.T) {
+		if _, err := service.GetCategoryRank(context.Background(), 99, startDate, endDate); !errors.Is(err, ErrCategoryNotFound) {
+			t.Errorf("Expected ErrCategoryNotFound, got %v", err)
+		}
+	})
+}
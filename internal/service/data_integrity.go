@@ -0,0 +1,62 @@
+package service
+
+import (
+	"context"
+	"fmt"
+)
+
+// IntegrityRepository defines the interface for data consistency checks
+type IntegrityRepository interface {
+	CountOrphanedRatings(ctx context.Context) (int, error)
+	CountInvalidRatings(ctx context.Context, maxRating int) (int, error)
+	CountOrphanedTicketRefs(ctx context.Context) (int, error)
+}
+
+// IntegrityReport holds the number of problems found by each integrity check
+type IntegrityReport struct {
+	OrphanedRatings    int  `json:"orphaned_ratings"`
+	InvalidRatings     int  `json:"invalid_ratings"`
+	OrphanedTicketRefs int  `json:"orphaned_ticket_refs"`
+	IsClean            bool `json:"is_clean"`
+}
+
+// DataIntegrityService checks the stored ratings against the data they reference
+type DataIntegrityService struct {
+	integrityRepo IntegrityRepository
+	maxRating     int
+}
+
+// NewDataIntegrityService creates a new data integrity service instance that flags ratings
+// above maxRating as invalid
+func NewDataIntegrityService(integrityRepo IntegrityRepository, maxRating int) *DataIntegrityService {
+	return &DataIntegrityService{
+		integrityRepo: integrityRepo,
+		maxRating:     maxRating,
+	}
+}
+
+// CheckDataIntegrity counts ratings in unknown categories, ratings outside [0, max rating] and
+// tickets referenced by ratings that don't exist
+func (s *DataIntegrityService) CheckDataIntegrity(ctx context.Context) (*IntegrityReport, error) {
+	orphanedRatings, err := s.integrityRepo.CountOrphanedRatings(ctx)
+	if err != nil {
+		return nil, logError(ctx, fmt.Errorf("failed to check category references: %w", err))
+	}
+
+	invalidRatings, err := s.integrityRepo.CountInvalidRatings(ctx, s.maxRating)
+	if err != nil {
+		return nil, logError(ctx, fmt.Errorf("failed to check rating values: %w", err))
+	}
+
+	orphanedTicketRefs, err := s.integrityRepo.CountOrphanedTicketRefs(ctx)
+	if err != nil {
+		return nil, logError(ctx, fmt.Errorf("failed to check ticket references: %w", err))
+	}
+
+	return &IntegrityReport{
+		OrphanedRatings:    orphanedRatings,
+		InvalidRatings:     invalidRatings,
+		OrphanedTicketRefs: orphanedTicketRefs,
+		IsClean:            orphanedRatings == 0 && invalidRatings == 0 && orphanedTicketRefs == 0,
+	}, nil
+}
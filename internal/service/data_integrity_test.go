@@ -0,0 +1,102 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"ticket-score-service/internal/models"
+	"ticket-score-service/internal/repository"
+	"ticket-score-service/internal/testutil"
+)
+
+func TestDataIntegrityService_CheckDataIntegrity(t *testing.T) {
+	createdAt := time.Date(2019, 10, 1, 9, 0, 0, 0, time.UTC)
+	categories := []models.RatingCategory{{ID: 1, Name: "Spelling", Weight: 1}, {ID: 2, Name: "Grammar", Weight: 0.7}}
+
+	tests := []struct {
+		name      string
+		ratings   []models.Rating
+		maxRating int
+		expected  IntegrityReport
+	}{
+		{
+			name: "clean data",
+			ratings: []models.Rating{
+				{ID: 1, Rating: 0, TicketID: 1, RatingCategoryID: 1, CreatedAt: createdAt},
+				{ID: 2, Rating: 5, TicketID: 2, RatingCategoryID: 2, CreatedAt: createdAt},
+			},
+			expected: IntegrityReport{IsClean: true},
+		},
+		{
+			name: "ratings in deleted categories",
+			ratings: []models.Rating{
+				{ID: 1, Rating: 4, TicketID: 1, RatingCategoryID: 1, CreatedAt: createdAt},
+				{ID: 2, Rating: 4, TicketID: 1, RatingCategoryID: 9, CreatedAt: createdAt},
+				{ID: 3, Rating: 3, TicketID: 2, RatingCategoryID: 9, CreatedAt: createdAt},
+			},
+			expected: IntegrityReport{OrphanedRatings: 2},
+		},
+		{
+			name: "rating values out of range",
+			ratings: []models.Rating{
+				{ID: 1, Rating: -1, TicketID: 1, RatingCategoryID: 1, CreatedAt: createdAt},
+				{ID: 2, Rating: 6, TicketID: 2, RatingCategoryID: 1, CreatedAt: createdAt},
+				{ID: 3, Rating: 5, TicketID: 2, RatingCategoryID: 2, CreatedAt: createdAt},
+			},
+			expected: IntegrityReport{InvalidRatings: 2},
+		},
+		{
+			name: "ten-point scale",
+			ratings: []models.Rating{
+				{ID: 1, Rating: 9, TicketID: 1, RatingCategoryID: 1, CreatedAt: createdAt},
+				{ID: 2, Rating: 11, TicketID: 2, RatingCategoryID: 1, CreatedAt: createdAt},
+			},
+			maxRating: 10,
+			expected:  IntegrityReport{InvalidRatings: 1},
+		},
+		{
+			name: "missing tickets are counted once each",
+			ratings: []models.Rating{
+				{ID: 1, Rating: 4, TicketID: 7, RatingCategoryID: 1, CreatedAt: createdAt},
+				{ID: 2, Rating: 4, TicketID: 7, RatingCategoryID: 2, CreatedAt: createdAt},
+				{ID: 3, Rating: 4, TicketID: 8, RatingCategoryID: 1, CreatedAt: createdAt},
+			},
+			expected: IntegrityReport{OrphanedTicketRefs: 2},
+		},
+		{
+			name: "every issue type",
+			ratings: []models.Rating{
+				{ID: 1, Rating: 4, TicketID: 1, RatingCategoryID: 9, CreatedAt: createdAt},
+				{ID: 2, Rating: 9, TicketID: 2, RatingCategoryID: 1, CreatedAt: createdAt},
+				{ID: 3, Rating: 4, TicketID: 7, RatingCategoryID: 2, CreatedAt: createdAt},
+			},
+			expected: IntegrityReport{OrphanedRatings: 1, InvalidRatings: 1, OrphanedTicketRefs: 1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := testutil.NewTestDB(t)
+			testutil.SeedTestData(t, db, tt.ratings, categories)
+			for _, ticketID := range []int{1, 2} {
+				if _, err := db.ExecContext(context.Background(), `INSERT INTO tickets (id, subject, created_at) VALUES (?, ?, ?)`, ticketID, "Subject", createdAt); err != nil {
+					t.Fatalf("Failed to insert ticket: %v", err)
+				}
+			}
+			maxRating := defaultMaxRating
+			if tt.maxRating > 0 {
+				maxRating = tt.maxRating
+			}
+			service := NewDataIntegrityService(repository.NewIntegrityRepository(db), maxRating)
+
+			report, err := service.CheckDataIntegrity(context.Background())
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if *report != tt.expected {
+				t.Errorf("Expected %+v, got %+v", tt.expected, *report)
+			}
+		})
+	}
+}
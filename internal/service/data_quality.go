@@ -0,0 +1,65 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"ticket-score-service/internal/models"
+)
+
+// RatingQualityRepository counts what the rating data quality metrics are calculated from
+type RatingQualityRepository interface {
+	GetRatingQualityCounts(ctx context.Context, startDate, endDate time.Time) (*models.RatingQualityCounts, error)
+}
+
+// RatingQualityMetrics describes how trustworthy the ratings of a period are, each metric
+// formatted to one decimal place, or "N/A" without ratings
+type RatingQualityMetrics struct {
+	CoverageRate            string `json:"coverageRate"`            // Rated tickets rated in every category, e.g. "75.0%"
+	AverageRatingsPerTicket string `json:"averageRatingsPerTicket"` // e.g. "3.5"
+	ReviewerDiversityIndex  string `json:"reviewerDiversityIndex"`  // Distinct reviewers per 100 ratings, e.g. "12.5%"
+	DuplicateRate           string `json:"duplicateRate"`           // (reviewer, ticket, category, day) combinations rated more than once, e.g. "0.0%"
+}
+
+// DataQualityService assesses the health of the rating data scores are calculated from
+type DataQualityService struct {
+	qualityRepo RatingQualityRepository
+}
+
+// NewDataQualityService creates a new data quality service instance
+func NewDataQualityService(qualityRepo RatingQualityRepository) *DataQualityService {
+	return &DataQualityService{
+		qualityRepo: qualityRepo,
+	}
+}
+
+// GetRatingQualityMetrics calculates the coverage, density, reviewer diversity and duplicate rate of the
+// ratings created in a date range. Coverage only counts tickets with at least one rating.
+func (s *DataQualityService) GetRatingQualityMetrics(ctx context.Context, startDate, endDate time.Time) (*RatingQualityMetrics, error) {
+	counts, err := s.qualityRepo.GetRatingQualityCounts(ctx, startDate, endDate)
+	if err != nil {
+		return nil, logError(ctx, fmt.Errorf("failed to count ratings: %w", err))
+	}
+
+	if counts.TotalRatings == 0 {
+		return &RatingQualityMetrics{
+			CoverageRate:            "N/A",
+			AverageRatingsPerTicket: "N/A",
+			ReviewerDiversityIndex:  "N/A",
+			DuplicateRate:           "N/A",
+		}, nil
+	}
+
+	return &RatingQualityMetrics{
+		CoverageRate:            formatRate(counts.FullyRatedTickets, counts.RatedTickets),
+		AverageRatingsPerTicket: fmt.Sprintf("%.1f", float64(counts.TotalRatings)/float64(counts.RatedTickets)),
+		ReviewerDiversityIndex:  formatRate(counts.DistinctReviewers, counts.TotalRatings),
+		DuplicateRate:           formatRate(counts.DuplicatedGroups, counts.RatingGroups),
+	}, nil
+}
+
+// formatRate formats part / whole as a percentage with one decimal place
+func formatRate(part, whole int) string {
+	return fmt.Sprintf("%.1f%%", float64(part)/float64(whole)*100)
+}
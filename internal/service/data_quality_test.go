@@ -0,0 +1,68 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"ticket-score-service/internal/models"
+	"ticket-score-service/internal/repository"
+	"ticket-score-service/internal/testutil"
+)
+
+func TestDataQualityService_GetRatingQualityMetrics(t *testing.T) {
+	day := time.Date(2019, 10, 1, 9, 0, 0, 0, time.UTC)
+	categories := []models.RatingCategory{{ID: 1, Name: "Spelling", Weight: 1}, {ID: 2, Name: "Grammar", Weight: 0.7}}
+
+	tests := []struct {
+		name     string
+		ratings  []models.Rating
+		expected RatingQualityMetrics
+	}{
+		{
+			name: "clean data",
+			ratings: []models.Rating{
+				{ID: 1, Rating: 4, TicketID: 1, RatingCategoryID: 1, ReviewerID: 1, CreatedAt: day},
+				{ID: 2, Rating: 4, TicketID: 1, RatingCategoryID: 2, ReviewerID: 1, CreatedAt: day},
+				{ID: 3, Rating: 3, TicketID: 2, RatingCategoryID: 1, ReviewerID: 2, CreatedAt: day},
+				// Same reviewer, ticket and category on another day isn't a duplicate
+				{ID: 4, Rating: 5, TicketID: 2, RatingCategoryID: 1, ReviewerID: 2, CreatedAt: day.AddDate(0, 0, 1)},
+				// Outside the date range
+				{ID: 5, Rating: 5, TicketID: 3, RatingCategoryID: 1, ReviewerID: 3, CreatedAt: day.AddDate(0, 0, 5)},
+			},
+			expected: RatingQualityMetrics{CoverageRate: "50.0%", AverageRatingsPerTicket: "2.0", ReviewerDiversityIndex: "50.0%", DuplicateRate: "0.0%"},
+		},
+		{
+			name: "duplicate ratings",
+			ratings: []models.Rating{
+				{ID: 1, Rating: 4, TicketID: 1, RatingCategoryID: 1, ReviewerID: 1, CreatedAt: day},
+				{ID: 2, Rating: 2, TicketID: 1, RatingCategoryID: 1, ReviewerID: 1, CreatedAt: day.Add(5 * time.Hour)},
+				{ID: 3, Rating: 4, TicketID: 1, RatingCategoryID: 2, ReviewerID: 1, CreatedAt: day},
+				{ID: 4, Rating: 3, TicketID: 2, RatingCategoryID: 1, ReviewerID: 2, CreatedAt: day},
+				{ID: 5, Rating: 3, TicketID: 2, RatingCategoryID: 1, ReviewerID: 3, CreatedAt: day},
+			},
+			expected: RatingQualityMetrics{CoverageRate: "50.0%", AverageRatingsPerTicket: "2.5", ReviewerDiversityIndex: "60.0%", DuplicateRate: "25.0%"},
+		},
+		{
+			name:     "no ratings",
+			ratings:  nil,
+			expected: RatingQualityMetrics{CoverageRate: "N/A", AverageRatingsPerTicket: "N/A", ReviewerDiversityIndex: "N/A", DuplicateRate: "N/A"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := testutil.NewTestDB(t)
+			testutil.SeedTestData(t, db, tt.ratings, categories)
+			service := NewDataQualityService(repository.NewIntegrityRepository(db))
+
+			metrics, err := service.GetRatingQualityMetrics(context.Background(), day, day.AddDate(0, 0, 1))
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if *metrics != tt.expected {
+				t.Errorf("Expected %+v, got %+v", tt.expected, *metrics)
+			}
+		})
+	}
+}
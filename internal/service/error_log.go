@@ -0,0 +1,20 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"ticket-score-service/internal/logger"
+)
+
+// logError logs err with the logger of the request, see logger.FromContext, and returns it. It is
+// called where a repository error enters the service layer, so each failure is logged once, with
+// the call stack of the service method when LOG_ERROR_STACKS is set. Canceled requests and missed
+// deadlines aren't failures of the service and aren't logged.
+func logError(ctx context.Context, err error) error {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return err
+	}
+	logger.LogErrorWithStack(ctx, logger.FromContext(ctx), "service error", err)
+	return err
+}
@@ -0,0 +1,55 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"ticket-score-service/internal/logger"
+	"ticket-score-service/internal/mocks"
+	"ticket-score-service/internal/models"
+)
+
+func TestLogError(t *testing.T) {
+	categories := []models.RatingCategory{{ID: 1, Name: "Spelling", Weight: 1}}
+	day := time.Date(2019, 10, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name           string
+		repoErr        error
+		logErrorStacks bool
+		expectedLog    bool
+		expectedStack  bool
+	}{
+		{name: "with stack", repoErr: errors.New("database is locked"), logErrorStacks: true, expectedLog: true, expectedStack: true},
+		{name: "without stack", repoErr: errors.New("database is locked"), expectedLog: true},
+		{name: "canceled request", repoErr: fmt.Errorf("query interrupted: %w", context.Canceled), logErrorStacks: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var logs bytes.Buffer
+			ctx := logger.NewContext(context.Background(), logger.New(&logs, tt.logErrorStacks))
+			service := NewRatingAnalyticsService(&mockCategoryRepo{categories: categories}, &mocks.MockRatingsRepo{Err: tt.repoErr}, NewTicketScoreService())
+
+			if _, err := service.GetCategoryScoreDrift(ctx, 1, day, day); !errors.Is(err, tt.repoErr) {
+				t.Fatalf("Expected %v, got %v", tt.repoErr, err)
+			}
+
+			output := logs.String()
+			if strings.Contains(output, "level=ERROR") != tt.expectedLog {
+				t.Errorf("Expected log %v, got %q", tt.expectedLog, output)
+			}
+			if strings.Contains(output, "stack=") != tt.expectedStack {
+				t.Errorf("Expected stack %v, got %q", tt.expectedStack, output)
+			}
+			if tt.expectedStack && !strings.Contains(output, "GetCategoryScoreDrift") {
+				t.Errorf("Expected the stack to name the service method, got %q", output)
+			}
+		})
+	}
+}
@@ -0,0 +1,41 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// HourWeekdayHeatmap holds a category's score for every hour of every day of the week (UTC).
+// Matrix[weekday][hour] is indexed from Sunday and midnight.
+type HourWeekdayHeatmap struct {
+	CategoryName string        `json:"categoryName"`
+	Matrix       [7][24]string `json:"matrix"`
+}
+
+// GetCategoryScoreHeatmapByHourAndWeekday calculates a category's score for each hour of each day
+// of the week (UTC) over a date range. Cells without ratings get an "N/A" score.
+func (s *RatingAnalyticsService) GetCategoryScoreHeatmapByHourAndWeekday(ctx context.Context, categoryID int, startDate, endDate time.Time) (*HourWeekdayHeatmap, error) {
+	category, err := findCategory(ctx, s.categoryRepo, categoryID)
+	if err != nil {
+		return nil, err
+	}
+
+	cells, err := s.ratingsRepo.GetRatingsByHourAndWeekdayAndCategoryID(ctx, categoryID, startDate, endDate)
+	if err != nil {
+		return nil, logError(ctx, fmt.Errorf("failed to get ratings by hour and weekday: %w", err))
+	}
+
+	heatmap := &HourWeekdayHeatmap{CategoryName: category.Name}
+	for weekday := range heatmap.Matrix {
+		for hour := range heatmap.Matrix[weekday] {
+			heatmap.Matrix[weekday][hour] = "N/A"
+		}
+	}
+
+	for _, cell := range cells {
+		heatmap.Matrix[cell.Weekday][cell.Hour] = s.formatScore(ratingToPercent(cell.AverageRating, s.ticketScoreServ.MaxRating()))
+	}
+
+	return heatmap, nil
+}
@@ -0,0 +1,61 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"ticket-score-service/internal/mocks"
+	"ticket-score-service/internal/models"
+)
+
+func TestGetCategoryScoreHeatmapByHourAndWeekday(t *testing.T) {
+	// 2024-01-01 is a Monday
+	monday := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	categories := []models.RatingCategory{
+		{ID: 1, Name: "Spelling", Weight: 1},
+		{ID: 2, Name: "Grammar", Weight: 1},
+	}
+	ratings := map[string][]models.Rating{
+		"all": {
+			{ID: 1, RatingCategoryID: 1, Rating: 5, CreatedAt: monday.Add(9 * time.Hour)},
+			{ID: 2, RatingCategoryID: 1, Rating: 3, CreatedAt: monday.Add(7*24*time.Hour + 9*time.Hour + 45*time.Minute)}, // next Monday
+			{ID: 3, RatingCategoryID: 1, Rating: 2, CreatedAt: monday.Add(4*24*time.Hour + 17*time.Hour)},                 // Friday
+			{ID: 4, RatingCategoryID: 2, Rating: 1, CreatedAt: monday.Add(2*24*time.Hour + 12*time.Hour)},                 // other category
+		},
+	}
+
+	service := NewRatingAnalyticsService(&mockCategoryRepo{categories: categories}, &mocks.MockRatingsRepo{Ratings: ratings}, NewTicketScoreService())
+
+	heatmap, err := service.GetCategoryScoreHeatmapByHourAndWeekday(context.Background(), 1, monday, monday.AddDate(0, 0, 13))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if heatmap.CategoryName != "Spelling" {
+		t.Errorf("Expected category Spelling, got %s", heatmap.CategoryName)
+	}
+
+	expected := map[[2]int]string{
+		{int(time.Monday), 9}:  "80%",
+		{int(time.Friday), 17}: "40%",
+	}
+	for weekday := range heatmap.Matrix {
+		for hour, score := range heatmap.Matrix[weekday] {
+			want, ok := expected[[2]int{weekday, hour}]
+			if !ok {
+				want = "N/A"
+			}
+			if score != want {
+				t.Errorf("Expected %s at weekday %d hour %d, got %s", want, weekday, hour, score)
+			}
+		}
+	}
+
+	t.Run("unknown category", func(t *testing.T) {
+		if _, err := service.GetCategoryScoreHeatmapByHourAndWeekday(context.Background(), 99, monday, monday); !errors.Is(err, ErrCategoryNotFound) {
+			t.Errorf("Expected ErrCategoryNotFound, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,65 @@
+package service
+
+import (
+	"context"
+	"math"
+	"time"
+)
+
+// movingRangeD4 is the control chart constant for moving ranges of two consecutive points
+const movingRangeD4 = 3.267
+
+// MRPoint is the absolute change in a category's score from its previous day with ratings
+type MRPoint struct {
+	Date         string  `json:"date"`
+	MR           float64 `json:"mr"`
+	OutOfControl bool    `json:"outOfControl"`
+}
+
+// MovingRangeReport is a moving range control chart of a category's daily scores
+type MovingRangeReport struct {
+	CategoryName      string    `json:"categoryName"`
+	MovingRanges      []MRPoint `json:"movingRanges"`
+	AverageMR         float64   `json:"averageMr"`
+	UpperControlLimit float64   `json:"upperControlLimit"`
+}
+
+// GetCategoryMovingRange calculates the moving range of a category's daily scores from startDate to
+// endDate: the absolute difference in percentage points between each day with ratings and the
+// previous day with ratings. The upper control limit is 3.267 × the average moving range, and
+// days whose moving range exceeds it are out of control.
+func (s *RatingAnalyticsService) GetCategoryMovingRange(ctx context.Context, categoryID int, startDate, endDate time.Time) (*MovingRangeReport, error) {
+	category, err := findCategory(ctx, s.categoryRepo, categoryID)
+	if err != nil {
+		return nil, err
+	}
+
+	scores, dates, err := s.ratedDailyScores(ctx, category, startDate, endDate)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &MovingRangeReport{
+		CategoryName: category.Name,
+		MovingRanges: []MRPoint{},
+	}
+	for i := 1; i < len(scores); i++ {
+		report.MovingRanges = append(report.MovingRanges, MRPoint{Date: dates[i], MR: math.Abs(scores[i] - scores[i-1])})
+	}
+
+	if len(report.MovingRanges) == 0 {
+		return report, nil
+	}
+
+	var sum float64
+	for _, point := range report.MovingRanges {
+		sum += point.MR
+	}
+	report.AverageMR = sum / float64(len(report.MovingRanges))
+	report.UpperControlLimit = movingRangeD4 * report.AverageMR
+	for i := range report.MovingRanges {
+		report.MovingRanges[i].OutOfControl = report.MovingRanges[i].MR > report.UpperControlLimit
+	}
+
+	return report, nil
+}
@@ -0,0 +1,79 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"ticket-score-service/internal/mocks"
+	"ticket-score-service/internal/models"
+)
+
+func TestGetCategoryMovingRange(t *testing.T) {
+	startDate := time.Date(2019, 10, 1, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2019, 10, 7, 0, 0, 0, 0, time.UTC)
+	categories := []models.RatingCategory{{ID: 1, Name: "Spelling", Weight: 1}}
+
+	// 40% on four days, with no ratings on 2019-10-04, then a jump to 100%
+	ratings := map[string][]models.Rating{}
+	for i, day := range []int{1, 2, 3, 5, 6} {
+		rating := 2
+		if day == 6 {
+			rating = 5
+		}
+		date := time.Date(2019, 10, day, 9, 0, 0, 0, time.UTC)
+		ratings[date.Format("2006-01-02")] = []models.Rating{{ID: i + 1, RatingCategoryID: 1, Rating: rating, CreatedAt: date}}
+	}
+
+	service := NewRatingAnalyticsService(&mockCategoryRepo{categories: categories}, &mocks.MockRatingsRepo{Ratings: ratings}, NewTicketScoreService())
+
+	report, err := service.GetCategoryMovingRange(context.Background(), 1, startDate, endDate)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if report.CategoryName != "Spelling" {
+		t.Errorf("Expected category Spelling, got %s", report.CategoryName)
+	}
+
+	expected := []MRPoint{
+		{Date: "2019-10-02", MR: 0},
+		{Date: "2019-10-03", MR: 0},
+		{Date: "2019-10-05", MR: 0},
+		{Date: "2019-10-06", MR: 60, OutOfControl: true},
+	}
+	if len(report.MovingRanges) != len(expected) {
+		t.Fatalf("Expected %d moving ranges, got %+v", len(expected), report.MovingRanges)
+	}
+	for i, point := range report.MovingRanges {
+		if point.Date != expected[i].Date || math.Abs(point.MR-expected[i].MR) > 0.01 || point.OutOfControl != expected[i].OutOfControl {
+			t.Errorf("Expected moving range %+v, got %+v", expected[i], point)
+		}
+	}
+
+	// UCL = 3.267 × (0 + 0 + 0 + 60) / 4
+	if math.Abs(report.AverageMR-15) > 0.01 {
+		t.Errorf("Expected average moving range 15, got %.2f", report.AverageMR)
+	}
+	if math.Abs(report.UpperControlLimit-49.005) > 0.001 {
+		t.Errorf("Expected upper control limit 49.005, got %.3f", report.UpperControlLimit)
+	}
+
+	t.Run("single rated day", func(t *testing.T) {
+		report, err := service.GetCategoryMovingRange(context.Background(), 1, startDate, startDate)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if len(report.MovingRanges) != 0 || report.AverageMR != 0 || report.UpperControlLimit != 0 {
+			t.Errorf("Expected an empty report, got %+v", report)
+		}
+	})
+
+	t.Run("unknown category", func(t *testing.T) {
+		if _, err := service.GetCategoryMovingRange(context.Background(), 99, startDate, endDate); !errors.Is(err, ErrCategoryNotFound) {
+			t.Errorf("Expected ErrCategoryNotFound, got %v", err)
+		}
+	})
+}
@@ -2,10 +2,14 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"sync"
 	"time"
 
+	"ticket-score-service/internal/concurrency"
+	"ticket-score-service/internal/config"
+	"ticket-score-service/internal/metrics"
 	"ticket-score-service/internal/models"
 	"ticket-score-service/internal/utils"
 )
@@ -16,6 +20,34 @@ type OverallQualityScore struct {
 	Score  string `json:"score"`
 }
 
+// QualityProgress represents progress of a streamed overall quality score calculation
+type QualityProgress struct {
+	ChunksDone   int    `json:"chunks_done"`
+	TotalChunks  int    `json:"total_chunks"`
+	PartialScore string `json:"partial_score"`
+	IsFinal      bool   `json:"is_final"`
+	Score        string `json:"score"`
+	Period       string `json:"period"`
+}
+
+// ErrPartialResult is returned when some, but not all, chunks fail.
+// PartialScore is calculated from the successful chunks only.
+type ErrPartialResult struct {
+	SuccessfulChunks int
+	TotalChunks      int
+	PartialScore     float64
+	Errors           []error
+}
+
+func (e *ErrPartialResult) Error() string {
+	return fmt.Sprintf("partial result: %d of %d chunks succeeded: %v", e.SuccessfulChunks, e.TotalChunks, e.Errors)
+}
+
+// Unwrap returns the errors of the failed chunks
+func (e *ErrPartialResult) Unwrap() []error {
+	return e.Errors
+}
+
 // ChunkResult represents the result of processing a single chunk
 type ChunkResult struct {
 	WeightedScore float64
@@ -35,82 +67,358 @@ type ChunkWork struct {
 	Categories []models.RatingCategory
 }
 
+// ConfigSource provides the current configuration, which can change while services run
+type ConfigSource interface {
+	Get() *config.Config
+}
+
+// chunkLimits holds the chunking settings of a single calculation
+type chunkLimits struct {
+	size          int
+	maxGoroutines int
+}
+
+// validate reports an error if the limits would stall or divide by zero
+func (l chunkLimits) validate() error {
+	if l.size <= 0 {
+		return fmt.Errorf("chunk size must be positive, got %d", l.size)
+	}
+	if l.maxGoroutines <= 0 {
+		return fmt.Errorf("max goroutines must be positive, got %d", l.maxGoroutines)
+	}
+	return nil
+}
+
+// chunkCount returns the number of chunks needed to process totalCount ratings
+func (l chunkLimits) chunkCount(totalCount int) int {
+	return (totalCount + l.size - 1) / l.size
+}
+
 // OverallQualityService handles overall quality score calculations using concurrent pagination
 type OverallQualityService struct {
 	ratingsRepo   RatingsRepository
 	categoryRepo  CategoryRepository
 	maxGoroutines int
 	chunkSize     int
+	config        ConfigSource
+	globalLimiter *concurrency.GlobalConcurrencyLimiter
+	analytics     CategoryAnalyticsProvider
+	maxRating     int
+
+	// Longest date range scored by day in GetOverallQualityScoreHistory
+	aggregationThresholdDays int
+
+	// Scores of periods that ended more than cacheStaleDays ago, keyed by start and end date
+	resultCache    *scoreCache
+	cacheStaleDays int
+}
+
+// OverallQualityOption configures an OverallQualityService
+type OverallQualityOption func(*OverallQualityService)
+
+// WithChunkSize sets the number of ratings fetched per chunk. Defaults to 1000. Ignored when
+// WithConfigSource is given.
+func WithChunkSize(size int) OverallQualityOption {
+	return func(s *OverallQualityService) {
+		s.chunkSize = size
+	}
+}
+
+// WithMaxGoroutines sets the number of chunks processed concurrently per calculation. Defaults to 10.
+// Ignored when WithConfigSource is given.
+func WithMaxGoroutines(n int) OverallQualityOption {
+	return func(s *OverallQualityService) {
+		s.maxGoroutines = n
+	}
+}
+
+// WithOverallQualityMaxRating sets the highest rating value, which scores 100%. Defaults to 5.
+// NewOverallQualityService returns an error if it is not positive.
+func WithOverallQualityMaxRating(maxRating int) OverallQualityOption {
+	return func(s *OverallQualityService) {
+		s.maxRating = maxRating
+	}
+}
+
+// WithOverallQualityAggregationThresholdDays sets the longest date range, in days, that
+// GetOverallQualityScoreHistory scores by day, as WithAggregationThresholdDays does for the category
+// analytics. Defaults to 30.
+func WithOverallQualityAggregationThresholdDays(days int) OverallQualityOption {
+	return func(s *OverallQualityService) {
+		s.aggregationThresholdDays = days
+	}
+}
+
+// WithOverallQualityConcurrencyLimiter shares a global goroutine limit with other services, on top
+// of the per-service chunk limit
+func WithOverallQualityConcurrencyLimiter(limiter *concurrency.GlobalConcurrencyLimiter) OverallQualityOption {
+	return func(s *OverallQualityService) {
+		s.globalLimiter = limiter
+	}
+}
+
+// WithConfigSource reads the chunk size and concurrency from the current configuration for each
+// calculation. It takes precedence over WithChunkSize and WithMaxGoroutines.
+func WithConfigSource(source ConfigSource) OverallQualityOption {
+	return func(s *OverallQualityService) {
+		s.config = source
+	}
+}
+
+// WithCacheStaleDays caches the scores of periods that ended more than days ago, since their
+// ratings no longer change. Defaults to 0, which disables the cache.
+func WithCacheStaleDays(days int) OverallQualityOption {
+	return func(s *OverallQualityService) {
+		s.cacheStaleDays = days
+	}
 }
 
-// NewOverallQualityService creates a new overall quality service instance
+// NewOverallQualityService creates a new overall quality service instance. analytics provides the
+// per-category scores of GetOverallQualityScoreBreakdown. It returns an error if the chunk size,
+// goroutine limit or max rating is not positive.
 func NewOverallQualityService(
 	ratingsRepo RatingsRepository,
 	categoryRepo CategoryRepository,
-) *OverallQualityService {
-	return &OverallQualityService{
+	analytics CategoryAnalyticsProvider,
+	opts ...OverallQualityOption,
+) (*OverallQualityService, error) {
+	s := &OverallQualityService{
 		ratingsRepo:   ratingsRepo,
 		categoryRepo:  categoryRepo,
+		analytics:     analytics,
 		maxGoroutines: 10,   // Default concurrency limit
 		chunkSize:     1000, // Default chunk size
+		maxRating:     defaultMaxRating,
+		resultCache:   newScoreCache(maxCachedScores),
+
+		aggregationThresholdDays: defaultAggregationThresholdDays,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	if err := (chunkLimits{size: s.chunkSize, maxGoroutines: s.maxGoroutines}).validate(); err != nil {
+		return nil, err
 	}
+	if s.maxRating <= 0 {
+		return nil, fmt.Errorf("max rating must be positive, got %d", s.maxRating)
+	}
+	return s, nil
+}
+
+// currentChunkLimits returns the chunking settings to use for a new calculation
+func (s *OverallQualityService) currentChunkLimits() chunkLimits {
+	if s.config != nil {
+		cfg := s.config.Get()
+		return chunkLimits{size: cfg.ChunkSize, maxGoroutines: cfg.MaxGoroutines}
+	}
+	return chunkLimits{size: s.chunkSize, maxGoroutines: s.maxGoroutines}
+}
+
+// InvalidateCache drops all cached scores. It is called whenever ratings are imported.
+func (s *OverallQualityService) InvalidateCache() {
+	s.resultCache.clear()
 }
 
-// GetOverallQualityScore calculates overall quality score using concurrent pagination processing
+// GetOverallQualityScore calculates overall quality score using concurrent pagination processing.
+// Scores of historical periods are cached, see WithCacheStaleDays.
 func (s *OverallQualityService) GetOverallQualityScore(ctx context.Context, startDate, endDate time.Time) (*OverallQualityScore, error) {
+	cacheable := s.cacheStaleDays > 0 && endDate.Before(time.Now().AddDate(0, 0, -s.cacheStaleDays))
+	key := startDate.Format(time.RFC3339Nano) + "/" + endDate.Format(time.RFC3339Nano)
+	// Ratings imported while the score is calculated invalidate it, see scoreCache.put
+	generation := s.resultCache.generation()
+	if cacheable {
+		if cached, ok := s.resultCache.get(key); ok {
+			return &cached, nil
+		}
+	}
+
+	result, complete, err := s.calculateOverallQualityScore(ctx, startDate, endDate)
+	if err != nil {
+		return nil, err
+	}
+
+	// Approximate scores from partial results are recalculated next time
+	if cacheable && complete {
+		s.resultCache.put(key, *result, generation)
+	}
+
+	return result, nil
+}
+
+// calculateOverallQualityScore calculates the overall quality score for a period, reporting
+// whether every chunk succeeded
+func (s *OverallQualityService) calculateOverallQualityScore(ctx context.Context, startDate, endDate time.Time) (*OverallQualityScore, bool, error) {
 	// Get total count
 	totalCount, err := s.ratingsRepo.CountByDateRange(ctx, startDate, endDate)
 	if err != nil {
-		return nil, fmt.Errorf("failed to count ratings: %w", err)
+		return nil, false, logError(ctx, fmt.Errorf("failed to count ratings: %w", err))
 	}
 
 	if totalCount == 0 {
 		return &OverallQualityScore{
 			Period: utils.FormatDateRange(startDate, endDate),
 			Score:  "N/A",
-		}, nil
+		}, true, nil
 	}
 
 	// Get categories for weighting
 	categories, err := s.categoryRepo.GetAll(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get categories: %w", err)
+		return nil, false, logError(ctx, fmt.Errorf("failed to get categories: %w", err))
 	}
 
 	// Process chunks concurrently
-	score, err := s.processChunksConcurrently(ctx, startDate, endDate, totalCount, categories)
+	score, err := s.processChunksConcurrently(ctx, startDate, endDate, totalCount, s.currentChunkLimits(), categories, nil)
 	if err != nil {
-		return nil, fmt.Errorf("failed to process chunks: %w", err)
+		// When only some chunks failed, report the approximate score
+		var partial *ErrPartialResult
+		if errors.As(err, &partial) {
+			return &OverallQualityScore{
+				Period: utils.FormatDateRange(startDate, endDate),
+				Score:  approximateScore(partial.PartialScore),
+			}, false, nil
+		}
+		return nil, false, fmt.Errorf("failed to process chunks: %w", err)
 	}
 
 	return &OverallQualityScore{
 		Period: utils.FormatDateRange(startDate, endDate),
 		Score:  utils.FormatScore(score),
-	}, nil
+	}, true, nil
+}
+
+// approximateScore formats a score calculated from partial results
+func approximateScore(score float64) string {
+	return "~" + utils.FormatScore(score)
 }
 
-// processChunksConcurrently processes rating chunks using goroutines
+// GetOverallQualityScoreStream calculates overall quality score, streaming progress after each chunk.
+// The last message sent has IsFinal set and carries the final score and period.
+func (s *OverallQualityService) GetOverallQualityScoreStream(ctx context.Context, startDate, endDate time.Time) (<-chan QualityProgress, <-chan error) {
+	progressChan := make(chan QualityProgress, 100)
+	errorChan := make(chan error, 1)
+
+	go func() {
+		defer close(progressChan)
+		defer close(errorChan)
+
+		period := utils.FormatDateRange(startDate, endDate)
+
+		totalCount, err := s.ratingsRepo.CountByDateRange(ctx, startDate, endDate)
+		if err != nil {
+			errorChan <- logError(ctx, fmt.Errorf("failed to count ratings: %w", err))
+			return
+		}
+
+		if totalCount == 0 {
+			progressChan <- QualityProgress{IsFinal: true, Score: "N/A", Period: period}
+			return
+		}
+
+		categories, err := s.categoryRepo.GetAll(ctx)
+		if err != nil {
+			errorChan <- logError(ctx, fmt.Errorf("failed to get categories: %w", err))
+			return
+		}
+
+		limits := s.currentChunkLimits()
+		if err := limits.validate(); err != nil {
+			errorChan <- err
+			return
+		}
+		numChunks := limits.chunkCount(totalCount)
+		chunkResults := make(chan ChunkResult, numChunks)
+		forwarded := make(chan struct{})
+
+		// Forward chunk results as running partial scores
+		go func() {
+			defer close(forwarded)
+
+			var chunksDone int
+			var weightedScore, maxScore float64
+			for result := range chunkResults {
+				chunksDone++
+				if result.Error == nil {
+					weightedScore += result.WeightedScore
+					maxScore += result.MaxScore
+				}
+
+				partialScore := "N/A"
+				if maxScore > 0 {
+					partialScore = utils.FormatScore((weightedScore / maxScore) * 100)
+				}
+
+				select {
+				case progressChan <- QualityProgress{
+					ChunksDone:   chunksDone,
+					TotalChunks:  numChunks,
+					PartialScore: partialScore,
+				}:
+				case <-ctx.Done():
+				}
+			}
+		}()
+
+		score, err := s.processChunksConcurrently(ctx, startDate, endDate, totalCount, limits, categories, chunkResults)
+		close(chunkResults)
+		<-forwarded
+
+		finalScore := utils.FormatScore(score)
+		if err != nil {
+			var partial *ErrPartialResult
+			if !errors.As(err, &partial) {
+				errorChan <- fmt.Errorf("failed to process chunks: %w", err)
+				return
+			}
+			finalScore = approximateScore(partial.PartialScore)
+		}
+
+		select {
+		case progressChan <- QualityProgress{
+			ChunksDone:  numChunks,
+			TotalChunks: numChunks,
+			IsFinal:     true,
+			Score:       finalScore,
+			Period:      period,
+		}:
+		case <-ctx.Done():
+		}
+	}()
+
+	return progressChan, errorChan
+}
+
+// processChunksConcurrently processes rating chunks using goroutines.
+// If progressChan is not nil, each chunk result is also sent to it as soon as it arrives.
 func (s *OverallQualityService) processChunksConcurrently(
 	ctx context.Context,
 	startDate, endDate time.Time,
 	totalCount int,
+	limits chunkLimits,
 	categories []models.RatingCategory,
+	progressChan chan<- ChunkResult,
 ) (float64, error) {
+	if err := limits.validate(); err != nil {
+		return 0, err
+	}
+
+	start := time.Now()
+	defer func() { metrics.CalculationDuration.Observe(time.Since(start).Seconds()) }()
 
 	// Calculate number of chunks
-	numChunks := (totalCount + s.chunkSize - 1) / s.chunkSize
+	numChunks := limits.chunkCount(totalCount)
 
 	// Create channels for results
 	resultChan := make(chan ChunkResult, numChunks)
 
 	// Start worker goroutines with semaphore for concurrency control
 	var wg sync.WaitGroup
-	semaphore := make(chan struct{}, s.maxGoroutines)
+	semaphore := make(chan struct{}, limits.maxGoroutines)
 
 	// Process each chunk
 	for i := 0; i < numChunks; i++ {
-		offset := i * s.chunkSize
-		limit := s.chunkSize
+		offset := i * limits.size
+		limit := limits.size
 		if offset+limit > totalCount {
 			limit = totalCount - offset
 		}
@@ -135,7 +443,7 @@ func (s *OverallQualityService) processChunksConcurrently(
 	}()
 
 	// Aggregate results
-	return s.aggregateChunkResults(resultChan, numChunks)
+	return s.aggregateChunkResults(ctx, resultChan, numChunks, progressChan)
 }
 
 // processChunk processes a single chunk of ratings
@@ -157,15 +465,23 @@ func (s *OverallQualityService) processChunk(
 	}
 	defer func() { <-semaphore }()
 
+	// Acquire a global slot shared with other services
+	if err := s.globalLimiter.Acquire(ctx); err != nil {
+		resultChan <- ChunkResult{ChunkID: work.ChunkID, Error: err}
+		return
+	}
+	defer s.globalLimiter.Release()
+
 	// Get ratings for this chunk
 	ratings, err := s.ratingsRepo.GetByDateRangePaginated(ctx, work.StartDate, work.EndDate, work.Limit, work.Offset)
 	if err != nil {
-		resultChan <- ChunkResult{ChunkID: work.ChunkID, Error: err}
+		resultChan <- ChunkResult{ChunkID: work.ChunkID, Error: logError(ctx, err)}
 		return
 	}
 
 	// Calculate weighted score for this chunk
 	weightedScore, maxScore := s.calculateChunkWeightedScore(ratings, work.Categories)
+	metrics.ChunksProcessed.Inc()
 
 	resultChan <- ChunkResult{
 		ChunkID:       work.ChunkID,
@@ -187,44 +503,69 @@ func (s *OverallQualityService) calculateChunkWeightedScore(ratings []models.Rat
 	var weightedSum, maxSum float64
 	for _, rating := range ratings {
 		weight := categoryWeights[rating.RatingCategoryID]
-		maxRating := 5.0 // Assuming 1-5 scale
 
 		weightedSum += float64(rating.Rating) * weight
-		maxSum += maxRating * weight
+		maxSum += float64(s.maxRating) * weight
 	}
 
 	return weightedSum, maxSum
 }
 
 // aggregateChunkResults combines results from all chunks
-func (s *OverallQualityService) aggregateChunkResults(resultChan <-chan ChunkResult, expectedChunks int) (float64, error) {
+func (s *OverallQualityService) aggregateChunkResults(
+	ctx context.Context,
+	resultChan <-chan ChunkResult,
+	expectedChunks int,
+	progressChan chan<- ChunkResult,
+) (float64, error) {
 	var (
 		totalWeightedScore = 0.0
 		totalMaxScore      = 0.0
-		errors             []error
+		successfulChunks   = 0
+		chunkErrors        []error
 	)
 
 	// Collect all results
 	for result := range resultChan {
+		if progressChan != nil {
+			select {
+			case progressChan <- result:
+			case <-ctx.Done():
+			}
+		}
+
 		if result.Error != nil {
-			errors = append(errors, fmt.Errorf("chunk %d failed: %w", result.ChunkID, result.Error))
+			chunkErrors = append(chunkErrors, fmt.Errorf("chunk %d failed: %w", result.ChunkID, result.Error))
 			continue
 		}
 
+		successfulChunks++
 		totalWeightedScore += result.WeightedScore
 		totalMaxScore += result.MaxScore
 	}
 
-	// Check if we have any errors
-	if len(errors) > 0 {
-		return 0, fmt.Errorf("chunk processing errors: %v", errors)
-	}
-
 	// Calculate final percentage
 	var finalScore float64
 	if totalMaxScore > 0 {
 		finalScore = (totalWeightedScore / totalMaxScore) * 100
 	}
 
+	// Check if we have any errors
+	if len(chunkErrors) > 0 {
+		// Chunks stopped by cancellation don't make the score approximate, the request failed
+		if err := ctx.Err(); err != nil {
+			return 0, fmt.Errorf("chunk processing stopped: %w", err)
+		}
+		if successfulChunks == 0 {
+			return 0, fmt.Errorf("chunk processing errors: %w", errors.Join(chunkErrors...))
+		}
+		return 0, &ErrPartialResult{
+			SuccessfulChunks: successfulChunks,
+			TotalChunks:      expectedChunks,
+			PartialScore:     finalScore,
+			Errors:           chunkErrors,
+		}
+	}
+
 	return finalScore, nil
 }
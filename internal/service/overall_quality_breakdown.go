@@ -0,0 +1,82 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"ticket-score-service/internal/utils"
+)
+
+// CategoryContribution is a category's share of the category-weighted overall score
+type CategoryContribution struct {
+	CategoryName         string  `json:"category_name"`
+	Weight               float64 `json:"weight"`
+	Score                string  `json:"score"`
+	WeightedContribution float64 `json:"weighted_contribution"`
+}
+
+// OverallQualityBreakdown splits an overall score into per-category contributions
+type OverallQualityBreakdown struct {
+	Period            string                 `json:"period"`
+	OverallScore      string                 `json:"overall_score"`
+	CategoryBreakdown []CategoryContribution `json:"category_breakdown"`
+}
+
+// GetOverallQualityScoreBreakdown splits the overall score of a period into category contributions.
+// OverallScore is GetOverallQualityScore over the same whole days as GetCategoryAnalytics, from the
+// start of startDate to the end of endDate. Every rating counts with its category's
+// weight there, so a category's share of the total weight is its weight times its rating count:
+// WeightedContribution is (score/100) * (weight*ratings / Σ weight*ratings), and the contributions
+// add up to OverallScore/100 up to the rounding of category scores. Categories without ratings
+// score "N/A" and contribute nothing.
+func (s *OverallQualityService) GetOverallQualityScoreBreakdown(ctx context.Context, startDate, endDate time.Time) (*OverallQualityBreakdown, error) {
+	categories, err := s.categoryRepo.GetAll(ctx)
+	if err != nil {
+		return nil, logError(ctx, fmt.Errorf("failed to get categories: %w", err))
+	}
+	weights := make(map[int]float64, len(categories))
+	for _, category := range categories {
+		weights[category.ID] = category.Weight
+	}
+
+	analytics, err := s.analytics.GetCategoryAnalytics(ctx, startDate, endDate)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get category analytics: %w", err)
+	}
+
+	// Whole days, like the category analytics, so a rating on endDate counts in both
+	from := time.Date(startDate.Year(), startDate.Month(), startDate.Day(), 0, 0, 0, 0, startDate.Location())
+	to := time.Date(endDate.Year(), endDate.Month(), endDate.Day(), 0, 0, 0, 0, endDate.Location()).AddDate(0, 0, 1)
+	overall, err := s.GetOverallQualityScore(ctx, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get overall score: %w", err)
+	}
+
+	var totalWeight float64
+	for _, category := range analytics {
+		if _, ok := parseScore(category.Score); ok {
+			totalWeight += weights[category.CategoryID] * float64(category.Ratings)
+		}
+	}
+
+	breakdown := &OverallQualityBreakdown{
+		Period:            utils.FormatDateRange(startDate, endDate),
+		OverallScore:      overall.Score,
+		CategoryBreakdown: make([]CategoryContribution, 0, len(analytics)),
+	}
+
+	for _, category := range analytics {
+		contribution := CategoryContribution{
+			CategoryName: category.Category,
+			Weight:       weights[category.CategoryID],
+			Score:        category.Score,
+		}
+		if score, ok := parseScore(category.Score); ok && totalWeight > 0 {
+			contribution.WeightedContribution = score / 100 * (contribution.Weight * float64(category.Ratings) / totalWeight)
+		}
+		breakdown.CategoryBreakdown = append(breakdown.CategoryBreakdown, contribution)
+	}
+
+	return breakdown, nil
+}
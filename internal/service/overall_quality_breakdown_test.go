@@ -0,0 +1,231 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"testing"
+	"time"
+
+	"ticket-score-service/internal/mocks"
+	"ticket-score-service/internal/models"
+	"ticket-score-service/internal/repository"
+	"ticket-score-service/internal/testutil"
+)
+
+// mockCategoryAnalytics returns canned category analytics
+type mockCategoryAnalytics struct {
+	analytics []CategoryAnalytics
+	err       error
+}
+
+func (m *mockCategoryAnalytics) GetCategoryAnalytics(ctx context.Context, startDate, endDate time.Time) ([]CategoryAnalytics, error) {
+	return m.analytics, m.err
+}
+
+func TestGetOverallQualityScoreBreakdown(t *testing.T) {
+	startDate := time.Date(2019, 10, 1, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2019, 10, 7, 0, 0, 0, 0, time.UTC)
+
+	categories := []models.RatingCategory{
+		{ID: 1, Name: "Spelling", Weight: 1},
+		{ID: 2, Name: "Grammar", Weight: 0.7},
+		{ID: 3, Name: "GDPR", Weight: 1.2},
+		{ID: 4, Name: "Randomness", Weight: 0.1},
+	}
+
+	tests := []struct {
+		name                  string
+		ratings               []models.Rating
+		scores                []string // by category, in category order
+		expectedOverall       string
+		expectedContributions []float64
+	}{
+		{
+			name: "all categories perfect",
+			ratings: []models.Rating{
+				{ID: 1, RatingCategoryID: 1, Rating: 5},
+				{ID: 2, RatingCategoryID: 2, Rating: 5},
+				{ID: 3, RatingCategoryID: 3, Rating: 5},
+				{ID: 4, RatingCategoryID: 4, Rating: 5},
+			},
+			scores:                []string{"100%", "100%", "100%", "100%"},
+			expectedOverall:       "100%",
+			expectedContributions: []float64{1.0 / 3, 0.7 / 3, 1.2 / 3, 0.1 / 3},
+		},
+		{
+			name: "categories weighted by their rating counts",
+			ratings: []models.Rating{
+				{ID: 1, RatingCategoryID: 1, Rating: 4},
+				{ID: 2, RatingCategoryID: 1, Rating: 4},
+				{ID: 3, RatingCategoryID: 2, Rating: 5},
+				{ID: 4, RatingCategoryID: 3, Rating: 2},
+			},
+			scores:          []string{"80%", "100%", "40%", "N/A"},
+			expectedOverall: "71%", // (1*2*4 + 0.7*5 + 1.2*2) / (5 * 3.9)
+			expectedContributions: []float64{
+				0.8 * 2 / 3.9,
+				1.0 * 0.7 / 3.9,
+				0.4 * 1.2 / 3.9,
+				0,
+			},
+		},
+		{
+			name:                  "no ratings",
+			scores:                []string{"N/A", "N/A", "N/A", "N/A"},
+			expectedOverall:       "N/A",
+			expectedContributions: []float64{0, 0, 0, 0},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			analytics := make([]CategoryAnalytics, len(categories))
+			for i, category := range categories {
+				analytics[i] = CategoryAnalytics{CategoryID: category.ID, Category: category.Name, Score: tt.scores[i]}
+				for _, rating := range tt.ratings {
+					if rating.RatingCategoryID == category.ID {
+						analytics[i].Ratings++
+					}
+				}
+			}
+
+			ratingsRepo := &mocks.MockRatingsRepo{
+				Ratings: map[string][]models.Rating{fmt.Sprintf("%d:0", len(tt.ratings)): tt.ratings},
+				Count:   len(tt.ratings),
+			}
+			service, err := NewOverallQualityService(ratingsRepo, &mockCategoryRepo{categories: categories}, &mockCategoryAnalytics{analytics: analytics})
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			breakdown, err := service.GetOverallQualityScoreBreakdown(context.Background(), startDate, endDate)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if breakdown.Period != "2019-10-01 to 2019-10-07" {
+				t.Errorf("Expected period 2019-10-01 to 2019-10-07, got %s", breakdown.Period)
+			}
+			if breakdown.OverallScore != tt.expectedOverall {
+				t.Errorf("Expected overall score %s, got %s", tt.expectedOverall, breakdown.OverallScore)
+			}
+			if len(breakdown.CategoryBreakdown) != len(categories) {
+				t.Fatalf("Expected %d categories, got %d", len(categories), len(breakdown.CategoryBreakdown))
+			}
+
+			var sum float64
+			for i, contribution := range breakdown.CategoryBreakdown {
+				if contribution.CategoryName != categories[i].Name || contribution.Weight != categories[i].Weight || contribution.Score != tt.scores[i] {
+					t.Errorf("Unexpected category %+v", contribution)
+				}
+				if math.Abs(contribution.WeightedContribution-tt.expectedContributions[i]) > 1e-9 {
+					t.Errorf("%s: expected contribution %.4f, got %.4f", contribution.CategoryName, tt.expectedContributions[i], contribution.WeightedContribution)
+				}
+				sum += contribution.WeightedContribution
+			}
+
+			// Contributions add up to the overall score as a fraction
+			overall, ok := parseScore(breakdown.OverallScore)
+			if !ok {
+				overall = 0
+			}
+			if math.Abs(sum*100-overall) > 0.5 {
+				t.Errorf("Expected contributions to sum to %.2f, got %.4f", overall/100, sum)
+			}
+		})
+	}
+
+	t.Run("analytics error", func(t *testing.T) {
+		service, err := NewOverallQualityService(&mocks.MockRatingsRepo{}, &mockCategoryRepo{categories: categories}, &mockCategoryAnalytics{err: errors.New("database error")})
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		if _, err := service.GetOverallQualityScoreBreakdown(context.Background(), startDate, endDate); err == nil {
+			t.Error("Expected error but got none")
+		}
+	})
+}
+
+func TestGetOverallQualityScoreBreakdown_MatchesOverallScore(t *testing.T) {
+	db := testutil.NewTestDB(t)
+	startDate := time.Date(2019, 10, 1, 0, 0, 0, 0, time.UTC)
+
+	// Spelling has three ratings and Grammar one, so the per-category scores alone would misweight them
+	testutil.SeedTestData(t, db, []models.Rating{
+		{ID: 1, Rating: 5, TicketID: 1, RatingCategoryID: 1, CreatedAt: startDate.Add(time.Hour)},
+		{ID: 2, Rating: 5, TicketID: 2, RatingCategoryID: 1, CreatedAt: startDate.Add(time.Hour)},
+		{ID: 3, Rating: 4, TicketID: 3, RatingCategoryID: 1, CreatedAt: startDate.Add(time.Hour)},
+		{ID: 4, Rating: 1, TicketID: 1, RatingCategoryID: 2, CreatedAt: startDate.Add(time.Hour)},
+	}, []models.RatingCategory{{ID: 1, Name: "Spelling", Weight: 1}, {ID: 2, Name: "Grammar", Weight: 0.7}})
+
+	ratingsRepo := repository.NewRatingsRepository(db)
+	categoryRepo := repository.NewRatingCategoryRepository(db)
+	analytics := NewRatingAnalyticsService(categoryRepo, ratingsRepo, NewTicketScoreService())
+	service, err := NewOverallQualityService(ratingsRepo, categoryRepo, analytics)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	endDate := startDate.AddDate(0, 0, 6)
+	overall, err := service.GetOverallQualityScore(context.Background(), startDate, endDate)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	breakdown, err := service.GetOverallQualityScoreBreakdown(context.Background(), startDate, endDate)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if breakdown.OverallScore != overall.Score {
+		t.Errorf("Expected overall score %s, got %s", overall.Score, breakdown.OverallScore)
+	}
+	var sum float64
+	for _, contribution := range breakdown.CategoryBreakdown {
+		sum += contribution.WeightedContribution
+	}
+	score, _ := parseScore(overall.Score)
+	if math.Abs(sum*100-score) > 0.5 {
+		t.Errorf("Expected contributions to sum to %.2f, got %.4f", score/100, sum)
+	}
+}
+
+func TestGetOverallQualityScoreBreakdown_RatingOnEndDate(t *testing.T) {
+	db := testutil.NewTestDB(t)
+	startDate := time.Date(2019, 10, 1, 0, 0, 0, 0, time.UTC)
+	endDate := startDate.AddDate(0, 0, 6)
+
+	// The Grammar rating is on the last day, which the category analytics include
+	testutil.SeedTestData(t, db, []models.Rating{
+		{ID: 1, Rating: 5, TicketID: 1, RatingCategoryID: 1, CreatedAt: startDate.Add(time.Hour)},
+		{ID: 2, Rating: 1, TicketID: 1, RatingCategoryID: 2, CreatedAt: endDate.Add(12 * time.Hour)},
+	}, []models.RatingCategory{{ID: 1, Name: "Spelling", Weight: 1}, {ID: 2, Name: "Grammar", Weight: 0.7}})
+
+	ratingsRepo := repository.NewRatingsRepository(db)
+	categoryRepo := repository.NewRatingCategoryRepository(db)
+	analytics := NewRatingAnalyticsService(categoryRepo, ratingsRepo, NewTicketScoreService())
+	service, err := NewOverallQualityService(ratingsRepo, categoryRepo, analytics)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	breakdown, err := service.GetOverallQualityScoreBreakdown(context.Background(), startDate, endDate)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	// (1*5 + 0.7*1) / (5 * 1.7)
+	if breakdown.OverallScore != "67%" {
+		t.Errorf("Expected overall score 67%%, got %s", breakdown.OverallScore)
+	}
+	var sum float64
+	for _, contribution := range breakdown.CategoryBreakdown {
+		sum += contribution.WeightedContribution
+	}
+	score, _ := parseScore(breakdown.OverallScore)
+	if math.Abs(sum*100-score) > 0.5 {
+		t.Errorf("Expected contributions to sum to %.2f, got %.4f", score/100, sum)
+	}
+}
@@ -0,0 +1,62 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"ticket-score-service/internal/models"
+	"ticket-score-service/internal/utils"
+)
+
+// QualityHistory holds the overall quality score of each day, month or week of a period
+type QualityHistory struct {
+	Period string       `json:"period"`
+	Dates  []DailyScore `json:"dates"`
+}
+
+// GetOverallQualityScoreHistory calculates the overall quality score, across all categories, of each
+// day in a date range. Ranges longer than the aggregation threshold, see
+// WithOverallQualityAggregationThresholdDays, are scored by month up to 90 days and by week beyond
+// that, labelled like the category analytics. Periods without ratings get "N/A".
+func (s *OverallQualityService) GetOverallQualityScoreHistory(ctx context.Context, startDate, endDate time.Time) (*QualityHistory, error) {
+	categories, err := s.categoryRepo.GetAll(ctx)
+	if err != nil {
+		return nil, logError(ctx, fmt.Errorf("failed to get categories: %w", err))
+	}
+
+	var ratings []models.Rating
+	for _, category := range categories {
+		categoryRatings, err := s.ratingsRepo.GetByCategoryIDAndDateRange(ctx, category.ID, startDate, endDate)
+		if err != nil {
+			return nil, logError(ctx, fmt.Errorf("failed to get ratings for category %d: %w", category.ID, err))
+		}
+		ratings = append(ratings, categoryRatings...)
+	}
+	ratingsByDate := groupRatingsByDate(ratings, startDate.Location())
+
+	// periodScore scores the ratings of the days from one date to another, inclusive
+	periodScore := func(label string, from, to time.Time) DailyScore {
+		var periodRatings []models.Rating
+		for day := from; !day.After(to); day = day.AddDate(0, 0, 1) {
+			periodRatings = append(periodRatings, ratingsByDate[day.Format("2006-01-02")]...)
+		}
+
+		weightedSum, maxSum := s.calculateChunkWeightedScore(periodRatings, categories)
+		if maxSum == 0 {
+			return DailyScore{Date: label, Score: "N/A"}
+		}
+		return DailyScore{Date: label, Score: utils.FormatScore(weightedSum / maxSum * 100)}
+	}
+
+	history := &QualityHistory{
+		Period: utils.FormatDateRange(startDate, endDate),
+	}
+
+	mode := aggregationFor(startDate, endDate, s.aggregationThresholdDays)
+	for _, period := range aggregationPeriods(mode, startDate, endDate) {
+		history.Dates = append(history.Dates, periodScore(period.label, period.start, period.end))
+	}
+
+	return history, nil
+}
@@ -0,0 +1,132 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"ticket-score-service/internal/mocks"
+	"ticket-score-service/internal/models"
+)
+
+func TestGetOverallQualityScoreHistory(t *testing.T) {
+	categories := []models.RatingCategory{
+		{ID: 1, Name: "Spelling", Weight: 2},
+		{ID: 2, Name: "Grammar", Weight: 1},
+	}
+	// 2019-10-01 is a Tuesday
+	day := time.Date(2019, 10, 1, 0, 0, 0, 0, time.UTC)
+	ratings := map[string][]models.Rating{
+		"all": {
+			// (5*2 + 2*1) / (5*2 + 5*1) = 80%
+			{ID: 1, RatingCategoryID: 1, Rating: 5, CreatedAt: day.Add(9 * time.Hour)},
+			{ID: 2, RatingCategoryID: 2, Rating: 2, CreatedAt: day.Add(10 * time.Hour)},
+			// 3 out of 5 = 60%
+			{ID: 3, RatingCategoryID: 2, Rating: 3, CreatedAt: day.AddDate(0, 0, 2).Add(time.Hour)},
+			// (1*2) / (5*2) = 20%
+			{ID: 4, RatingCategoryID: 1, Rating: 1, CreatedAt: day.AddDate(0, 0, 6).Add(23 * time.Hour)},
+			// Only in the longer ranges
+			{ID: 5, RatingCategoryID: 1, Rating: 4, CreatedAt: day.AddDate(0, 0, 40)},
+		},
+	}
+
+	tests := []struct {
+		name           string
+		endDate        time.Time
+		opts           []OverallQualityOption
+		expectedPeriod string
+		expectedDates  []DailyScore
+	}{
+		{
+			name:           "7 days are scored by day",
+			endDate:        day.AddDate(0, 0, 6),
+			expectedPeriod: "2019-10-01 to 2019-10-07",
+			expectedDates: []DailyScore{
+				{Date: "2019-10-01", Score: "80%"},
+				{Date: "2019-10-02", Score: "N/A"},
+				{Date: "2019-10-03", Score: "60%"},
+				{Date: "2019-10-04", Score: "N/A"},
+				{Date: "2019-10-05", Score: "N/A"},
+				{Date: "2019-10-06", Score: "N/A"},
+				{Date: "2019-10-07", Score: "20%"},
+			},
+		},
+		{
+			name:           "7 days over the configured threshold are scored by month",
+			endDate:        day.AddDate(0, 0, 6),
+			opts:           []OverallQualityOption{WithOverallQualityAggregationThresholdDays(5)},
+			expectedPeriod: "2019-10-01 to 2019-10-07",
+			expectedDates: []DailyScore{
+				{Date: "2019-10", Score: "57%"},
+			},
+		},
+		{
+			name:           "45 days are scored by month",
+			endDate:        day.AddDate(0, 0, 44),
+			expectedPeriod: "2019-10-01 to 2019-11-14",
+			expectedDates: []DailyScore{
+				// (5*2 + 2*1 + 3*1 + 1*2) / (5*2 + 5*1 + 5*1 + 5*2) = 57%
+				{Date: "2019-10", Score: "57%"},
+				{Date: "2019-11", Score: "80%"},
+			},
+		},
+		{
+			name:           "100 days are scored by week",
+			endDate:        day.AddDate(0, 0, 99),
+			expectedPeriod: "2019-10-01 to 2020-01-08",
+			expectedDates: []DailyScore{
+				// (5*2 + 2*1 + 3*1) / (5*2 + 5*1 + 5*1) = 75%
+				{Date: "2019-09-30 to 2019-10-06", Score: "75%"},
+				{Date: "2019-10-07 to 2019-10-13", Score: "20%"},
+				{Date: "2019-10-14 to 2019-10-20", Score: "N/A"},
+				{Date: "2019-10-21 to 2019-10-27", Score: "N/A"},
+				{Date: "2019-10-28 to 2019-11-03", Score: "N/A"},
+				{Date: "2019-11-04 to 2019-11-10", Score: "80%"},
+				{Date: "2019-11-11 to 2019-11-17", Score: "N/A"},
+				{Date: "2019-11-18 to 2019-11-24", Score: "N/A"},
+				{Date: "2019-11-25 to 2019-12-01", Score: "N/A"},
+				{Date: "2019-12-02 to 2019-12-08", Score: "N/A"},
+				{Date: "2019-12-09 to 2019-12-15", Score: "N/A"},
+				{Date: "2019-12-16 to 2019-12-22", Score: "N/A"},
+				{Date: "2019-12-23 to 2019-12-29", Score: "N/A"},
+				{Date: "2019-12-30 to 2020-01-05", Score: "N/A"},
+				{Date: "2020-01-06 to 2020-01-08", Score: "N/A"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, err := NewOverallQualityService(&mocks.MockRatingsRepo{Ratings: ratings}, &mockCategoryRepo{categories: categories}, nil, tt.opts...)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			history, err := service.GetOverallQualityScoreHistory(context.Background(), day, tt.endDate)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if history.Period != tt.expectedPeriod {
+				t.Errorf("Expected period %s, got %s", tt.expectedPeriod, history.Period)
+			}
+			if !reflect.DeepEqual(history.Dates, tt.expectedDates) {
+				t.Errorf("Expected %+v, got %+v", tt.expectedDates, history.Dates)
+			}
+		})
+	}
+
+	t.Run("repository error", func(t *testing.T) {
+		repoErr := errors.New("database error")
+		service, err := NewOverallQualityService(&mocks.MockRatingsRepo{Err: repoErr}, &mockCategoryRepo{categories: categories}, nil)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		if _, err := service.GetOverallQualityScoreHistory(context.Background(), day, day); !errors.Is(err, repoErr) {
+			t.Errorf("Expected %v, got %v", repoErr, err)
+		}
+	})
+}
@@ -6,6 +6,11 @@ import (
 	"testing"
 	"time"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"ticket-score-service/internal/apperror"
+	"ticket-score-service/internal/config"
 	"ticket-score-service/internal/mocks"
 	"ticket-score-service/internal/models"
 )
@@ -149,7 +154,10 @@ func TestGetOverallQualityScore(t *testing.T) {
 			}
 
 			// Create service
-			service := NewOverallQualityService(mockRatingsRepo, mockCategoryRepo)
+			service, err := NewOverallQualityService(mockRatingsRepo, mockCategoryRepo, nil)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
 
 			// Execute
 			ctx := context.Background()
@@ -184,6 +192,41 @@ func TestGetOverallQualityScore(t *testing.T) {
 	}
 }
 
+func TestNewOverallQualityService(t *testing.T) {
+	tests := []struct {
+		name        string
+		opts        []OverallQualityOption
+		expectError bool
+	}{
+		{name: "defaults"},
+		{name: "custom chunk size and goroutine limit", opts: []OverallQualityOption{WithChunkSize(2), WithMaxGoroutines(1)}},
+		{name: "zero chunk size", opts: []OverallQualityOption{WithChunkSize(0)}, expectError: true},
+		{name: "negative chunk size", opts: []OverallQualityOption{WithChunkSize(-1)}, expectError: true},
+		{name: "zero goroutine limit", opts: []OverallQualityOption{WithMaxGoroutines(0)}, expectError: true},
+		{name: "custom max rating", opts: []OverallQualityOption{WithOverallQualityMaxRating(10)}},
+		{name: "zero max rating", opts: []OverallQualityOption{WithOverallQualityMaxRating(0)}, expectError: true},
+		{name: "negative max rating", opts: []OverallQualityOption{WithOverallQualityMaxRating(-1)}, expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, err := NewOverallQualityService(&mocks.MockRatingsRepo{}, &mockCategoryRepo{}, nil, tt.opts...)
+			if tt.expectError {
+				if err == nil {
+					t.Error("Expected error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if service == nil {
+				t.Fatal("Expected a service")
+			}
+		})
+	}
+}
+
 func TestProcessChunksConcurrently(t *testing.T) {
 	categories := []models.RatingCategory{
 		{ID: 1, Name: "Spelling", Weight: 10.0},
@@ -194,6 +237,7 @@ func TestProcessChunksConcurrently(t *testing.T) {
 		name             string
 		totalCount       int
 		paginatedRatings map[string][]models.Rating
+		opts             []OverallQualityOption
 		expectedScore    float64
 		paginationErr    error
 		expectError      bool
@@ -244,6 +288,22 @@ func TestProcessChunksConcurrently(t *testing.T) {
 			expectedScore:    0.0,
 			expectError:      false,
 		},
+		{
+			name:       "custom chunk size and goroutine limit",
+			totalCount: 3,
+			paginatedRatings: map[string][]models.Rating{
+				"2:0": {
+					{ID: 1, RatingCategoryID: 1, Rating: 4},
+					{ID: 2, RatingCategoryID: 1, Rating: 5},
+				},
+				"1:2": {
+					{ID: 3, RatingCategoryID: 1, Rating: 3},
+				},
+			},
+			opts:          []OverallQualityOption{WithChunkSize(2), WithMaxGoroutines(1)},
+			expectedScore: 80.0, // (40+50+30)/(50+50+50)*100
+			expectError:   false,
+		},
 	}
 
 	for _, tt := range tests {
@@ -258,14 +318,17 @@ func TestProcessChunksConcurrently(t *testing.T) {
 				categories: categories,
 			}
 
-			service := NewOverallQualityService(mockRatingsRepo, mockCategoryRepo)
+			service, err := NewOverallQualityService(mockRatingsRepo, mockCategoryRepo, nil, tt.opts...)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
 
 			ctx := context.Background()
 			startDate := time.Date(2019, 10, 1, 0, 0, 0, 0, time.UTC)
 			endDate := time.Date(2019, 10, 7, 0, 0, 0, 0, time.UTC)
 
 			score, err := service.processChunksConcurrently(
-				ctx, startDate, endDate, tt.totalCount, categories)
+				ctx, startDate, endDate, tt.totalCount, service.currentChunkLimits(), categories, nil)
 
 			if tt.expectError {
 				if err == nil {
@@ -296,6 +359,7 @@ func TestCalculateChunkWeightedScore(t *testing.T) {
 	tests := []struct {
 		name                string
 		ratings             []models.Rating
+		maxRating           int
 		expectedWeightedSum float64
 		expectedMaxSum      float64
 	}{
@@ -332,6 +396,16 @@ func TestCalculateChunkWeightedScore(t *testing.T) {
 			expectedWeightedSum: 0.0, // 5 * 0 = 0
 			expectedMaxSum:      0.0, // 5 * 0 = 0
 		},
+		{
+			name: "custom max rating",
+			ratings: []models.Rating{
+				{ID: 1, RatingCategoryID: 1, Rating: 8}, // 8 * 10 = 80
+				{ID: 2, RatingCategoryID: 2, Rating: 6}, // 6 * 5 = 30
+			},
+			maxRating:           10,
+			expectedWeightedSum: 110.0, // 80 + 30
+			expectedMaxSum:      150.0, // (10*10) + (10*5)
+		},
 	}
 
 	for _, tt := range tests {
@@ -339,7 +413,14 @@ func TestCalculateChunkWeightedScore(t *testing.T) {
 			mockRatingsRepo := &mocks.MockRatingsRepo{}
 			mockCategoryRepo := &mockCategoryRepo{categories: categories}
 
-			service := NewOverallQualityService(mockRatingsRepo, mockCategoryRepo)
+			var opts []OverallQualityOption
+			if tt.maxRating > 0 {
+				opts = append(opts, WithOverallQualityMaxRating(tt.maxRating))
+			}
+			service, err := NewOverallQualityService(mockRatingsRepo, mockCategoryRepo, nil, opts...)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
 
 			weightedSum, maxSum := service.calculateChunkWeightedScore(tt.ratings, categories)
 
@@ -366,3 +447,538 @@ func generateRatings(startID, count, categoryID, rating int) []models.Rating {
 	}
 	return ratings
 }
+
+func TestGetOverallQualityScoreStream(t *testing.T) {
+	startDate := time.Date(2019, 10, 1, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2019, 10, 7, 0, 0, 0, 0, time.UTC)
+
+	categories := []models.RatingCategory{
+		{ID: 1, Name: "Spelling", Weight: 10.0},
+		{ID: 2, Name: "Grammar", Weight: 5.0},
+	}
+
+	t.Run("partial score converges to final score", func(t *testing.T) {
+		mockRatingsRepo := &mocks.MockRatingsRepo{
+			Ratings: map[string][]models.Rating{
+				"2:0": {
+					{ID: 1, RatingCategoryID: 1, Rating: 5},
+					{ID: 2, RatingCategoryID: 2, Rating: 5},
+				},
+				"2:2": {
+					{ID: 3, RatingCategoryID: 1, Rating: 2},
+					{ID: 4, RatingCategoryID: 2, Rating: 3},
+				},
+				"2:4": {
+					{ID: 5, RatingCategoryID: 1, Rating: 4},
+					{ID: 6, RatingCategoryID: 2, Rating: 1},
+				},
+			},
+			Count: 6,
+		}
+		mockCategoryRepo := &mockCategoryRepo{categories: categories}
+
+		service, err := NewOverallQualityService(mockRatingsRepo, mockCategoryRepo, nil, WithChunkSize(2))
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		progressChan, errorChan := service.GetOverallQualityScoreStream(context.Background(), startDate, endDate)
+
+		var updates []QualityProgress
+		for update := range progressChan {
+			updates = append(updates, update)
+		}
+		if err := <-errorChan; err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		if len(updates) != 4 {
+			t.Fatalf("Expected 3 progress updates and 1 final update, got %d", len(updates))
+		}
+
+		for i, update := range updates[:3] {
+			if update.IsFinal {
+				t.Errorf("Update %d should not be final", i)
+			}
+			if update.ChunksDone != i+1 {
+				t.Errorf("Expected %d chunks done, got %d", i+1, update.ChunksDone)
+			}
+			if update.TotalChunks != 3 {
+				t.Errorf("Expected 3 total chunks, got %d", update.TotalChunks)
+			}
+		}
+
+		final := updates[3]
+		if !final.IsFinal {
+			t.Fatal("Expected last update to be final")
+		}
+
+		// (75 + 35 + 45) / (3 chunks * 75 max) = 155/225 = 68.9%
+		if final.Score != "69%" {
+			t.Errorf("Expected final score 69%%, got %s", final.Score)
+		}
+		if final.Period != "2019-10-01 to 2019-10-07" {
+			t.Errorf("Expected period 2019-10-01 to 2019-10-07, got %s", final.Period)
+		}
+		if updates[2].PartialScore != final.Score {
+			t.Errorf("Expected partial score after all chunks %s to equal final score %s", updates[2].PartialScore, final.Score)
+		}
+
+		// Final score must match the unary calculation
+		result, err := service.GetOverallQualityScore(context.Background(), startDate, endDate)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if result.Score != final.Score {
+			t.Errorf("Expected streamed score %s to equal unary score %s", final.Score, result.Score)
+		}
+	})
+
+	t.Run("no ratings in period", func(t *testing.T) {
+		mockRatingsRepo := &mocks.MockRatingsRepo{Count: 0}
+		mockCategoryRepo := &mockCategoryRepo{categories: categories}
+
+		service, err := NewOverallQualityService(mockRatingsRepo, mockCategoryRepo, nil)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		progressChan, errorChan := service.GetOverallQualityScoreStream(context.Background(), startDate, endDate)
+
+		var updates []QualityProgress
+		for update := range progressChan {
+			updates = append(updates, update)
+		}
+		if err := <-errorChan; err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		if len(updates) != 1 || !updates[0].IsFinal || updates[0].Score != "N/A" {
+			t.Errorf("Expected a single final N/A update, got %+v", updates)
+		}
+	})
+
+	t.Run("error counting ratings", func(t *testing.T) {
+		mockRatingsRepo := &mocks.MockRatingsRepo{CountErr: errors.New("database connection failed")}
+		mockCategoryRepo := &mockCategoryRepo{categories: categories}
+
+		service, err := NewOverallQualityService(mockRatingsRepo, mockCategoryRepo, nil)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		progressChan, errorChan := service.GetOverallQualityScoreStream(context.Background(), startDate, endDate)
+		for range progressChan {
+		}
+
+		if err := <-errorChan; err == nil {
+			t.Error("Expected error but got none")
+		}
+	})
+}
+
+func TestPartialChunkFailure(t *testing.T) {
+	startDate := time.Date(2019, 10, 1, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2019, 10, 7, 0, 0, 0, 0, time.UTC)
+
+	categories := []models.RatingCategory{
+		{ID: 1, Name: "Spelling", Weight: 1.0},
+	}
+
+	// Three chunks of two ratings, the last one fails
+	newRepo := func() *mocks.MockRatingsRepo {
+		return &mocks.MockRatingsRepo{
+			Ratings: map[string][]models.Rating{
+				"2:0": generateRatings(1, 2, 1, 5),
+				"2:2": generateRatings(3, 2, 1, 3),
+			},
+			PaginationErrs: map[string]error{
+				"2:4": errors.New("chunk query failed"),
+			},
+			Count: 6,
+		}
+	}
+
+	t.Run("aggregateChunkResults returns ErrPartialResult", func(t *testing.T) {
+		service, err := NewOverallQualityService(newRepo(), &mockCategoryRepo{categories: categories}, nil, WithChunkSize(2))
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		_, err = service.processChunksConcurrently(context.Background(), startDate, endDate, 6, service.currentChunkLimits(), categories, nil)
+
+		var partial *ErrPartialResult
+		if !errors.As(err, &partial) {
+			t.Fatalf("Expected ErrPartialResult, got %v", err)
+		}
+		if partial.SuccessfulChunks != 2 {
+			t.Errorf("Expected 2 successful chunks, got %d", partial.SuccessfulChunks)
+		}
+		if partial.TotalChunks != 3 {
+			t.Errorf("Expected 3 total chunks, got %d", partial.TotalChunks)
+		}
+		if partial.PartialScore != 80.0 { // (5+5+3+3) / (4*5) * 100
+			t.Errorf("Expected partial score 80, got %f", partial.PartialScore)
+		}
+		if len(partial.Errors) != 1 {
+			t.Errorf("Expected 1 chunk error, got %d", len(partial.Errors))
+		}
+	})
+
+	t.Run("GetOverallQualityScore returns approximate score", func(t *testing.T) {
+		service, err := NewOverallQualityService(newRepo(), &mockCategoryRepo{categories: categories}, nil, WithChunkSize(2))
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		result, err := service.GetOverallQualityScore(context.Background(), startDate, endDate)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if result.Score != "~80%" {
+			t.Errorf("Expected score ~80%%, got %s", result.Score)
+		}
+	})
+
+	t.Run("GetOverallQualityScoreStream final update is approximate", func(t *testing.T) {
+		service, err := NewOverallQualityService(newRepo(), &mockCategoryRepo{categories: categories}, nil, WithChunkSize(2))
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		progressChan, errorChan := service.GetOverallQualityScoreStream(context.Background(), startDate, endDate)
+
+		var final QualityProgress
+		for update := range progressChan {
+			final = update
+		}
+		if err := <-errorChan; err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		if !final.IsFinal || final.Score != "~80%" {
+			t.Errorf("Expected final score ~80%%, got %+v", final)
+		}
+	})
+
+	t.Run("canceled request is not approximate", func(t *testing.T) {
+		service, err := NewOverallQualityService(newRepo(), &mockCategoryRepo{categories: categories}, nil, WithChunkSize(2))
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		result, err := service.GetOverallQualityScore(ctx, startDate, endDate)
+		if !errors.Is(err, context.Canceled) {
+			t.Fatalf("Expected context.Canceled, got %+v, %v", result, err)
+		}
+	})
+
+	t.Run("all chunks failing is a hard error", func(t *testing.T) {
+		mockRatingsRepo := &mocks.MockRatingsRepo{
+			PaginationErr: errors.New("pagination query failed"),
+			Count:         6,
+		}
+		service, err := NewOverallQualityService(mockRatingsRepo, &mockCategoryRepo{categories: categories}, nil, WithChunkSize(2))
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		_, err = service.GetOverallQualityScore(context.Background(), startDate, endDate)
+		if err == nil {
+			t.Fatal("Expected error but got none")
+		}
+
+		var partial *ErrPartialResult
+		if errors.As(err, &partial) {
+			t.Error("Expected hard error, got ErrPartialResult")
+		}
+	})
+
+	t.Run("chunk errors keep their gRPC status", func(t *testing.T) {
+		canceled := status.Error(codes.Canceled, "request canceled")
+
+		hardFailure := &mocks.MockRatingsRepo{PaginationErr: canceled, Count: 6}
+		partialFailure := newRepo()
+		partialFailure.PaginationErrs["2:4"] = canceled
+
+		for _, repo := range []*mocks.MockRatingsRepo{hardFailure, partialFailure} {
+			service, err := NewOverallQualityService(repo, &mockCategoryRepo{categories: categories}, nil, WithChunkSize(2))
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			_, err = service.processChunksConcurrently(context.Background(), startDate, endDate, 6, service.currentChunkLimits(), categories, nil)
+			st, ok := apperror.UnwrapGRPCStatus(err)
+			if !ok || st.Code() != codes.Canceled {
+				t.Errorf("Expected a wrapped Canceled status, got %v", err)
+			}
+		}
+	})
+}
+
+// importingRatingsRepo calls afterRead after each page of ratings is read, to interleave an import
+// with a calculation
+type importingRatingsRepo struct {
+	*mocks.MockRatingsRepo
+	afterRead func()
+}
+
+func (r *importingRatingsRepo) GetByDateRangePaginated(ctx context.Context, startDate, endDate time.Time, limit, offset int) ([]models.Rating, error) {
+	ratings, err := r.MockRatingsRepo.GetByDateRangePaginated(ctx, startDate, endDate, limit, offset)
+	if r.afterRead != nil {
+		r.afterRead()
+	}
+	return ratings, err
+}
+
+func TestGetOverallQualityScore_ResultCache(t *testing.T) {
+	categories := []models.RatingCategory{
+		{ID: 1, Name: "Spelling", Weight: 1.0},
+	}
+	now := time.Now()
+
+	tests := []struct {
+		name          string
+		startDate     time.Time
+		endDate       time.Time
+		expectedFirst string
+		expectedAgain string
+	}{
+		{
+			name:          "past period hits the cache",
+			startDate:     now.AddDate(0, 0, -30),
+			endDate:       now.AddDate(0, 0, -10),
+			expectedFirst: "100%",
+			expectedAgain: "100%",
+		},
+		{
+			name:          "current period is never cached",
+			startDate:     now.AddDate(0, 0, -7),
+			endDate:       now,
+			expectedFirst: "100%",
+			expectedAgain: "60%",
+		},
+		{
+			name:          "period inside the stale window is not cached",
+			startDate:     now.AddDate(0, 0, -30),
+			endDate:       now.AddDate(0, 0, -6),
+			expectedFirst: "100%",
+			expectedAgain: "60%",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRatingsRepo := &mocks.MockRatingsRepo{
+				Ratings: map[string][]models.Rating{"2:0": generateRatings(1, 2, 1, 5)},
+				Count:   2,
+			}
+			service, err := NewOverallQualityService(mockRatingsRepo, &mockCategoryRepo{categories: categories}, nil, WithCacheStaleDays(7))
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			result, err := service.GetOverallQualityScore(context.Background(), tt.startDate, tt.endDate)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if result.Score != tt.expectedFirst {
+				t.Errorf("Expected first score %s, got %s", tt.expectedFirst, result.Score)
+			}
+
+			// New ratings only show up when the score is recalculated
+			mockRatingsRepo.Ratings = map[string][]models.Rating{"2:0": generateRatings(1, 2, 1, 3)}
+
+			result, err = service.GetOverallQualityScore(context.Background(), tt.startDate, tt.endDate)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if result.Score != tt.expectedAgain {
+				t.Errorf("Expected second score %s, got %s", tt.expectedAgain, result.Score)
+			}
+		})
+	}
+
+	t.Run("InvalidateCache forces recalculation", func(t *testing.T) {
+		startDate, endDate := now.AddDate(0, 0, -30), now.AddDate(0, 0, -10)
+		mockRatingsRepo := &mocks.MockRatingsRepo{
+			Ratings: map[string][]models.Rating{"2:0": generateRatings(1, 2, 1, 5)},
+			Count:   2,
+		}
+		service, err := NewOverallQualityService(mockRatingsRepo, &mockCategoryRepo{categories: categories}, nil, WithCacheStaleDays(7))
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		if _, err := service.GetOverallQualityScore(context.Background(), startDate, endDate); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		mockRatingsRepo.Ratings = map[string][]models.Rating{"2:0": generateRatings(1, 2, 1, 3)}
+		service.InvalidateCache()
+
+		result, err := service.GetOverallQualityScore(context.Background(), startDate, endDate)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if result.Score != "60%" {
+			t.Errorf("Expected recalculated score 60%%, got %s", result.Score)
+		}
+	})
+
+	t.Run("scores calculated during an import are not cached", func(t *testing.T) {
+		startDate, endDate := now.AddDate(0, 0, -30), now.AddDate(0, 0, -10)
+		mockRatingsRepo := &mocks.MockRatingsRepo{
+			Ratings: map[string][]models.Rating{"2:0": generateRatings(1, 2, 1, 5)},
+			Count:   2,
+		}
+		repo := &importingRatingsRepo{MockRatingsRepo: mockRatingsRepo}
+		service, err := NewOverallQualityService(repo, &mockCategoryRepo{categories: categories}, nil, WithCacheStaleDays(7))
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		// The import commits after the ratings were read but before the score is cached
+		repo.afterRead = func() {
+			mockRatingsRepo.Ratings = map[string][]models.Rating{"2:0": generateRatings(1, 2, 1, 3)}
+			service.InvalidateCache()
+		}
+		result, err := service.GetOverallQualityScore(context.Background(), startDate, endDate)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if result.Score != "100%" {
+			t.Errorf("Expected score 100%% from before the import, got %s", result.Score)
+		}
+
+		repo.afterRead = nil
+		result, err = service.GetOverallQualityScore(context.Background(), startDate, endDate)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if result.Score != "60%" {
+			t.Errorf("Expected recalculated score 60%%, got %s", result.Score)
+		}
+	})
+
+	t.Run("least recently used score is evicted", func(t *testing.T) {
+		periods := [][2]time.Time{
+			{now.AddDate(0, 0, -30), now.AddDate(0, 0, -10)},
+			{now.AddDate(0, 0, -40), now.AddDate(0, 0, -10)},
+			{now.AddDate(0, 0, -50), now.AddDate(0, 0, -10)},
+		}
+		mockRatingsRepo := &mocks.MockRatingsRepo{
+			Ratings: map[string][]models.Rating{"2:0": generateRatings(1, 2, 1, 5)},
+			Count:   2,
+		}
+		service, err := NewOverallQualityService(mockRatingsRepo, &mockCategoryRepo{categories: categories}, nil, WithCacheStaleDays(7))
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		service.resultCache = newScoreCache(2)
+
+		score := func(period [2]time.Time) string {
+			t.Helper()
+			result, err := service.GetOverallQualityScore(context.Background(), period[0], period[1])
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			return result.Score
+		}
+
+		score(periods[0])
+		score(periods[1])
+		score(periods[0]) // Periods[1] is now the least recently used
+		score(periods[2])
+
+		mockRatingsRepo.Ratings = map[string][]models.Rating{"2:0": generateRatings(1, 2, 1, 3)}
+		// Periods[1] last, since recalculating it evicts another period
+		for _, check := range []struct {
+			period   int
+			expected string
+		}{{0, "100%"}, {2, "100%"}, {1, "60%"}} {
+			if got := score(periods[check.period]); got != check.expected {
+				t.Errorf("Period %d: expected %s, got %s", check.period, check.expected, got)
+			}
+		}
+	})
+
+	t.Run("partial results are not cached", func(t *testing.T) {
+		startDate, endDate := now.AddDate(0, 0, -30), now.AddDate(0, 0, -10)
+		mockRatingsRepo := &mocks.MockRatingsRepo{
+			Ratings:        map[string][]models.Rating{"2:0": generateRatings(1, 2, 1, 5)},
+			PaginationErrs: map[string]error{"2:2": errors.New("chunk query failed")},
+			Count:          4,
+		}
+		service, err := NewOverallQualityService(mockRatingsRepo, &mockCategoryRepo{categories: categories}, nil, WithCacheStaleDays(7), WithChunkSize(2))
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		result, err := service.GetOverallQualityScore(context.Background(), startDate, endDate)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if result.Score != "~100%" {
+			t.Fatalf("Expected approximate score ~100%%, got %s", result.Score)
+		}
+
+		mockRatingsRepo.Ratings["2:2"] = generateRatings(3, 2, 1, 0)
+		mockRatingsRepo.PaginationErrs = nil
+
+		result, err = service.GetOverallQualityScore(context.Background(), startDate, endDate)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if result.Score != "50%" {
+			t.Errorf("Expected recalculated score 50%%, got %s", result.Score)
+		}
+	})
+}
+
+// staticConfigSource serves a configuration that tests can replace between calls
+type staticConfigSource struct {
+	cfg *config.Config
+}
+
+func (s *staticConfigSource) Get() *config.Config {
+	return s.cfg
+}
+
+func TestGetOverallQualityScore_ConfigSource(t *testing.T) {
+	startDate := time.Date(2019, 10, 1, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2019, 10, 7, 0, 0, 0, 0, time.UTC)
+	categories := []models.RatingCategory{{ID: 1, Name: "Spelling", Weight: 1.0}}
+
+	// Pages of two ratings score 100%, the single page of four fails
+	mockRatingsRepo := &mocks.MockRatingsRepo{
+		Ratings: map[string][]models.Rating{
+			"2:0": generateRatings(1, 2, 1, 5),
+			"2:2": generateRatings(3, 2, 1, 5),
+		},
+		PaginationErrs: map[string]error{"4:0": errors.New("page too large")},
+		Count:          4,
+	}
+	source := &staticConfigSource{cfg: &config.Config{ChunkSize: 2, MaxGoroutines: 1}}
+
+	service, err := NewOverallQualityService(mockRatingsRepo, &mockCategoryRepo{categories: categories}, nil, WithConfigSource(source))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	result, err := service.GetOverallQualityScore(context.Background(), startDate, endDate)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if result.Score != "100%" {
+		t.Errorf("Expected score 100%% with chunks of 2, got %s", result.Score)
+	}
+
+	// A changed configuration applies to the next calculation
+	source.cfg = &config.Config{ChunkSize: 4, MaxGoroutines: 1}
+	if _, err := service.GetOverallQualityScore(context.Background(), startDate, endDate); err == nil {
+		t.Error("Expected error with chunks of 4 but got none")
+	}
+}
@@ -3,18 +3,20 @@ package service
 import (
 	"context"
 	"fmt"
+	"math"
 	"strconv"
 	"strings"
 	"time"
 )
 
-// PeriodComparisonResult represents the result of comparing two periods
+// PeriodComparisonResult represents the result of comparing an earlier period with a later one
 type PeriodComparisonResult struct {
-	StartPeriod string `json:"start_period"`
-	StartScore  string `json:"start_score"`
-	EndPeriod   string `json:"end_period"`
-	EndScore    string `json:"end_score"`
-	Difference  string `json:"difference"`
+	PreviousPeriod     string `json:"previous_period"`
+	PreviousScore      string `json:"previous_score"`
+	CurrentPeriod      string `json:"current_period"`
+	CurrentScore       string `json:"current_score"`
+	Difference         string `json:"difference"`
+	AbsoluteDifference string `json:"absolute_difference"`
 }
 
 // PeriodComparisonService handles period over period comparisons
@@ -29,7 +31,8 @@ func NewPeriodComparisonService(overallQualityService *OverallQualityService) *P
 	}
 }
 
-// GetPeriodComparison compares overall quality scores between two time periods
+// GetPeriodComparison compares overall quality scores between two time periods, where the first
+// period is the earlier one
 func (s *PeriodComparisonService) GetPeriodComparison(
 	ctx context.Context,
 	firstStartDate, firstEndDate, secondStartDate, secondEndDate time.Time,
@@ -50,14 +53,40 @@ func (s *PeriodComparisonService) GetPeriodComparison(
 	difference := s.calculateDifference(firstPeriodScore.Score, secondPeriodScore.Score)
 
 	return &PeriodComparisonResult{
-		StartPeriod: secondPeriodScore.Period, // Most recent period (second)
-		StartScore:  secondPeriodScore.Score,  // Most recent score (second)
-		EndPeriod:   firstPeriodScore.Period,  // Older period (first)
-		EndScore:    firstPeriodScore.Score,   // Older score (first)
-		Difference:  difference,
+		PreviousPeriod:     firstPeriodScore.Period,
+		PreviousScore:      firstPeriodScore.Score,
+		CurrentPeriod:      secondPeriodScore.Period,
+		CurrentScore:       secondPeriodScore.Score,
+		Difference:         difference,
+		AbsoluteDifference: calculateAbsoluteDifference(firstPeriodScore.Score, secondPeriodScore.Score),
 	}, nil
 }
 
+// calculateAbsoluteDifference returns the change from firstScore to secondScore in percentage
+// points with a sign, e.g. "+5pp" or "-3pp". Unparseable scores, including "N/A", give "N/A".
+func calculateAbsoluteDifference(firstScore, secondScore string) string {
+	value1, ok := parseScore(firstScore)
+	if !ok {
+		return "N/A"
+	}
+	value2, ok := parseScore(secondScore)
+	if !ok {
+		return "N/A"
+	}
+
+	// Scores have at most 2 decimal places, so rounding only drops floating point noise
+	change := math.Round((value2-value1)*100) / 100
+	if change == 0 {
+		return "0pp"
+	}
+
+	difference := strconv.FormatFloat(change, 'f', -1, 64)
+	if change > 0 {
+		difference = "+" + difference
+	}
+	return difference + "pp"
+}
+
 // Calculates relative percentage change
 // Returns the relative change as a formatted string with proper sign
 func (s *PeriodComparisonService) calculateDifference(firstScore, secondScore string) string {
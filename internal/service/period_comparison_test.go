@@ -0,0 +1,71 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"ticket-score-service/internal/models"
+	"ticket-score-service/internal/repository"
+	"ticket-score-service/internal/testutil"
+)
+
+func TestGetPeriodComparison(t *testing.T) {
+	db := testutil.NewTestDB(t)
+	firstStart := time.Date(2019, 10, 1, 0, 0, 0, 0, time.UTC)
+	secondStart := firstStart.AddDate(0, 0, 7)
+
+	testutil.SeedTestData(t, db, []models.Rating{
+		{ID: 1, Rating: 4, TicketID: 1, RatingCategoryID: 1, CreatedAt: firstStart.Add(time.Hour)},  // 80%
+		{ID: 2, Rating: 5, TicketID: 2, RatingCategoryID: 1, CreatedAt: secondStart.Add(time.Hour)}, // 100%
+	}, []models.RatingCategory{{ID: 1, Name: "Spelling", Weight: 1}})
+
+	overallQuality, err := NewOverallQualityService(repository.NewRatingsRepository(db), repository.NewRatingCategoryRepository(db), nil)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	service := NewPeriodComparisonService(overallQuality)
+
+	result, err := service.GetPeriodComparison(context.Background(),
+		firstStart, firstStart.AddDate(0, 0, 6), secondStart, secondStart.AddDate(0, 0, 6))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expected := PeriodComparisonResult{
+		PreviousPeriod:     "2019-10-01 to 2019-10-07",
+		PreviousScore:      "80%",
+		CurrentPeriod:      "2019-10-08 to 2019-10-14",
+		CurrentScore:       "100%",
+		Difference:         "+25.0%",
+		AbsoluteDifference: "+20pp",
+	}
+	if *result != expected {
+		t.Errorf("Expected %+v, got %+v", expected, *result)
+	}
+}
+
+func TestCalculateAbsoluteDifference(t *testing.T) {
+	tests := []struct {
+		name        string
+		firstScore  string
+		secondScore string
+		expected    string
+	}{
+		{name: "increase", firstScore: "82%", secondScore: "89%", expected: "+7pp"},
+		{name: "decrease", firstScore: "90%", secondScore: "87%", expected: "-3pp"},
+		{name: "no change", firstScore: "85%", secondScore: "85%", expected: "0pp"},
+		{name: "from zero", firstScore: "0%", secondScore: "40%", expected: "+40pp"},
+		{name: "decimal scores", firstScore: "82.3%", secondScore: "89.5%", expected: "+7.2pp"},
+		{name: "first period without ratings", firstScore: "N/A", secondScore: "89%", expected: "N/A"},
+		{name: "second period without ratings", firstScore: "82%", secondScore: "N/A", expected: "N/A"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := calculateAbsoluteDifference(tt.firstScore, tt.secondScore); got != tt.expected {
+				t.Errorf("Expected %s, got %s", tt.expected, got)
+			}
+		})
+	}
+}
@@ -2,63 +2,267 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"sort"
+	"sync"
 	"time"
 
 	"ticket-score-service/internal/models"
+	"ticket-score-service/internal/repository"
 	"ticket-score-service/internal/utils"
 )
 
+// ErrCategoryNotFound is returned when a requested rating category does not exist. It is the error
+// of the category repository, so lookups by ID can return it as is.
+var ErrCategoryNotFound = repository.ErrCategoryNotFound
+
+// ErrInvalidRating is returned when a rating to import references a non-positive ID or has a value
+// out of range
+var ErrInvalidRating = errors.New("invalid rating")
+
 type DailyScore struct {
 	Date  string `json:"date"`
 	Score string `json:"score"`
 }
 
 type CategoryAnalytics struct {
-	Category string       `json:"category"`
-	Ratings  int          `json:"ratings"`
-	Dates    []DailyScore `json:"dates"`
-	Score    string       `json:"score"`
+	CategoryID  int          `json:"category_id"`
+	Category    string       `json:"category"`
+	Ratings     int          `json:"ratings"`
+	Dates       []DailyScore `json:"dates"`
+	Score       string       `json:"score"`
+	StdDev      string       `json:"std_dev"`
+	Aggregation string       `json:"aggregation"`
+}
+
+// AggregationMode is how the scores of a date range are bucketed in CategoryAnalytics.Dates
+type AggregationMode int
+
+const (
+	AggregationDaily   AggregationMode = iota // One score per day, dated "2006-01-02"
+	AggregationMonthly                        // One score per month, dated "2006-01"
+	AggregationWeekly                         // One score per week, dated "2006-01-02 to 2006-01-08"
+)
+
+// String returns the mode's name as reported in CategoryAnalytics.Aggregation
+func (m AggregationMode) String() string {
+	switch m {
+	case AggregationMonthly:
+		return "monthly"
+	case AggregationWeekly:
+		return "weekly"
+	default:
+		return "daily"
+	}
+}
+
+const (
+	// defaultAggregationThresholdDays is the longest date range scored by day
+	defaultAggregationThresholdDays = 30
+	// monthlyAggregationMaxDays is the longest date range scored by month; longer ranges are scored by week
+	monthlyAggregationMaxDays = 90
+)
+
+// ExtremeRatingCounts holds the number of ratings at or above and at or below a threshold
+type ExtremeRatingCounts struct {
+	CategoryID     int `json:"category_id"`
+	AboveThreshold int `json:"above_threshold"`
+	BelowThreshold int `json:"below_threshold"`
+	Threshold      int `json:"threshold"`
+}
+
+// ImprovementOpportunity describes how much a category's score could still improve
+type ImprovementOpportunity struct {
+	CategoryName         string  `json:"category_name"`
+	CurrentScore         string  `json:"current_score"`
+	ImprovementPotential float64 `json:"improvement_potential"`
+	WeightedPotential    float64 `json:"weighted_potential"`
+	Priority             int     `json:"priority"`
+}
+
+// CategoryHeatmap holds monthly scores per category for a year.
+// ScoreMatrix[categoryIndex][monthIndex] is the score of Categories[categoryIndex] in Months[monthIndex].
+type CategoryHeatmap struct {
+	Year        int        `json:"year"`
+	Categories  []string   `json:"categories"`
+	Months      []string   `json:"months"`
+	ScoreMatrix [][]string `json:"score_matrix"`
+}
+
+// ComparisonPoint holds two categories' scores for the same day
+type ComparisonPoint struct {
+	Date       string `json:"date"`
+	Score1     string `json:"score1"`
+	Score2     string `json:"score2"`
+	Difference string `json:"difference"`
+}
+
+// CategoryComparison holds the daily scores of two categories side by side
+type CategoryComparison struct {
+	Category1 string            `json:"category1"`
+	Category2 string            `json:"category2"`
+	Points    []ComparisonPoint `json:"points"`
 }
 
 type CategoryRepository interface {
 	GetAll(ctx context.Context) ([]models.RatingCategory, error)
+	GetByID(ctx context.Context, id int) (*models.RatingCategory, error)
+	GetAllPaginated(ctx context.Context, limit, offset int) ([]models.RatingCategory, error)
+	Count(ctx context.Context) (int, error)
 }
 
 type RatingsRepository interface {
 	GetByCategoryIDAndDate(ctx context.Context, categoryID int, date time.Time) ([]models.Rating, error)
+	GetByCategoryIDAndDateRange(ctx context.Context, categoryID int, startDate, endDate time.Time) ([]models.Rating, error)
+	GetByCategoryID(ctx context.Context, categoryID int) ([]models.Rating, error)
 	GetByDateRangePaginated(ctx context.Context, startDate, endDate time.Time, limit, offset int) ([]models.Rating, error)
 	CountByDateRange(ctx context.Context, startDate, endDate time.Time) (int, error)
 	GetDistinctTicketIDsByDateRange(ctx context.Context, startDate, endDate time.Time) ([]int, error)
+	BulkInsertRatings(ctx context.Context, ratings []models.Rating) error
+	GetDistinctReviewerIDsByDateRange(ctx context.Context, startDate, endDate time.Time) ([]int, error)
+	GetByTicketID(ctx context.Context, ticketID int) ([]models.Rating, error)
 	GetByTicketIDAndCategoryID(ctx context.Context, ticketID, categoryID int) ([]models.Rating, error)
+	GetRatingsByTicketIDsAndCategoryIDs(ctx context.Context, ticketIDs []int, categoryIDs []int) (map[int]map[int][]models.Rating, error)
+	GetByTicketIDBeforeTime(ctx context.Context, ticketID int, cutoff time.Time) ([]models.Rating, error)
+	GetByRevieweeIDAndCategoryID(ctx context.Context, revieweeID, categoryID int) ([]models.Rating, error)
+	GetByReviewerIDAndCategoryIDAndDateRange(ctx context.Context, reviewerID, categoryID int, startDate, endDate time.Time) ([]models.Rating, error)
+	GetByReviewerIDAndDateRange(ctx context.Context, reviewerID int, startDate, endDate time.Time) ([]models.Rating, error)
+	GetByRevieweeIDAndDateRange(ctx context.Context, revieweeID int, startDate, endDate time.Time) ([]models.Rating, error)
+	GetAverageScoreByReviewer(ctx context.Context, startDate, endDate time.Time) (map[int]float64, error)
+	GetAverageRatingByRevieweeAndCategory(ctx context.Context, startDate, endDate time.Time) (map[int]map[int]float64, error)
+	GetCountAboveThreshold(ctx context.Context, categoryID int, threshold int, startDate, endDate time.Time) (int, error)
+	GetCountBelowThreshold(ctx context.Context, categoryID int, threshold int, startDate, endDate time.Time) (int, error)
+	GetDistinctTicketCountByCategoryIDAndDateRange(ctx context.Context, categoryID int, startDate, endDate time.Time) (int, error)
+	GetAverageRatingPerTicket(ctx context.Context, startDate, endDate time.Time) (map[int]float64, error)
+	GetRatingCountPerTicket(ctx context.Context, startDate, endDate time.Time) (map[int]int, error)
+	GetRatingCountByReviewerAndDateRange(ctx context.Context, startDate, endDate time.Time) (map[int]int, error)
+	GetCountByCategoryIDAndDateRange(ctx context.Context, startDate, endDate time.Time) (map[string]int, error)
+	GetRatingsByHourOfDay(ctx context.Context, startDate, endDate time.Time) ([]models.HourlyRatings, error)
+	GetRatingCountByDayOfWeek(ctx context.Context, startDate, endDate time.Time) ([7]int, error)
+	GetRatingsByWeekdayAndCategoryID(ctx context.Context, categoryID int, startDate, endDate time.Time) ([]models.WeekdayRatings, error)
+	GetRatingsByHourAndWeekdayAndCategoryID(ctx context.Context, categoryID int, startDate, endDate time.Time) ([]models.HourWeekdayRatings, error)
+	GetReviewerCountAndAverageRatingByTicket(ctx context.Context, categoryID int, startDate, endDate time.Time) ([]models.TicketReviewerRatings, error)
 }
 
 type ScoreCalculator interface {
 	CalculateScore(ratings []models.Rating, categories []models.RatingCategory) (float64, error)
+	CalculateScoreResult(ratings []models.Rating, categories []models.RatingCategory) (*ScoreResult, error)
+	// MaxRating is the highest rating value, which scores 100%
+	MaxRating() int
 }
 
 type RatingAnalyticsService struct {
-	categoryRepo    CategoryRepository
-	ratingsRepo     RatingsRepository
-	ticketScoreServ ScoreCalculator
+	categoryRepo             CategoryRepository
+	ratingsRepo              RatingsRepository
+	ticketScoreServ          ScoreCalculator
+	scorePrecision           int
+	aggregationThresholdDays int
+
+	// Ratings to score instead of the repository's, keyed by category ID, see scopedTo
+	scopedRatings map[int][]models.Rating
+
+	importListeners []func()
+}
+
+// RatingAnalyticsOption configures a RatingAnalyticsService
+type RatingAnalyticsOption func(*RatingAnalyticsService)
+
+// WithAggregationThresholdDays sets the longest date range, in days, that is scored by day.
+// Longer ranges are scored by month up to 90 days and by week beyond that. Defaults to 30.
+func WithAggregationThresholdDays(days int) RatingAnalyticsOption {
+	return func(s *RatingAnalyticsService) {
+		s.aggregationThresholdDays = days
+	}
+}
+
+// WithAnalyticsScorePrecision sets the number of decimal places in formatted scores. Defaults to 0.
+func WithAnalyticsScorePrecision(decimals int) RatingAnalyticsOption {
+	return func(s *RatingAnalyticsService) {
+		s.scorePrecision = decimals
+	}
 }
 
 func NewRatingAnalyticsService(
 	categoryRepo CategoryRepository,
 	ratingsRepo RatingsRepository,
 	ticketScoreServ ScoreCalculator,
+	opts ...RatingAnalyticsOption,
 ) *RatingAnalyticsService {
-	return &RatingAnalyticsService{
-		categoryRepo:    categoryRepo,
-		ratingsRepo:     ratingsRepo,
-		ticketScoreServ: ticketScoreServ,
+	s := &RatingAnalyticsService{
+		categoryRepo:             categoryRepo,
+		ratingsRepo:              ratingsRepo,
+		ticketScoreServ:          ticketScoreServ,
+		aggregationThresholdDays: defaultAggregationThresholdDays,
+	}
+	for _, opt := range opts {
+		opt(s)
 	}
+	return s
+}
+
+// ImportRatings inserts a batch of ratings, either all of them or none. Nothing is inserted if
+// any rating is invalid.
+func (s *RatingAnalyticsService) ImportRatings(ctx context.Context, ratings []models.Rating) error {
+	for i, rating := range ratings {
+		if err := s.ValidateRating(rating); err != nil {
+			return fmt.Errorf("rating %d: %w", i, err)
+		}
+	}
+
+	if err := s.ratingsRepo.BulkInsertRatings(ctx, ratings); err != nil {
+		return logError(ctx, fmt.Errorf("failed to insert ratings: %w", err))
+	}
+
+	for _, fn := range s.importListeners {
+		fn()
+	}
+	return nil
+}
+
+// OnRatingsImported registers fn to be called after each batch of ratings is imported, e.g. to
+// drop scores cached from the ratings before it
+func (s *RatingAnalyticsService) OnRatingsImported(fn func()) {
+	s.importListeners = append(s.importListeners, fn)
+}
+
+// ValidateRating checks that a rating to import references positive IDs and has a value from 0 to
+// the maximum rating. Its own ID may be 0, to have the database assign one.
+func (s *RatingAnalyticsService) ValidateRating(rating models.Rating) error {
+	if rating.ID < 0 {
+		return fmt.Errorf("%w: id must not be negative", ErrInvalidRating)
+	}
+
+	ids := []struct {
+		name  string
+		value int
+	}{
+		{"ticket_id", rating.TicketID},
+		{"rating_category_id", rating.RatingCategoryID},
+		{"reviewer_id", rating.ReviewerID},
+		{"reviewee_id", rating.RevieweeID},
+	}
+	for _, id := range ids {
+		if id.value <= 0 {
+			return fmt.Errorf("%w: %s must be positive", ErrInvalidRating, id.name)
+		}
+	}
+
+	if maxRating := s.ticketScoreServ.MaxRating(); rating.Rating < 0 || rating.Rating > maxRating {
+		return fmt.Errorf("%w: rating must be between 0 and %d, got %d", ErrInvalidRating, maxRating, rating.Rating)
+	}
+	return nil
+}
+
+// formatScore formats a score with the configured precision
+func (s *RatingAnalyticsService) formatScore(score float64) string {
+	return utils.FormatScoreWithPrecision(score, s.scorePrecision)
 }
 
 func (s *RatingAnalyticsService) GetCategoryAnalytics(ctx context.Context, startDate, endDate time.Time) ([]CategoryAnalytics, error) {
 	categories, err := s.categoryRepo.GetAll(ctx)
 	if err != nil {
-		return nil, err
+		return nil, logError(ctx, err)
 	}
 
 	var results []CategoryAnalytics
@@ -73,11 +277,270 @@ func (s *RatingAnalyticsService) GetCategoryAnalytics(ctx context.Context, start
 	return results, nil
 }
 
+// GetCategoryAnalyticsPaginated gets the analytics of one page of categories, ordered by ID, along
+// with the total number of categories
+func (s *RatingAnalyticsService) GetCategoryAnalyticsPaginated(ctx context.Context, startDate, endDate time.Time, limit, offset int) ([]CategoryAnalytics, int, error) {
+	totalCount, err := s.categoryRepo.Count(ctx)
+	if err != nil {
+		return nil, 0, logError(ctx, err)
+	}
+
+	categories, err := s.categoryRepo.GetAllPaginated(ctx, limit, offset)
+	if err != nil {
+		return nil, 0, logError(ctx, err)
+	}
+
+	results := make([]CategoryAnalytics, 0, len(categories))
+	for _, category := range categories {
+		analytics, err := s.processCategoryAnalytics(ctx, category, startDate, endDate)
+		if err != nil {
+			return nil, 0, err
+		}
+		results = append(results, analytics)
+	}
+
+	return results, totalCount, nil
+}
+
+// AnalyticsForRatings gets the same analytics as GetCategoryAnalytics for every category, but only
+// from the given ratings, such as those of one reviewer or reviewee
+func (s *RatingAnalyticsService) AnalyticsForRatings(ctx context.Context, ratings []models.Rating, startDate, endDate time.Time) ([]CategoryAnalytics, error) {
+	categories, err := s.categoryRepo.GetAll(ctx)
+	if err != nil {
+		return nil, logError(ctx, err)
+	}
+
+	scoped := s.scopedTo(ratings)
+
+	results := make([]CategoryAnalytics, 0, len(categories))
+	for _, category := range categories {
+		analytics, err := scoped.processCategoryAnalytics(ctx, category, startDate, endDate)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, analytics)
+	}
+
+	return results, nil
+}
+
+// GetCategoryExtremeRatingCounts counts ratings at or above and at or below the threshold for a category
+func (s *RatingAnalyticsService) GetCategoryExtremeRatingCounts(ctx context.Context, categoryID, threshold int, startDate, endDate time.Time) (*ExtremeRatingCounts, error) {
+	above, err := s.ratingsRepo.GetCountAboveThreshold(ctx, categoryID, threshold, startDate, endDate)
+	if err != nil {
+		return nil, logError(ctx, err)
+	}
+
+	below, err := s.ratingsRepo.GetCountBelowThreshold(ctx, categoryID, threshold, startDate, endDate)
+	if err != nil {
+		return nil, logError(ctx, err)
+	}
+
+	return &ExtremeRatingCounts{
+		CategoryID:     categoryID,
+		AboveThreshold: above,
+		BelowThreshold: below,
+		Threshold:      threshold,
+	}, nil
+}
+
+// GetScoreImprovementPotential ranks categories by how much their score could improve, weighted by
+// category weight. Priority 1 is the biggest opportunity. Categories without ratings are left out.
+func (s *RatingAnalyticsService) GetScoreImprovementPotential(ctx context.Context, startDate, endDate time.Time) ([]ImprovementOpportunity, error) {
+	categories, err := s.categoryRepo.GetAll(ctx)
+	if err != nil {
+		return nil, logError(ctx, err)
+	}
+
+	var opportunities []ImprovementOpportunity
+	for _, category := range categories {
+		ratings, err := s.ratingsRepo.GetByCategoryIDAndDateRange(ctx, category.ID, startDate, endDate)
+		if err != nil {
+			return nil, logError(ctx, err)
+		}
+		if len(ratings) == 0 {
+			continue
+		}
+
+		score, err := s.ticketScoreServ.CalculateScore(ratings, []models.RatingCategory{category})
+		if err != nil {
+			return nil, fmt.Errorf("failed to calculate score for category %s: %w", category.Name, err)
+		}
+
+		potential := 100 - score
+		opportunities = append(opportunities, ImprovementOpportunity{
+			CategoryName:         category.Name,
+			CurrentScore:         s.formatScore(score),
+			ImprovementPotential: potential,
+			WeightedPotential:    potential * category.Weight,
+		})
+	}
+
+	sort.SliceStable(opportunities, func(i, j int) bool {
+		return opportunities[i].WeightedPotential > opportunities[j].WeightedPotential
+	})
+	for i := range opportunities {
+		opportunities[i].Priority = i + 1
+	}
+
+	return opportunities, nil
+}
+
+// GetMonthlyCategoryHeatmap calculates the score of every category in every month of a year.
+// Months without ratings score "N/A".
+func (s *RatingAnalyticsService) GetMonthlyCategoryHeatmap(ctx context.Context, year int) (*CategoryHeatmap, error) {
+	categories, err := s.categoryRepo.GetAll(ctx)
+	if err != nil {
+		return nil, logError(ctx, err)
+	}
+
+	heatmap := &CategoryHeatmap{
+		Year:        year,
+		Categories:  make([]string, len(categories)),
+		Months:      make([]string, 12),
+		ScoreMatrix: make([][]string, len(categories)),
+	}
+
+	yearStart := time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC)
+	for month := range heatmap.Months {
+		heatmap.Months[month] = yearStart.AddDate(0, month, 0).Format("2006-01")
+	}
+
+	for i, category := range categories {
+		// Fetch the whole year at once and split it by month
+		ratings, err := s.ratingsRepo.GetByCategoryIDAndDateRange(ctx, category.ID, yearStart, yearStart.AddDate(1, 0, -1))
+		if err != nil {
+			return nil, logError(ctx, fmt.Errorf("failed to get ratings for category %s: %w", category.Name, err))
+		}
+
+		monthlyRatings := make([][]models.Rating, 12)
+		for _, rating := range ratings {
+			month := rating.CreatedAt.In(time.UTC).Month() - 1
+			monthlyRatings[month] = append(monthlyRatings[month], rating)
+		}
+
+		heatmap.Categories[i] = category.Name
+		heatmap.ScoreMatrix[i] = make([]string, 12)
+		for month, ratings := range monthlyRatings {
+			heatmap.ScoreMatrix[i][month] = s.calculateOverallScore(ratings, category)
+		}
+	}
+
+	return heatmap, nil
+}
+
+// GetCategoryScoreComparison calculates the daily scores of two categories side by side.
+// Difference is score1 - score2, or "N/A" when either score is "N/A".
+func (s *RatingAnalyticsService) GetCategoryScoreComparison(ctx context.Context, categoryID1, categoryID2 int, startDate, endDate time.Time) (*CategoryComparison, error) {
+	category1, err := findCategory(ctx, s.categoryRepo, categoryID1)
+	if err != nil {
+		return nil, err
+	}
+	category2, err := findCategory(ctx, s.categoryRepo, categoryID2)
+	if err != nil {
+		return nil, err
+	}
+
+	// Fetch both categories' daily scores in parallel
+	var scores1, scores2 []DailyScore
+	var err1, err2 error
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		scores1, _, err1 = s.calculateDailyScores(ctx, category1, startDate, endDate)
+	}()
+	go func() {
+		defer wg.Done()
+		scores2, _, err2 = s.calculateDailyScores(ctx, category2, startDate, endDate)
+	}()
+	wg.Wait()
+
+	if err1 != nil {
+		return nil, fmt.Errorf("failed to calculate daily scores for category %s: %w", category1.Name, err1)
+	}
+	if err2 != nil {
+		return nil, fmt.Errorf("failed to calculate daily scores for category %s: %w", category2.Name, err2)
+	}
+
+	// Both slices hold one score per day of the same range
+	points := make([]ComparisonPoint, len(scores1))
+	for i := range scores1 {
+		points[i] = ComparisonPoint{
+			Date:       scores1[i].Date,
+			Score1:     scores1[i].Score,
+			Score2:     scores2[i].Score,
+			Difference: s.formatScoreDifference(scores1[i].Score, scores2[i].Score),
+		}
+	}
+
+	return &CategoryComparison{
+		Category1: category1.Name,
+		Category2: category2.Name,
+		Points:    points,
+	}, nil
+}
+
+// GetRatingsByCategoryAndReviewer gets the ratings a reviewer gave in a category for every day from
+// startDate to endDate, in chronological order
+func (s *RatingAnalyticsService) GetRatingsByCategoryAndReviewer(ctx context.Context, categoryID, reviewerID int, startDate, endDate time.Time) ([]models.Rating, error) {
+	if _, err := findCategory(ctx, s.categoryRepo, categoryID); err != nil {
+		return nil, err
+	}
+
+	ratings, err := s.ratingsRepo.GetByReviewerIDAndCategoryIDAndDateRange(ctx, reviewerID, categoryID, startDate, endDate)
+	if err != nil {
+		return nil, logError(ctx, fmt.Errorf("failed to get ratings: %w", err))
+	}
+
+	return ratings, nil
+}
+
+// formatScoreDifference formats score1 - score2 as a signed percentage such as "+5%" or "-3%"
+func (s *RatingAnalyticsService) formatScoreDifference(score1, score2 string) string {
+	return scoreDifference(score1, score2, s.scorePrecision)
+}
+
+// scoreDifference formats the difference between two formatted scores, score1 - score2, as a
+// signed percentage with the given precision. It returns "N/A" if either score is "N/A".
+func scoreDifference(score1, score2 string, decimals int) string {
+	value1, ok1 := parseScore(score1)
+	value2, ok2 := parseScore(score2)
+	if !ok1 || !ok2 {
+		return "N/A"
+	}
+
+	difference := value1 - value2
+	switch {
+	case difference > 0:
+		return "+" + utils.FormatScoreWithPrecision(difference, decimals)
+	case difference < 0:
+		return "-" + utils.FormatScoreWithPrecision(-difference, decimals)
+	default:
+		return utils.FormatScoreWithPrecision(0, decimals)
+	}
+}
+
+// findCategory looks up a rating category by ID
+func findCategory(ctx context.Context, categoryRepo CategoryRepository, categoryID int) (models.RatingCategory, error) {
+	category, err := categoryRepo.GetByID(ctx, categoryID)
+	if errors.Is(err, ErrCategoryNotFound) {
+		return models.RatingCategory{}, err
+	}
+	if err != nil {
+		return models.RatingCategory{}, logError(ctx, fmt.Errorf("failed to get category: %w", err))
+	}
+
+	return *category, nil
+}
+
 func (s *RatingAnalyticsService) processCategoryAnalytics(ctx context.Context, category models.RatingCategory, startDate, endDate time.Time) (CategoryAnalytics, error) {
 	analytics := CategoryAnalytics{
-		Category: category.Name,
-		Ratings:  0,
-		Dates:    []DailyScore{},
+		CategoryID:  category.ID,
+		Category:    category.Name,
+		Ratings:     0,
+		Dates:       []DailyScore{},
+		Aggregation: s.determineAggregation(startDate, endDate).String(),
 	}
 
 	scores, totalRatings, err := s.calculateScores(ctx, category, startDate, endDate)
@@ -88,29 +551,36 @@ func (s *RatingAnalyticsService) processCategoryAnalytics(ctx context.Context, c
 	analytics.Dates = scores
 	analytics.Ratings = len(totalRatings)
 	analytics.Score = s.calculateOverallScore(totalRatings, category)
+	analytics.StdDev = s.calculateRatingStdDev(totalRatings)
 
 	return analytics, nil
 }
 
 func (s *RatingAnalyticsService) calculateScores(ctx context.Context, category models.RatingCategory, startDate, endDate time.Time) ([]DailyScore, []models.Rating, error) {
-	if s.shouldUseWeeklyAggregation(startDate, endDate) {
+	switch s.determineAggregation(startDate, endDate) {
+	case AggregationWeekly:
 		return s.calculateWeeklyScores(ctx, category, startDate, endDate)
+	case AggregationMonthly:
+		return s.calculateMonthlyScores(ctx, category, startDate, endDate)
+	default:
+		return s.calculateDailyScores(ctx, category, startDate, endDate)
 	}
-	return s.calculateDailyScores(ctx, category, startDate, endDate)
 }
 
 func (s *RatingAnalyticsService) calculateDailyScores(ctx context.Context, category models.RatingCategory, startDate, endDate time.Time) ([]DailyScore, []models.Rating, error) {
+	ratings, err := s.getCategoryRatings(ctx, category.ID, startDate, endDate)
+	if err != nil {
+		return nil, nil, err
+	}
+	ratingsByDate := groupRatingsByDate(ratings, startDate.Location())
+
 	var scores []DailyScore
 	var totalRatings []models.Rating
 
 	currentDate := startDate
 	for !currentDate.After(endDate) {
-		dailyRatings, err := s.ratingsRepo.GetByCategoryIDAndDate(ctx, category.ID, currentDate)
-		if err != nil {
-			return nil, nil, err
-		}
-
 		dateStr := currentDate.Format("2006-01-02")
+		dailyRatings := ratingsByDate[dateStr]
 		dailyScore := s.calculateDailyScore(dailyRatings, category, dateStr)
 		scores = append(scores, dailyScore)
 
@@ -124,6 +594,50 @@ func (s *RatingAnalyticsService) calculateDailyScores(ctx context.Context, categ
 	return scores, totalRatings, nil
 }
 
+// ratedDailyScores calculates a category's unrounded score on each day from startDate to endDate
+// that has ratings. The scores and their dates (YYYY-MM-DD) are returned in date order.
+func (s *RatingAnalyticsService) ratedDailyScores(ctx context.Context, category models.RatingCategory, startDate, endDate time.Time) ([]float64, []string, error) {
+	ratings, err := s.getCategoryRatings(ctx, category.ID, startDate, endDate)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get ratings: %w", err)
+	}
+	return s.scoreRatedDays(ratings, category, startDate, endDate)
+}
+
+// scoreRatedDays is ratedDailyScores for ratings that have already been fetched
+func (s *RatingAnalyticsService) scoreRatedDays(ratings []models.Rating, category models.RatingCategory, startDate, endDate time.Time) ([]float64, []string, error) {
+	ratingsByDate := groupRatingsByDate(ratings, startDate.Location())
+
+	var scores []float64
+	var dates []string
+	for currentDate := startDate; !currentDate.After(endDate); currentDate = currentDate.AddDate(0, 0, 1) {
+		dateStr := currentDate.Format("2006-01-02")
+		dailyRatings := ratingsByDate[dateStr]
+		if len(dailyRatings) == 0 {
+			continue
+		}
+
+		score, err := s.ticketScoreServ.CalculateScore(dailyRatings, []models.RatingCategory{category})
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to calculate score for %s: %w", dateStr, err)
+		}
+		scores = append(scores, score)
+		dates = append(dates, dateStr)
+	}
+
+	return scores, dates, nil
+}
+
+// groupRatingsByDate buckets ratings by their creation day (YYYY-MM-DD) in the given location
+func groupRatingsByDate(ratings []models.Rating, loc *time.Location) map[string][]models.Rating {
+	ratingsByDate := make(map[string][]models.Rating)
+	for _, rating := range ratings {
+		dateStr := rating.CreatedAt.In(loc).Format("2006-01-02")
+		ratingsByDate[dateStr] = append(ratingsByDate[dateStr], rating)
+	}
+	return ratingsByDate
+}
+
 func (s *RatingAnalyticsService) calculateDailyScore(dailyRatings []models.Rating, category models.RatingCategory, dateStr string) DailyScore {
 	if len(dailyRatings) == 0 {
 		return DailyScore{
@@ -142,7 +656,7 @@ func (s *RatingAnalyticsService) calculateDailyScore(dailyRatings []models.Ratin
 
 	return DailyScore{
 		Date:  dateStr,
-		Score: utils.FormatScore(score),
+		Score: s.formatScore(score),
 	}
 }
 
@@ -156,46 +670,137 @@ func (s *RatingAnalyticsService) calculateOverallScore(totalRatings []models.Rat
 		return "N/A"
 	}
 
-	return utils.FormatScore(score)
+	return s.formatScore(score)
 }
 
-func (s *RatingAnalyticsService) shouldUseWeeklyAggregation(startDate, endDate time.Time) bool {
+// calculateRatingStdDev calculates the standard deviation of ratings on the score scale, where a
+// rating of 5 is 100%. It is "N/A" for fewer than 2 ratings.
+func (s *RatingAnalyticsService) calculateRatingStdDev(ratings []models.Rating) string {
+	if len(ratings) < 2 {
+		return "N/A"
+	}
+
+	values := make([]float64, len(ratings))
+	for i, rating := range ratings {
+		values[i] = ratingToPercent(float64(rating.Rating), s.ticketScoreServ.MaxRating())
+	}
+
+	return s.formatScore(standardDeviation(values))
+}
+
+// determineAggregation picks how a date range is scored: by day up to the service's aggregation
+// threshold, by month up to 90 days and by week beyond that
+func (s *RatingAnalyticsService) determineAggregation(startDate, endDate time.Time) AggregationMode {
+	return aggregationFor(startDate, endDate, s.aggregationThresholdDays)
+}
+
+// aggregationFor picks how a date range is scored: by day up to thresholdDays, by month up to 90
+// days and by week beyond that
+func aggregationFor(startDate, endDate time.Time, thresholdDays int) AggregationMode {
+	switch {
+	case !exceedsAggregationThreshold(startDate, endDate, thresholdDays):
+		return AggregationDaily
+	case !exceedsAggregationThreshold(startDate, endDate, monthlyAggregationMaxDays):
+		return AggregationMonthly
+	default:
+		return AggregationWeekly
+	}
+}
+
+// exceedsAggregationThreshold reports whether a date range is longer than thresholdDays
+func exceedsAggregationThreshold(startDate, endDate time.Time, thresholdDays int) bool {
 	duration := endDate.Sub(startDate)
-	return duration > 30*24*time.Hour // More than 30 days
+	return duration > time.Duration(thresholdDays)*24*time.Hour
 }
 
+// calculateWeeklyScores scores each week, starting on Monday, of a date range
 func (s *RatingAnalyticsService) calculateWeeklyScores(ctx context.Context, category models.RatingCategory, startDate, endDate time.Time) ([]DailyScore, []models.Rating, error) {
-	var weeklyScores []DailyScore
-	var totalRatings []models.Rating
+	return s.calculateAggregatedScores(ctx, category, AggregationWeekly, startDate, endDate)
+}
 
-	currentWeekStart := s.getWeekStart(startDate)
+// calculateMonthlyScores scores each calendar month of a date range. The first and last months
+// only include the days inside the range.
+func (s *RatingAnalyticsService) calculateMonthlyScores(ctx context.Context, category models.RatingCategory, startDate, endDate time.Time) ([]DailyScore, []models.Rating, error) {
+	return s.calculateAggregatedScores(ctx, category, AggregationMonthly, startDate, endDate)
+}
 
-	for !currentWeekStart.After(endDate) {
-		weekEnd := currentWeekStart.AddDate(0, 0, 6)
-		if weekEnd.After(endDate) {
-			weekEnd = endDate
-		}
+// calculateAggregatedScores scores a category in each period of a date range, see
+// aggregationPeriods, from the ratings of the whole range fetched at once. The first week starts on
+// its Monday, so it includes the days before startDate.
+func (s *RatingAnalyticsService) calculateAggregatedScores(ctx context.Context, category models.RatingCategory, mode AggregationMode, startDate, endDate time.Time) ([]DailyScore, []models.Rating, error) {
+	periods := aggregationPeriods(mode, startDate, endDate)
+	if len(periods) == 0 {
+		return nil, nil, nil
+	}
 
-		weeklyRatings, err := s.getRatingsForDateRange(ctx, category.ID, currentWeekStart, weekEnd)
-		if err != nil {
-			return nil, nil, err
+	ratings, err := s.getCategoryRatings(ctx, category.ID, periods[0].start, endDate)
+	if err != nil {
+		return nil, nil, err
+	}
+	ratingsByDate := groupRatingsByDate(ratings, startDate.Location())
+
+	var scores []DailyScore
+	var totalRatings []models.Rating
+	for _, period := range periods {
+		var periodRatings []models.Rating
+		for day := period.start; !day.After(period.end); day = day.AddDate(0, 0, 1) {
+			periodRatings = append(periodRatings, ratingsByDate[day.Format("2006-01-02")]...)
 		}
 
-		weekStr := fmt.Sprintf("%s to %s", currentWeekStart.Format("2006-01-02"), weekEnd.Format("2006-01-02"))
-		weeklyScore := s.calculatePeriodScore(weeklyRatings, category, weekStr)
-		weeklyScores = append(weeklyScores, weeklyScore)
+		scores = append(scores, s.calculatePeriodScore(periodRatings, category, period.label))
+		totalRatings = append(totalRatings, periodRatings...)
+	}
 
-		if len(weeklyRatings) > 0 {
-			totalRatings = append(totalRatings, weeklyRatings...)
-		}
+	return scores, totalRatings, nil
+}
+
+// aggregationPeriod is a day, week or month of a date range, labelled like CategoryAnalytics.Dates
+type aggregationPeriod struct {
+	label      string
+	start, end time.Time
+}
 
-		currentWeekStart = currentWeekStart.AddDate(0, 0, 7)
+// aggregationPeriods splits a date range into the periods of an aggregation mode. Weeks start on
+// Monday; months start on the first but the first and last months only include the days inside the
+// range, as in calculateMonthlyScores.
+func aggregationPeriods(mode AggregationMode, startDate, endDate time.Time) []aggregationPeriod {
+	var periods []aggregationPeriod
+	switch mode {
+	case AggregationWeekly:
+		for weekStart := getWeekStart(startDate); !weekStart.After(endDate); weekStart = weekStart.AddDate(0, 0, 7) {
+			weekEnd := weekStart.AddDate(0, 0, 6)
+			if weekEnd.After(endDate) {
+				weekEnd = endDate
+			}
+			label := fmt.Sprintf("%s to %s", weekStart.Format("2006-01-02"), weekEnd.Format("2006-01-02"))
+			periods = append(periods, aggregationPeriod{label: label, start: weekStart, end: weekEnd})
+		}
+	case AggregationMonthly:
+		for monthStart := getMonthStart(startDate); !monthStart.After(endDate); monthStart = monthStart.AddDate(0, 1, 0) {
+			from, to := monthStart, monthStart.AddDate(0, 1, -1)
+			if from.Before(startDate) {
+				from = startDate
+			}
+			if to.After(endDate) {
+				to = endDate
+			}
+			periods = append(periods, aggregationPeriod{label: monthStart.Format("2006-01"), start: from, end: to})
+		}
+	default:
+		for day := startDate; !day.After(endDate); day = day.AddDate(0, 0, 1) {
+			periods = append(periods, aggregationPeriod{label: day.Format("2006-01-02"), start: day, end: day})
+		}
 	}
+	return periods
+}
 
-	return weeklyScores, totalRatings, nil
+// getMonthStart returns the first day of the month containing date
+func getMonthStart(date time.Time) time.Time {
+	return time.Date(date.Year(), date.Month(), 1, 0, 0, 0, 0, date.Location())
 }
 
-func (s *RatingAnalyticsService) getWeekStart(date time.Time) time.Time {
+// getWeekStart returns the Monday of the week containing date
+func getWeekStart(date time.Time) time.Time {
 	weekday := int(date.Weekday())
 	if weekday == 0 {
 		weekday = 7 // Sunday = 7
@@ -203,20 +808,41 @@ func (s *RatingAnalyticsService) getWeekStart(date time.Time) time.Time {
 	return date.AddDate(0, 0, -(weekday - 1))
 }
 
-func (s *RatingAnalyticsService) getRatingsForDateRange(ctx context.Context, categoryID int, startDate, endDate time.Time) ([]models.Rating, error) {
-	var allRatings []models.Rating
+// scopedTo returns a copy of the service that scores only the given ratings instead of querying
+// the ratings repository
+func (s *RatingAnalyticsService) scopedTo(ratings []models.Rating) *RatingAnalyticsService {
+	scoped := *s
+	scoped.scopedRatings = make(map[int][]models.Rating)
+	for _, rating := range ratings {
+		scoped.scopedRatings[rating.RatingCategoryID] = append(scoped.scopedRatings[rating.RatingCategoryID], rating)
+	}
+	return &scoped
+}
 
-	currentDate := startDate
-	for !currentDate.After(endDate) {
-		dailyRatings, err := s.ratingsRepo.GetByCategoryIDAndDate(ctx, categoryID, currentDate)
-		if err != nil {
-			return nil, err
-		}
-		allRatings = append(allRatings, dailyRatings...)
-		currentDate = currentDate.AddDate(0, 0, 1)
+// getCategoryRatings gets a category's ratings for every day from startDate to endDate
+func (s *RatingAnalyticsService) getCategoryRatings(ctx context.Context, categoryID int, startDate, endDate time.Time) ([]models.Rating, error) {
+	if s.scopedRatings != nil {
+		return s.scopedCategoryRatings(categoryID, startDate, endDate), nil
+	}
+	ratings, err := s.ratingsRepo.GetByCategoryIDAndDateRange(ctx, categoryID, startDate, endDate)
+	if err != nil {
+		return nil, logError(ctx, err)
 	}
+	return ratings, nil
+}
 
-	return allRatings, nil
+// scopedCategoryRatings gets the scoped ratings of a category created on any day from startDate to endDate
+func (s *RatingAnalyticsService) scopedCategoryRatings(categoryID int, startDate, endDate time.Time) []models.Rating {
+	start := time.Date(startDate.Year(), startDate.Month(), startDate.Day(), 0, 0, 0, 0, startDate.Location())
+	end := time.Date(endDate.Year(), endDate.Month(), endDate.Day(), 0, 0, 0, 0, endDate.Location()).AddDate(0, 0, 1)
+
+	var ratings []models.Rating
+	for _, rating := range s.scopedRatings[categoryID] {
+		if !rating.CreatedAt.Before(start) && rating.CreatedAt.Before(end) {
+			ratings = append(ratings, rating)
+		}
+	}
+	return ratings
 }
 
 func (s *RatingAnalyticsService) calculatePeriodScore(ratings []models.Rating, category models.RatingCategory, periodStr string) DailyScore {
@@ -237,6 +863,6 @@ func (s *RatingAnalyticsService) calculatePeriodScore(ratings []models.Rating, c
 
 	return DailyScore{
 		Date:  periodStr,
-		Score: utils.FormatScore(score),
+		Score: s.formatScore(score),
 	}
 }
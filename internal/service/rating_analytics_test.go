@@ -2,13 +2,17 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"reflect"
 	"strings"
 	"testing"
 	"time"
 
 	"ticket-score-service/internal/mocks"
 	"ticket-score-service/internal/models"
+	"ticket-score-service/internal/repository"
+	"ticket-score-service/internal/testutil"
 	"ticket-score-service/internal/utils"
 )
 
@@ -21,6 +25,33 @@ func (m *mockCategoryRepo) GetAll(ctx context.Context) ([]models.RatingCategory,
 	return m.categories, m.err
 }
 
+func (m *mockCategoryRepo) GetByID(ctx context.Context, id int) (*models.RatingCategory, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	for _, category := range m.categories {
+		if category.ID == id {
+			return &category, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: %d", ErrCategoryNotFound, id)
+}
+
+func (m *mockCategoryRepo) GetAllPaginated(ctx context.Context, limit, offset int) ([]models.RatingCategory, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	if offset >= len(m.categories) {
+		return nil, nil
+	}
+	end := min(offset+limit, len(m.categories))
+	return m.categories[offset:end], nil
+}
+
+func (m *mockCategoryRepo) Count(ctx context.Context) (int, error) {
+	return len(m.categories), m.err
+}
+
 type mockTicketScoreService struct {
 	score float64
 	err   error
@@ -30,15 +61,27 @@ func (m *mockTicketScoreService) CalculateScore(ratings []models.Rating, categor
 	return m.score, m.err
 }
 
+func (m *mockTicketScoreService) CalculateScoreResult(ratings []models.Rating, categories []models.RatingCategory) (*ScoreResult, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	return &ScoreResult{Score: m.score}, nil
+}
+
+func (m *mockTicketScoreService) MaxRating() int {
+	return defaultMaxRating
+}
+
 func TestGetCategoryAnalytics(t *testing.T) {
 	tests := []struct {
-		name          string
-		categories    []models.RatingCategory
-		ratings       map[string][]models.Rating
-		startDate     time.Time
-		endDate       time.Time
-		expectedCount int
-		expectError   bool
+		name                string
+		categories          []models.RatingCategory
+		ratings             map[string][]models.Rating
+		startDate           time.Time
+		endDate             time.Time
+		expectedCount       int
+		expectedAggregation AggregationMode
+		expectError         bool
 	}{
 		{
 			name: "successful analysis with ratings",
@@ -78,7 +121,7 @@ func TestGetCategoryAnalytics(t *testing.T) {
 			expectError:   false,
 		},
 		{
-			name: "long date range - weekly aggregation",
+			name: "medium date range - monthly aggregation",
 			categories: []models.RatingCategory{
 				{ID: 1, Name: "Spelling", Weight: 10},
 			},
@@ -86,10 +129,26 @@ func TestGetCategoryAnalytics(t *testing.T) {
 				"1-2024-01-01": {{ID: 1, Rating: 4, RatingCategoryID: 1}},
 				"1-2024-02-15": {{ID: 2, Rating: 5, RatingCategoryID: 1}},
 			},
-			startDate:     time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
-			endDate:       time.Date(2024, 2, 15, 0, 0, 0, 0, time.UTC),
-			expectedCount: 1,
-			expectError:   false,
+			startDate:           time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+			endDate:             time.Date(2024, 2, 15, 0, 0, 0, 0, time.UTC),
+			expectedCount:       1,
+			expectedAggregation: AggregationMonthly,
+			expectError:         false,
+		},
+		{
+			name: "long date range - weekly aggregation",
+			categories: []models.RatingCategory{
+				{ID: 1, Name: "Spelling", Weight: 10},
+			},
+			ratings: map[string][]models.Rating{
+				"1-2024-01-01": {{ID: 1, Rating: 4, RatingCategoryID: 1}},
+				"1-2024-04-15": {{ID: 2, Rating: 5, RatingCategoryID: 1}},
+			},
+			startDate:           time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+			endDate:             time.Date(2024, 4, 15, 0, 0, 0, 0, time.UTC),
+			expectedCount:       1,
+			expectedAggregation: AggregationWeekly,
+			expectError:         false,
 		},
 	}
 
@@ -122,8 +181,12 @@ func TestGetCategoryAnalytics(t *testing.T) {
 					t.Errorf("dates should not be empty")
 				}
 
+				if analytics.Aggregation != tt.expectedAggregation.String() {
+					t.Errorf("expected %s aggregation, got %s", tt.expectedAggregation, analytics.Aggregation)
+				}
+
 				// Check if long date ranges use weekly aggregation
-				if tt.name == "long date range - weekly aggregation" {
+				if tt.expectedAggregation == AggregationWeekly {
 					for _, date := range analytics.Dates {
 						if !strings.Contains(date.Date, " to ") {
 							t.Errorf("expected weekly format with 'to' separator, got %s", date.Date)
@@ -135,33 +198,192 @@ func TestGetCategoryAnalytics(t *testing.T) {
 	}
 }
 
+func TestGetCategoryAnalyticsPaginated(t *testing.T) {
+	db := testutil.NewTestDB(t)
+	startDate := time.Date(2019, 10, 1, 0, 0, 0, 0, time.UTC)
+	endDate := startDate.AddDate(0, 0, 2)
+
+	categories := make([]models.RatingCategory, 5)
+	ratings := make([]models.Rating, len(categories))
+	for i := range categories {
+		categories[i] = models.RatingCategory{ID: i + 1, Name: fmt.Sprintf("Category %d", i+1), Weight: 1}
+		ratings[i] = models.Rating{ID: i + 1, Rating: i + 1, TicketID: 1, RatingCategoryID: i + 1, CreatedAt: startDate.Add(time.Hour)}
+	}
+	testutil.SeedTestData(t, db, ratings, categories)
+	service := NewRatingAnalyticsService(repository.NewRatingCategoryRepository(db), repository.NewRatingsRepository(db), NewTicketScoreService())
+
+	all, err := service.GetCategoryAnalytics(context.Background(), startDate, endDate)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		limit    int
+		offset   int
+		expected []int // indexes into the unpaginated analytics
+	}{
+		{name: "first page", limit: 2, offset: 0, expected: []int{0, 1}},
+		{name: "middle page", limit: 2, offset: 2, expected: []int{2, 3}},
+		{name: "partial last page", limit: 2, offset: 4, expected: []int{4}},
+		{name: "offset at the end", limit: 2, offset: 5, expected: nil},
+		{name: "offset past the end", limit: 2, offset: 50, expected: nil},
+		{name: "single page", limit: 5, offset: 0, expected: []int{0, 1, 2, 3, 4}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			page, totalCount, err := service.GetCategoryAnalyticsPaginated(context.Background(), startDate, endDate, tt.limit, tt.offset)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if totalCount != len(categories) {
+				t.Errorf("Expected total count %d, got %d", len(categories), totalCount)
+			}
+			if len(page) != len(tt.expected) {
+				t.Fatalf("Expected %d categories, got %d", len(tt.expected), len(page))
+			}
+			for i, index := range tt.expected {
+				if !reflect.DeepEqual(page[i], all[index]) {
+					t.Errorf("Expected %+v at position %d, got %+v", all[index], i, page[i])
+				}
+			}
+		})
+	}
+
+	t.Run("total count follows new categories", func(t *testing.T) {
+		testutil.SeedTestData(t, db, nil, []models.RatingCategory{{ID: 6, Name: "Category 6", Weight: 1}})
+
+		_, totalCount, err := service.GetCategoryAnalyticsPaginated(context.Background(), startDate, endDate, 2, 0)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if totalCount != 6 {
+			t.Errorf("Expected total count 6, got %d", totalCount)
+		}
+	})
+
+	t.Run("category error", func(t *testing.T) {
+		errorService := NewRatingAnalyticsService(&mockCategoryRepo{err: fmt.Errorf("database error")}, &mocks.MockRatingsRepo{}, NewTicketScoreService())
+		if _, _, err := errorService.GetCategoryAnalyticsPaginated(context.Background(), startDate, endDate, 2, 0); err == nil {
+			t.Error("Expected error but got none")
+		}
+	})
+}
+
+func TestGetCategoryCoverage_IncludesEndDate(t *testing.T) {
+	db := testutil.NewTestDB(t)
+	startDate := time.Date(2019, 10, 1, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2019, 10, 3, 0, 0, 0, 0, time.UTC)
+
+	categories := []models.RatingCategory{
+		{ID: 1, Name: "Spelling", Weight: 1},
+		{ID: 2, Name: "Grammar", Weight: 1},
+	}
+	testutil.SeedTestData(t, db, []models.Rating{
+		{ID: 1, Rating: 5, TicketID: 1, RatingCategoryID: 1, CreatedAt: startDate.Add(time.Hour)},
+		{ID: 2, Rating: 4, TicketID: 2, RatingCategoryID: 2, CreatedAt: endDate.Add(10 * time.Hour)}, // end date
+		{ID: 3, Rating: 3, TicketID: 3, RatingCategoryID: 2, CreatedAt: endDate.Add(24 * time.Hour)}, // after range
+	}, categories)
+	service := NewRatingAnalyticsService(repository.NewRatingCategoryRepository(db), repository.NewRatingsRepository(db), NewTicketScoreService())
+
+	coverage, err := service.GetCategoryCoverage(context.Background(), startDate, endDate)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expected := []CategoryCoverage{
+		{CategoryName: "Spelling", RatedTickets: 1, TotalTickets: 2, CoveragePct: "50%"},
+		{CategoryName: "Grammar", RatedTickets: 1, TotalTickets: 2, CoveragePct: "50%"},
+	}
+	if !reflect.DeepEqual(coverage, expected) {
+		t.Errorf("Expected %+v, got %+v", expected, coverage)
+	}
+}
+
 func TestCalculateScores(t *testing.T) {
 	tests := []struct {
 		name                string
 		startDate           time.Time
 		endDate             time.Time
-		expectedAggregation string // "daily" or "weekly"
+		thresholdDays       int // 0 keeps the default
+		expectedAggregation AggregationMode
 	}{
 		{
 			name:                "short range - daily aggregation",
 			startDate:           time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
 			endDate:             time.Date(2024, 1, 5, 0, 0, 0, 0, time.UTC),
-			expectedAggregation: "daily",
+			expectedAggregation: AggregationDaily,
 		},
 		{
-			name:                "long range - weekly aggregation",
+			name:                "medium range - monthly aggregation",
 			startDate:           time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
 			endDate:             time.Date(2024, 2, 15, 0, 0, 0, 0, time.UTC),
-			expectedAggregation: "weekly",
+			expectedAggregation: AggregationMonthly,
+		},
+		{
+			name:                "90 days - monthly aggregation",
+			startDate:           time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+			endDate:             time.Date(2024, 3, 31, 0, 0, 0, 0, time.UTC),
+			expectedAggregation: AggregationMonthly,
+		},
+		{
+			name:                "long range - weekly aggregation",
+			startDate:           time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+			endDate:             time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC),
+			expectedAggregation: AggregationWeekly,
+		},
+		{
+			name:                "range at lowered threshold - daily aggregation",
+			startDate:           time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+			endDate:             time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC),
+			thresholdDays:       14,
+			expectedAggregation: AggregationDaily,
+		},
+		{
+			name:                "range over lowered threshold - monthly aggregation",
+			startDate:           time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+			endDate:             time.Date(2024, 1, 16, 0, 0, 0, 0, time.UTC),
+			thresholdDays:       14,
+			expectedAggregation: AggregationMonthly,
+		},
+		{
+			name:                "long range under raised threshold - daily aggregation",
+			startDate:           time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+			endDate:             time.Date(2024, 4, 15, 0, 0, 0, 0, time.UTC),
+			thresholdDays:       120,
+			expectedAggregation: AggregationDaily,
+		},
+		{
+			name:                "range over raised threshold - weekly aggregation",
+			startDate:           time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+			endDate:             time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC),
+			thresholdDays:       120,
+			expectedAggregation: AggregationWeekly,
 		},
 	}
 
+	// Layout of each period's date
+	layouts := map[AggregationMode]string{
+		AggregationDaily:   "2006-01-02",
+		AggregationMonthly: "2006-01",
+		AggregationWeekly:  "2006-01-02 to 2006-01-02",
+	}
+
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			categoryRepo := &mockCategoryRepo{}
 			ratingsRepo := &mocks.MockRatingsRepo{Ratings: map[string][]models.Rating{}}
 			ticketScoreServ := &mockTicketScoreService{score: 75.0}
-			service := NewRatingAnalyticsService(categoryRepo, ratingsRepo, ticketScoreServ)
+			var opts []RatingAnalyticsOption
+			if tt.thresholdDays > 0 {
+				opts = append(opts, WithAggregationThresholdDays(tt.thresholdDays))
+			}
+			service := NewRatingAnalyticsService(categoryRepo, ratingsRepo, ticketScoreServ, opts...)
+
+			if aggregation := service.determineAggregation(tt.startDate, tt.endDate); aggregation != tt.expectedAggregation {
+				t.Errorf("expected %s aggregation, got %s", tt.expectedAggregation, aggregation)
+			}
 
 			category := models.RatingCategory{ID: 1, Name: "Spelling", Weight: 10}
 			scores, _, err := service.calculateScores(context.Background(), category, tt.startDate, tt.endDate)
@@ -175,23 +397,49 @@ func TestCalculateScores(t *testing.T) {
 			}
 
 			// Check aggregation type based on date format
-			if tt.expectedAggregation == "weekly" {
-				for _, score := range scores {
-					if !strings.Contains(score.Date, " to ") {
-						t.Errorf("expected weekly format with 'to' separator, got %s", score.Date)
-					}
-				}
-			} else {
-				for _, score := range scores {
-					if strings.Contains(score.Date, " to ") {
-						t.Errorf("expected daily format without 'to' separator, got %s", score.Date)
-					}
+			layout := layouts[tt.expectedAggregation]
+			for _, score := range scores {
+				if len(score.Date) != len(layout) {
+					t.Errorf("expected %s format %q, got %s", tt.expectedAggregation, layout, score.Date)
 				}
 			}
 		})
 	}
 }
 
+func TestCalculateMonthlyScores(t *testing.T) {
+	startDate := time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2024, 3, 10, 0, 0, 0, 0, time.UTC)
+
+	ratings := map[string][]models.Rating{
+		"all": {
+			{ID: 1, Rating: 1, RatingCategoryID: 1, CreatedAt: time.Date(2024, 1, 10, 9, 0, 0, 0, time.UTC)}, // Before the range
+			{ID: 2, Rating: 4, RatingCategoryID: 1, CreatedAt: time.Date(2024, 1, 20, 9, 0, 0, 0, time.UTC)},
+			{ID: 3, Rating: 5, RatingCategoryID: 1, CreatedAt: time.Date(2024, 3, 5, 9, 0, 0, 0, time.UTC)},
+			{ID: 4, Rating: 1, RatingCategoryID: 1, CreatedAt: time.Date(2024, 3, 20, 9, 0, 0, 0, time.UTC)}, // After the range
+		},
+	}
+	service := NewRatingAnalyticsService(&mockCategoryRepo{}, &mocks.MockRatingsRepo{Ratings: ratings}, NewTicketScoreService())
+
+	category := models.RatingCategory{ID: 1, Name: "Spelling", Weight: 1}
+	scores, totalRatings, err := service.calculateMonthlyScores(context.Background(), category, startDate, endDate)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := []DailyScore{
+		{Date: "2024-01", Score: "80%"},
+		{Date: "2024-02", Score: "N/A"},
+		{Date: "2024-03", Score: "100%"},
+	}
+	if !reflect.DeepEqual(scores, expected) {
+		t.Errorf("expected %v, got %v", expected, scores)
+	}
+	if len(totalRatings) != 2 {
+		t.Errorf("expected the 2 ratings inside the range, got %d", len(totalRatings))
+	}
+}
+
 func TestCalculateDailyScore(t *testing.T) {
 	ticketScoreServ := &mockTicketScoreService{score: 75.0}
 	service := &RatingAnalyticsService{
@@ -245,6 +493,7 @@ func TestCalculateOverallScore(t *testing.T) {
 		ratings       []models.Rating
 		mockScore     float64
 		mockError     error
+		precision     int
 		expectedScore string
 	}{
 		{
@@ -277,6 +526,24 @@ func TestCalculateOverallScore(t *testing.T) {
 			mockError:     fmt.Errorf("calculation error"),
 			expectedScore: "N/A",
 		},
+		{
+			name: "one decimal place",
+			ratings: []models.Rating{
+				{ID: 1, Rating: 4, RatingCategoryID: 1},
+			},
+			mockScore:     85.333,
+			precision:     1,
+			expectedScore: "85.3%",
+		},
+		{
+			name: "two decimal places",
+			ratings: []models.Rating{
+				{ID: 1, Rating: 4, RatingCategoryID: 1},
+			},
+			mockScore:     85.333,
+			precision:     2,
+			expectedScore: "85.33%",
+		},
 	}
 
 	for _, tt := range tests {
@@ -284,6 +551,7 @@ func TestCalculateOverallScore(t *testing.T) {
 			// Set mock values for this test
 			ticketScoreServ.score = tt.mockScore
 			ticketScoreServ.err = tt.mockError
+			service.scorePrecision = tt.precision
 
 			result := service.calculateOverallScore(tt.ratings, category)
 
@@ -294,6 +562,37 @@ func TestCalculateOverallScore(t *testing.T) {
 	}
 }
 
+func TestCalculateRatingStdDev(t *testing.T) {
+	service := &RatingAnalyticsService{ticketScoreServ: NewTicketScoreService()}
+
+	tests := []struct {
+		name           string
+		ratings        []int
+		precision      int
+		expectedStdDev string
+	}{
+		{name: "no ratings", expectedStdDev: "N/A"},
+		{name: "single rating", ratings: []int{4}, expectedStdDev: "N/A"},
+		{name: "two ratings", ratings: []int{4, 5}, expectedStdDev: "10%"},
+		{name: "equal ratings", ratings: []int{3, 3, 3}, expectedStdDev: "0%"},
+		{name: "one decimal place", ratings: []int{1, 3, 5}, precision: 1, expectedStdDev: "32.7%"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ratings := make([]models.Rating, len(tt.ratings))
+			for i, rating := range tt.ratings {
+				ratings[i] = models.Rating{ID: i + 1, Rating: rating, RatingCategoryID: 1}
+			}
+			service.scorePrecision = tt.precision
+
+			if result := service.calculateRatingStdDev(ratings); result != tt.expectedStdDev {
+				t.Errorf("expected standard deviation %s, got %s", tt.expectedStdDev, result)
+			}
+		})
+	}
+}
+
 func TestFormatScore(t *testing.T) {
 	tests := []struct {
 		score    float64
@@ -312,3 +611,612 @@ func TestFormatScore(t *testing.T) {
 		}
 	}
 }
+
+func TestGetCategoryExtremeRatingCounts(t *testing.T) {
+	day := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	ratings := map[string][]models.Rating{
+		"1-2024-01-01": {
+			{ID: 1, Rating: 5, RatingCategoryID: 1, CreatedAt: day.Add(time.Hour)},
+			{ID: 2, Rating: 4, RatingCategoryID: 1, CreatedAt: day.Add(time.Hour)},
+			{ID: 3, Rating: 1, RatingCategoryID: 1, CreatedAt: day.Add(time.Hour)},
+		},
+		"2-2024-01-01": {
+			{ID: 4, Rating: 5, RatingCategoryID: 2, CreatedAt: day.Add(time.Hour)},
+		},
+	}
+
+	service := NewRatingAnalyticsService(&mockCategoryRepo{}, &mocks.MockRatingsRepo{Ratings: ratings}, &mockTicketScoreService{})
+
+	counts, err := service.GetCategoryExtremeRatingCounts(context.Background(), 1, 4, day, day)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if counts.CategoryID != 1 || counts.Threshold != 4 {
+		t.Errorf("Unexpected category or threshold: %+v", counts)
+	}
+	if counts.AboveThreshold != 2 {
+		t.Errorf("Expected 2 ratings above threshold, got %d", counts.AboveThreshold)
+	}
+	if counts.BelowThreshold != 2 {
+		t.Errorf("Expected 2 ratings below threshold, got %d", counts.BelowThreshold)
+	}
+
+	errorService := NewRatingAnalyticsService(&mockCategoryRepo{}, &mocks.MockRatingsRepo{CountErr: fmt.Errorf("database error")}, &mockTicketScoreService{})
+	if _, err := errorService.GetCategoryExtremeRatingCounts(context.Background(), 1, 4, day, day); err == nil {
+		t.Error("Expected error but got none")
+	}
+}
+
+// calculateDailyScoresPerDay is the previous implementation of calculateDailyScores,
+// issuing one repository call per day. It is kept as a reference for the bulk version.
+func (s *RatingAnalyticsService) calculateDailyScoresPerDay(ctx context.Context, category models.RatingCategory, startDate, endDate time.Time) ([]DailyScore, []models.Rating, error) {
+	var scores []DailyScore
+	var totalRatings []models.Rating
+
+	currentDate := startDate
+	for !currentDate.After(endDate) {
+		dailyRatings, err := s.ratingsRepo.GetByCategoryIDAndDate(ctx, category.ID, currentDate)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		dateStr := currentDate.Format("2006-01-02")
+		scores = append(scores, s.calculateDailyScore(dailyRatings, category, dateStr))
+
+		if len(dailyRatings) > 0 {
+			totalRatings = append(totalRatings, dailyRatings...)
+		}
+
+		currentDate = currentDate.AddDate(0, 0, 1)
+	}
+
+	return scores, totalRatings, nil
+}
+
+// generateDailyRatings creates a few ratings per day and category, keyed like the mock repository expects
+func generateDailyRatings(startDate time.Time, days, categories int) map[string][]models.Rating {
+	ratings := make(map[string][]models.Rating)
+	id := 1
+	for day := 0; day < days; day++ {
+		date := startDate.AddDate(0, 0, day)
+		for categoryID := 1; categoryID <= categories; categoryID++ {
+			// Leave some days empty so N/A entries are covered
+			if (day+categoryID)%4 == 0 {
+				continue
+			}
+
+			key := fmt.Sprintf("%d-%s", categoryID, date.Format("2006-01-02"))
+			for i := 0; i < 1+(day+categoryID)%3; i++ {
+				ratings[key] = append(ratings[key], models.Rating{
+					ID:               id,
+					Rating:           (id*7 + day) % 6,
+					TicketID:         id,
+					RatingCategoryID: categoryID,
+					CreatedAt:        date.Add(time.Duration(i*5+1) * time.Hour),
+				})
+				id++
+			}
+		}
+	}
+	return ratings
+}
+
+func TestCalculateDailyScores_MatchesPerDayQueries(t *testing.T) {
+	startDate := time.Date(2019, 10, 1, 0, 0, 0, 0, time.UTC)
+	endDate := startDate.AddDate(0, 0, 29)
+
+	ratingsRepo := &mocks.MockRatingsRepo{Ratings: generateDailyRatings(startDate, 30, 5)}
+	service := NewRatingAnalyticsService(&mockCategoryRepo{}, ratingsRepo, NewTicketScoreService())
+
+	for categoryID := 1; categoryID <= 5; categoryID++ {
+		category := models.RatingCategory{ID: categoryID, Name: fmt.Sprintf("Category %d", categoryID), Weight: 1}
+
+		t.Run(category.Name, func(t *testing.T) {
+			expectedScores, expectedRatings, err := service.calculateDailyScoresPerDay(context.Background(), category, startDate, endDate)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			scores, totalRatings, err := service.calculateDailyScores(context.Background(), category, startDate, endDate)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if !reflect.DeepEqual(scores, expectedScores) {
+				t.Errorf("Daily scores differ from per-day approach:\n got: %v\nwant: %v", scores, expectedScores)
+			}
+			if len(totalRatings) != len(expectedRatings) {
+				t.Errorf("Expected %d total ratings, got %d", len(expectedRatings), len(totalRatings))
+			}
+		})
+	}
+
+	t.Run("repository error", func(t *testing.T) {
+		errorService := NewRatingAnalyticsService(&mockCategoryRepo{}, &mocks.MockRatingsRepo{Err: fmt.Errorf("database error")}, NewTicketScoreService())
+		category := models.RatingCategory{ID: 1, Name: "Spelling", Weight: 1}
+
+		if _, _, err := errorService.calculateDailyScores(context.Background(), category, startDate, endDate); err == nil {
+			t.Error("Expected error but got none")
+		}
+	})
+}
+
+// newBenchmarkRatingsRepo seeds an in-memory SQLite database so the benchmark includes real query round trips
+func newBenchmarkRatingsRepo(b *testing.B, ratings map[string][]models.Rating) *repository.RatingsRepository {
+	b.Helper()
+
+	var seed []models.Rating
+	for _, dailyRatings := range ratings {
+		seed = append(seed, dailyRatings...)
+	}
+	db := testutil.NewTestDB(b)
+	testutil.SeedTestData(b, db, seed, nil)
+
+	return repository.NewRatingsRepository(db)
+}
+
+func BenchmarkCalculateDailyScores(b *testing.B) {
+	startDate := time.Date(2019, 10, 1, 0, 0, 0, 0, time.UTC)
+	endDate := startDate.AddDate(0, 0, 29)
+
+	ratingsRepo := newBenchmarkRatingsRepo(b, generateDailyRatings(startDate, 30, 5))
+	service := NewRatingAnalyticsService(&mockCategoryRepo{}, ratingsRepo, NewTicketScoreService())
+
+	categories := make([]models.RatingCategory, 5)
+	for i := range categories {
+		categories[i] = models.RatingCategory{ID: i + 1, Name: fmt.Sprintf("Category %d", i+1), Weight: 1}
+	}
+
+	b.Run("per-day", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			for _, category := range categories {
+				if _, _, err := service.calculateDailyScoresPerDay(context.Background(), category, startDate, endDate); err != nil {
+					b.Fatal(err)
+				}
+			}
+		}
+	})
+
+	b.Run("bulk", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			for _, category := range categories {
+				if _, _, err := service.calculateDailyScores(context.Background(), category, startDate, endDate); err != nil {
+					b.Fatal(err)
+				}
+			}
+		}
+	})
+}
+
+func TestGetScoreImprovementPotential(t *testing.T) {
+	startDate := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2024, 1, 7, 0, 0, 0, 0, time.UTC)
+	createdAt := startDate.Add(time.Hour)
+
+	categories := []models.RatingCategory{
+		{ID: 1, Name: "Spelling", Weight: 1},  // low score, low weight
+		{ID: 2, Name: "Grammar", Weight: 3},   // low score, high weight
+		{ID: 3, Name: "Tone", Weight: 5},      // high score, high weight
+		{ID: 4, Name: "Empathy", Weight: 0.5}, // no ratings
+	}
+	ratings := map[string][]models.Rating{
+		"1-2024-01-01": {{ID: 1, RatingCategoryID: 1, Rating: 2, CreatedAt: createdAt}}, // 40%
+		"2-2024-01-01": {{ID: 2, RatingCategoryID: 2, Rating: 2, CreatedAt: createdAt}}, // 40%
+		"3-2024-01-01": {{ID: 3, RatingCategoryID: 3, Rating: 5, CreatedAt: createdAt}}, // 100%
+	}
+
+	service := NewRatingAnalyticsService(&mockCategoryRepo{categories: categories}, &mocks.MockRatingsRepo{Ratings: ratings}, NewTicketScoreService())
+
+	opportunities, err := service.GetScoreImprovementPotential(context.Background(), startDate, endDate)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expected := []ImprovementOpportunity{
+		{CategoryName: "Grammar", CurrentScore: "40%", ImprovementPotential: 60, WeightedPotential: 180, Priority: 1},
+		{CategoryName: "Spelling", CurrentScore: "40%", ImprovementPotential: 60, WeightedPotential: 60, Priority: 2},
+		{CategoryName: "Tone", CurrentScore: "100%", ImprovementPotential: 0, WeightedPotential: 0, Priority: 3},
+	}
+	if len(opportunities) != len(expected) {
+		t.Fatalf("Expected %d opportunities, got %d: %+v", len(expected), len(opportunities), opportunities)
+	}
+	for i, opportunity := range opportunities {
+		if opportunity != expected[i] {
+			t.Errorf("Expected %+v at position %d, got %+v", expected[i], i, opportunity)
+		}
+	}
+
+	t.Run("category error", func(t *testing.T) {
+		errorService := NewRatingAnalyticsService(&mockCategoryRepo{err: fmt.Errorf("database error")}, &mocks.MockRatingsRepo{}, NewTicketScoreService())
+		if _, err := errorService.GetScoreImprovementPotential(context.Background(), startDate, endDate); err == nil {
+			t.Error("Expected error but got none")
+		}
+	})
+}
+
+func TestGetMonthlyCategoryHeatmap(t *testing.T) {
+	ratings := map[string][]models.Rating{
+		"1-2019-01-15": {{ID: 1, RatingCategoryID: 1, Rating: 4, CreatedAt: time.Date(2019, 1, 15, 10, 0, 0, 0, time.UTC)}},   // 80%
+		"1-2019-12-31": {{ID: 2, RatingCategoryID: 1, Rating: 5, CreatedAt: time.Date(2019, 12, 31, 23, 0, 0, 0, time.UTC)}},  // 100%
+		"2-2019-06-01": {{ID: 3, RatingCategoryID: 2, Rating: 1, CreatedAt: time.Date(2019, 6, 1, 0, 0, 0, 0, time.UTC)}},     // 20%
+		"2-2020-01-01": {{ID: 4, RatingCategoryID: 2, Rating: 5, CreatedAt: time.Date(2020, 1, 1, 0, 30, 0, 0, time.UTC)}},    // next year
+		"1-2018-12-31": {{ID: 5, RatingCategoryID: 1, Rating: 0, CreatedAt: time.Date(2018, 12, 31, 23, 30, 0, 0, time.UTC)}}, // previous year
+	}
+
+	tests := []struct {
+		name       string
+		categories []models.RatingCategory
+		expected   map[[2]int]string // [category, month] -> score; all other cells are N/A
+	}{
+		{
+			name: "scores by category and month",
+			categories: []models.RatingCategory{
+				{ID: 1, Name: "Spelling", Weight: 1},
+				{ID: 2, Name: "Grammar", Weight: 1},
+				{ID: 3, Name: "Tone", Weight: 1},
+			},
+			expected: map[[2]int]string{{0, 0}: "80%", {0, 11}: "100%", {1, 5}: "20%"},
+		},
+		{
+			name:       "no categories",
+			categories: []models.RatingCategory{},
+			expected:   map[[2]int]string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := NewRatingAnalyticsService(&mockCategoryRepo{categories: tt.categories}, &mocks.MockRatingsRepo{Ratings: ratings}, NewTicketScoreService())
+
+			heatmap, err := service.GetMonthlyCategoryHeatmap(context.Background(), 2019)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if heatmap.Year != 2019 {
+				t.Errorf("Expected year 2019, got %d", heatmap.Year)
+			}
+			if len(heatmap.Months) != 12 || heatmap.Months[0] != "2019-01" || heatmap.Months[11] != "2019-12" {
+				t.Errorf("Unexpected months: %v", heatmap.Months)
+			}
+			if len(heatmap.Categories) != len(tt.categories) {
+				t.Fatalf("Expected %d categories, got %d", len(tt.categories), len(heatmap.Categories))
+			}
+			if len(heatmap.ScoreMatrix) != len(tt.categories) {
+				t.Fatalf("Expected %d rows, got %d", len(tt.categories), len(heatmap.ScoreMatrix))
+			}
+
+			for i, row := range heatmap.ScoreMatrix {
+				if heatmap.Categories[i] != tt.categories[i].Name {
+					t.Errorf("Expected category %s at row %d, got %s", tt.categories[i].Name, i, heatmap.Categories[i])
+				}
+				if len(row) != 12 {
+					t.Fatalf("Expected 12 months in row %d, got %d", i, len(row))
+				}
+				for month, score := range row {
+					expected, ok := tt.expected[[2]int{i, month}]
+					if !ok {
+						expected = "N/A"
+					}
+					if score != expected {
+						t.Errorf("Expected score %s for %s in %s, got %s", expected, heatmap.Categories[i], heatmap.Months[month], score)
+					}
+				}
+			}
+		})
+	}
+
+	t.Run("category error", func(t *testing.T) {
+		errorService := NewRatingAnalyticsService(&mockCategoryRepo{err: fmt.Errorf("database error")}, &mocks.MockRatingsRepo{}, NewTicketScoreService())
+		if _, err := errorService.GetMonthlyCategoryHeatmap(context.Background(), 2019); err == nil {
+			t.Error("Expected error but got none")
+		}
+	})
+}
+
+func TestGetCategoryScoreComparison(t *testing.T) {
+	startDate := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC)
+	day := func(offset int) time.Time { return startDate.AddDate(0, 0, offset).Add(time.Hour) }
+
+	categories := []models.RatingCategory{
+		{ID: 1, Name: "Spelling", Weight: 1},
+		{ID: 2, Name: "Grammar", Weight: 1},
+	}
+	ratings := map[string][]models.Rating{
+		// Both categories have data on Jan 1 and Jan 2; only Spelling on Jan 3
+		"1-2024-01-01": {{ID: 1, RatingCategoryID: 1, Rating: 5, CreatedAt: day(0)}}, // 100%
+		"2-2024-01-01": {{ID: 2, RatingCategoryID: 2, Rating: 4, CreatedAt: day(0)}}, // 80%
+		"1-2024-01-02": {{ID: 3, RatingCategoryID: 1, Rating: 3, CreatedAt: day(1)}}, // 60%
+		"2-2024-01-02": {{ID: 4, RatingCategoryID: 2, Rating: 4, CreatedAt: day(1)}}, // 80%
+		"1-2024-01-03": {{ID: 5, RatingCategoryID: 1, Rating: 4, CreatedAt: day(2)}}, // 80%
+	}
+
+	service := NewRatingAnalyticsService(&mockCategoryRepo{categories: categories}, &mocks.MockRatingsRepo{Ratings: ratings}, NewTicketScoreService())
+
+	tests := []struct {
+		name     string
+		endDate  time.Time
+		expected []ComparisonPoint
+	}{
+		{
+			name:    "both have data",
+			endDate: startDate.AddDate(0, 0, 1),
+			expected: []ComparisonPoint{
+				{Date: "2024-01-01", Score1: "100%", Score2: "80%", Difference: "+20%"},
+				{Date: "2024-01-02", Score1: "60%", Score2: "80%", Difference: "-20%"},
+			},
+		},
+		{
+			name:    "one has N/A on a day",
+			endDate: endDate,
+			expected: []ComparisonPoint{
+				{Date: "2024-01-01", Score1: "100%", Score2: "80%", Difference: "+20%"},
+				{Date: "2024-01-02", Score1: "60%", Score2: "80%", Difference: "-20%"},
+				{Date: "2024-01-03", Score1: "80%", Score2: "N/A", Difference: "N/A"},
+			},
+		},
+		{
+			name:    "both have N/A on the same day",
+			endDate: endDate.AddDate(0, 0, 1),
+			expected: []ComparisonPoint{
+				{Date: "2024-01-01", Score1: "100%", Score2: "80%", Difference: "+20%"},
+				{Date: "2024-01-02", Score1: "60%", Score2: "80%", Difference: "-20%"},
+				{Date: "2024-01-03", Score1: "80%", Score2: "N/A", Difference: "N/A"},
+				{Date: "2024-01-04", Score1: "N/A", Score2: "N/A", Difference: "N/A"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			comparison, err := service.GetCategoryScoreComparison(context.Background(), 1, 2, startDate, tt.endDate)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if comparison.Category1 != "Spelling" || comparison.Category2 != "Grammar" {
+				t.Errorf("Expected Spelling and Grammar, got %s and %s", comparison.Category1, comparison.Category2)
+			}
+			if len(comparison.Points) != len(tt.expected) {
+				t.Fatalf("Expected %d points, got %d", len(tt.expected), len(comparison.Points))
+			}
+			for i, point := range comparison.Points {
+				if point != tt.expected[i] {
+					t.Errorf("Expected %+v at position %d, got %+v", tt.expected[i], i, point)
+				}
+			}
+		})
+	}
+
+	t.Run("unknown category", func(t *testing.T) {
+		_, err := service.GetCategoryScoreComparison(context.Background(), 1, 99, startDate, endDate)
+		if !errors.Is(err, ErrCategoryNotFound) {
+			t.Errorf("Expected ErrCategoryNotFound, got %v", err)
+		}
+	})
+}
+
+func TestGetRatingsByCategoryAndReviewer(t *testing.T) {
+	startDate := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC)
+	day := func(offset int) time.Time { return startDate.AddDate(0, 0, offset).Add(time.Hour) }
+
+	categories := []models.RatingCategory{
+		{ID: 1, Name: "Spelling", Weight: 1},
+		{ID: 2, Name: "Grammar", Weight: 1},
+	}
+	ratings := map[string][]models.Rating{
+		"1-2024-01-01": {{ID: 1, RatingCategoryID: 1, ReviewerID: 7, Rating: 5, CreatedAt: day(0)}},
+		"2-2024-01-01": {{ID: 2, RatingCategoryID: 2, ReviewerID: 8, Rating: 4, CreatedAt: day(0)}},
+		"1-2024-01-02": {{ID: 3, RatingCategoryID: 1, ReviewerID: 8, Rating: 3, CreatedAt: day(1)}},
+		"1-2024-01-03": {{ID: 4, RatingCategoryID: 1, ReviewerID: 7, Rating: 2, CreatedAt: day(2)}},
+	}
+
+	service := NewRatingAnalyticsService(&mockCategoryRepo{categories: categories}, &mocks.MockRatingsRepo{Ratings: ratings}, NewTicketScoreService())
+
+	tests := []struct {
+		name        string
+		categoryID  int
+		reviewerID  int
+		expectedIDs []int
+		expectedErr error
+	}{
+		{name: "reviewer with ratings in the category", categoryID: 1, reviewerID: 7, expectedIDs: []int{1, 4}},
+		{name: "reviewer with ratings only in another category", categoryID: 2, reviewerID: 7},
+		{name: "unknown category", categoryID: 99, reviewerID: 7, expectedErr: ErrCategoryNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := service.GetRatingsByCategoryAndReviewer(context.Background(), tt.categoryID, tt.reviewerID, startDate, endDate)
+			if !errors.Is(err, tt.expectedErr) {
+				t.Fatalf("Expected error %v, got %v", tt.expectedErr, err)
+			}
+
+			if len(result) != len(tt.expectedIDs) {
+				t.Fatalf("Expected %d ratings, got %d", len(tt.expectedIDs), len(result))
+			}
+			for i, id := range tt.expectedIDs {
+				if result[i].ID != id {
+					t.Errorf("Expected rating %d at position %d, got %d", id, i, result[i].ID)
+				}
+			}
+		})
+	}
+}
+
+func TestFormatScoreDifference(t *testing.T) {
+	service := &RatingAnalyticsService{}
+
+	tests := []struct {
+		score1   string
+		score2   string
+		expected string
+	}{
+		{"85%", "80%", "+5%"},
+		{"77%", "80%", "-3%"},
+		{"80%", "80%", "0%"},
+		{"N/A", "80%", "N/A"},
+		{"80%", "N/A", "N/A"},
+		{"N/A", "N/A", "N/A"},
+	}
+
+	for _, tt := range tests {
+		if result := service.formatScoreDifference(tt.score1, tt.score2); result != tt.expected {
+			t.Errorf("formatScoreDifference(%s, %s) = %s, expected %s", tt.score1, tt.score2, result, tt.expected)
+		}
+	}
+}
+
+func TestImportRatings_Validation(t *testing.T) {
+	valid := models.Rating{ID: 1, Rating: 5, TicketID: 1, RatingCategoryID: 1, ReviewerID: 2, RevieweeID: 3}
+
+	tests := []struct {
+		name        string
+		modify      func(*models.Rating)
+		maxRating   int
+		expectError bool
+	}{
+		{name: "valid rating", modify: func(r *models.Rating) {}},
+		{name: "database assigned ID", modify: func(r *models.Rating) { r.ID = 0 }},
+		{name: "rating of zero", modify: func(r *models.Rating) { r.Rating = 0 }},
+		{name: "negative ID", modify: func(r *models.Rating) { r.ID = -1 }, expectError: true},
+		{name: "negative rating", modify: func(r *models.Rating) { r.Rating = -1 }, expectError: true},
+		{name: "rating above the maximum", modify: func(r *models.Rating) { r.Rating = 6 }, expectError: true},
+		{name: "ten-point scale", modify: func(r *models.Rating) { r.Rating = 9 }, maxRating: 10},
+		{name: "missing ticket", modify: func(r *models.Rating) { r.TicketID = 0 }, expectError: true},
+		{name: "missing category", modify: func(r *models.Rating) { r.RatingCategoryID = 0 }, expectError: true},
+		{name: "missing reviewer", modify: func(r *models.Rating) { r.ReviewerID = 0 }, expectError: true},
+		{name: "missing reviewee", modify: func(r *models.Rating) { r.RevieweeID = -2 }, expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			maxRating := defaultMaxRating
+			if tt.maxRating > 0 {
+				maxRating = tt.maxRating
+			}
+			ratingsRepo := &mocks.MockRatingsRepo{}
+			service := NewRatingAnalyticsService(&mockCategoryRepo{}, ratingsRepo, NewTicketScoreService(WithMaxRating(maxRating)))
+			imports := 0
+			service.OnRatingsImported(func() { imports++ })
+
+			rating := valid
+			tt.modify(&rating)
+			err := service.ImportRatings(context.Background(), []models.Rating{valid, rating})
+
+			if tt.expectError {
+				if !errors.Is(err, ErrInvalidRating) {
+					t.Errorf("Expected ErrInvalidRating, got %v", err)
+				}
+				if len(ratingsRepo.Inserted) != 0 || imports != 0 {
+					t.Errorf("Expected nothing inserted, got %d batches and %d notifications", len(ratingsRepo.Inserted), imports)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if len(ratingsRepo.Inserted) != 1 || imports != 1 {
+				t.Errorf("Expected 1 batch inserted and notified, got %d batches and %d notifications", len(ratingsRepo.Inserted), imports)
+			}
+		})
+	}
+}
+
+func TestAnalyticsForRatings(t *testing.T) {
+	day := time.Date(2019, 10, 1, 1, 0, 0, 0, time.UTC)
+
+	categories := []models.RatingCategory{
+		{ID: 1, Name: "Spelling", Weight: 1},
+		{ID: 2, Name: "Grammar", Weight: 1},
+	}
+	// Reviewer 1 rated reviewee 1 and reviewer 2 rated reviewee 2, so both lookups see the same ratings
+	ratings := map[string][]models.Rating{
+		"1-2019-10-01": {
+			{ID: 1, RatingCategoryID: 1, ReviewerID: 1, RevieweeID: 1, Rating: 4, CreatedAt: day},
+			{ID: 2, RatingCategoryID: 1, ReviewerID: 2, RevieweeID: 2, Rating: 1, CreatedAt: day},
+		},
+		"1-2019-10-03": {{ID: 3, RatingCategoryID: 1, ReviewerID: 1, RevieweeID: 1, Rating: 5, CreatedAt: day.AddDate(0, 0, 2)}},
+		"2-2019-10-02": {{ID: 4, RatingCategoryID: 2, ReviewerID: 2, RevieweeID: 2, Rating: 3, CreatedAt: day.AddDate(0, 0, 1)}},
+	}
+
+	categoryRepo := &mockCategoryRepo{categories: categories}
+	ratingsRepo := &mocks.MockRatingsRepo{Ratings: ratings}
+	analytics := NewRatingAnalyticsService(categoryRepo, ratingsRepo, NewTicketScoreService())
+
+	reviewers := NewReviewerAnalyticsService(categoryRepo, ratingsRepo, NewTicketScoreService(), analytics)
+	reviewees := NewRevieweeAnalyticsService(categoryRepo, ratingsRepo, &mockTicketRepo{}, NewTicketScoreService(), analytics)
+
+	daily := []CategoryAnalytics{
+		{
+			CategoryID: 1, Category: "Spelling", Ratings: 2, Score: "90%", StdDev: "10%", Aggregation: "daily",
+			Dates: []DailyScore{{Date: "2019-10-01", Score: "80%"}, {Date: "2019-10-02", Score: "N/A"}, {Date: "2019-10-03", Score: "100%"}},
+		},
+		{
+			CategoryID: 2, Category: "Grammar", Ratings: 0, Score: "N/A", StdDev: "N/A", Aggregation: "daily",
+			Dates: []DailyScore{{Date: "2019-10-01", Score: "N/A"}, {Date: "2019-10-02", Score: "N/A"}, {Date: "2019-10-03", Score: "N/A"}},
+		},
+	}
+	monthly := []CategoryAnalytics{
+		{
+			CategoryID: 1, Category: "Spelling", Ratings: 2, Score: "90%", StdDev: "10%", Aggregation: "monthly",
+			Dates: []DailyScore{{Date: "2019-09", Score: "N/A"}, {Date: "2019-10", Score: "90%"}},
+		},
+		{
+			CategoryID: 2, Category: "Grammar", Ratings: 0, Score: "N/A", StdDev: "N/A", Aggregation: "monthly",
+			Dates: []DailyScore{{Date: "2019-09", Score: "N/A"}, {Date: "2019-10", Score: "N/A"}},
+		},
+	}
+
+	tests := []struct {
+		name      string
+		get       func(ctx context.Context, id int, startDate, endDate time.Time) ([]CategoryAnalytics, error)
+		startDate time.Time
+		endDate   time.Time
+		expected  []CategoryAnalytics
+	}{
+		{
+			name:      "reviewer daily scores",
+			get:       reviewers.GetReviewerAnalytics,
+			startDate: time.Date(2019, 10, 1, 0, 0, 0, 0, time.UTC),
+			endDate:   time.Date(2019, 10, 3, 0, 0, 0, 0, time.UTC),
+			expected:  daily,
+		},
+		{
+			name:      "reviewee daily scores",
+			get:       reviewees.GetRevieweeAnalytics,
+			startDate: time.Date(2019, 10, 1, 0, 0, 0, 0, time.UTC),
+			endDate:   time.Date(2019, 10, 3, 0, 0, 0, 0, time.UTC),
+			expected:  daily,
+		},
+		{
+			name:      "reviewer monthly scores",
+			get:       reviewers.GetReviewerAnalytics,
+			startDate: time.Date(2019, 9, 1, 0, 0, 0, 0, time.UTC),
+			endDate:   time.Date(2019, 10, 31, 0, 0, 0, 0, time.UTC),
+			expected:  monthly,
+		},
+		{
+			name:      "reviewee monthly scores",
+			get:       reviewees.GetRevieweeAnalytics,
+			startDate: time.Date(2019, 9, 1, 0, 0, 0, 0, time.UTC),
+			endDate:   time.Date(2019, 10, 31, 0, 0, 0, 0, time.UTC),
+			expected:  monthly,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := tt.get(context.Background(), 1, tt.startDate, tt.endDate)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("Expected %+v, got %+v", tt.expected, result)
+			}
+		})
+	}
+}
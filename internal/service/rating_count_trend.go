@@ -0,0 +1,45 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// PeriodCount holds the number of ratings given in a day, week or month
+type PeriodCount struct {
+	DateLabel string `json:"date_label"`
+	Count     int    `json:"count"`
+}
+
+// RatingCountTrend holds the number of ratings per period over a date range
+type RatingCountTrend struct {
+	Periods []PeriodCount `json:"periods"`
+}
+
+// GetRatingCountTrend counts the ratings in all categories per day, month or week, aggregating the
+// date range like GetCategoryAnalytics.
+func (s *RatingAnalyticsService) GetRatingCountTrend(ctx context.Context, startDate, endDate time.Time) (*RatingCountTrend, error) {
+	dailyCounts, err := s.ratingsRepo.GetCountByCategoryIDAndDateRange(ctx, startDate, endDate)
+	if err != nil {
+		return nil, logError(ctx, fmt.Errorf("failed to get daily rating counts: %w", err))
+	}
+
+	countBetween := func(from, to time.Time) int {
+		count := 0
+		for day := from; !day.After(to); day = day.AddDate(0, 0, 1) {
+			count += dailyCounts[day.Format("2006-01-02")]
+		}
+		return count
+	}
+
+	trend := &RatingCountTrend{}
+	for _, period := range aggregationPeriods(s.determineAggregation(startDate, endDate), startDate, endDate) {
+		trend.Periods = append(trend.Periods, PeriodCount{
+			DateLabel: period.label,
+			Count:     countBetween(period.start, period.end),
+		})
+	}
+
+	return trend, nil
+}
@@ -0,0 +1,130 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"ticket-score-service/internal/mocks"
+	"ticket-score-service/internal/models"
+)
+
+func TestGetRatingCountTrend(t *testing.T) {
+	// ratingsOn gives each day the given number of ratings, starting on the first day
+	ratingsOn := func(first time.Time, perDay ...int) map[string][]models.Rating {
+		ratings := make(map[string][]models.Rating)
+		id := 1
+		for i, count := range perDay {
+			day := first.AddDate(0, 0, i)
+			key := fmt.Sprintf("1-%s", day.Format("2006-01-02"))
+			for j := 0; j < count; j++ {
+				ratings[key] = append(ratings[key], models.Rating{ID: id, RatingCategoryID: 1, Rating: 4, CreatedAt: day.Add(time.Hour)})
+				id++
+			}
+		}
+		return ratings
+	}
+
+	tests := []struct {
+		name          string
+		ratings       map[string][]models.Rating
+		startDate     time.Time
+		endDate       time.Time
+		expected      []PeriodCount
+		expectedTotal int
+	}{
+		{
+			name:      "daily counts",
+			ratings:   ratingsOn(time.Date(2019, 9, 30, 0, 0, 0, 0, time.UTC), 4, 2, 0, 3, 1),
+			startDate: time.Date(2019, 10, 1, 0, 0, 0, 0, time.UTC),
+			endDate:   time.Date(2019, 10, 3, 0, 0, 0, 0, time.UTC),
+			expected: []PeriodCount{
+				{DateLabel: "2019-10-01", Count: 2},
+				{DateLabel: "2019-10-02", Count: 0},
+				{DateLabel: "2019-10-03", Count: 3},
+			},
+			expectedTotal: 5,
+		},
+		{
+			name:      "monthly counts for ranges of 31-90 days",
+			ratings:   ratingsOn(time.Date(2019, 10, 1, 0, 0, 0, 0, time.UTC), append(make([]int, 30), 1, 2, 3, 4, 5)...),
+			startDate: time.Date(2019, 10, 1, 0, 0, 0, 0, time.UTC),
+			endDate:   time.Date(2019, 11, 3, 0, 0, 0, 0, time.UTC),
+			expected: []PeriodCount{
+				{DateLabel: "2019-10", Count: 1},
+				{DateLabel: "2019-11", Count: 9},
+			},
+			expectedTotal: 10,
+		},
+		{
+			name:      "weekly counts for longer ranges",
+			ratings:   ratingsOn(time.Date(2019, 9, 30, 0, 0, 0, 0, time.UTC), append(make([]int, 13), 1, 2, 3)...),
+			startDate: time.Date(2019, 10, 1, 0, 0, 0, 0, time.UTC),
+			endDate:   time.Date(2020, 1, 5, 0, 0, 0, 0, time.UTC),
+			expected: []PeriodCount{
+				{DateLabel: "2019-09-30 to 2019-10-06", Count: 0},
+				{DateLabel: "2019-10-07 to 2019-10-13", Count: 1},
+				{DateLabel: "2019-10-14 to 2019-10-20", Count: 5},
+				{DateLabel: "2019-10-21 to 2019-10-27", Count: 0},
+				{DateLabel: "2019-10-28 to 2019-11-03", Count: 0},
+				{DateLabel: "2019-11-04 to 2019-11-10", Count: 0},
+				{DateLabel: "2019-11-11 to 2019-11-17", Count: 0},
+				{DateLabel: "2019-11-18 to 2019-11-24", Count: 0},
+				{DateLabel: "2019-11-25 to 2019-12-01", Count: 0},
+				{DateLabel: "2019-12-02 to 2019-12-08", Count: 0},
+				{DateLabel: "2019-12-09 to 2019-12-15", Count: 0},
+				{DateLabel: "2019-12-16 to 2019-12-22", Count: 0},
+				{DateLabel: "2019-12-23 to 2019-12-29", Count: 0},
+				{DateLabel: "2019-12-30 to 2020-01-05", Count: 0},
+			},
+			expectedTotal: 6,
+		},
+		{
+			name:      "no ratings",
+			ratings:   nil,
+			startDate: time.Date(2019, 10, 1, 0, 0, 0, 0, time.UTC),
+			endDate:   time.Date(2019, 10, 2, 0, 0, 0, 0, time.UTC),
+			expected: []PeriodCount{
+				{DateLabel: "2019-10-01", Count: 0},
+				{DateLabel: "2019-10-02", Count: 0},
+			},
+			expectedTotal: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := NewRatingAnalyticsService(&mockCategoryRepo{}, &mocks.MockRatingsRepo{Ratings: tt.ratings}, NewTicketScoreService())
+
+			trend, err := service.GetRatingCountTrend(context.Background(), tt.startDate, tt.endDate)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if len(trend.Periods) != len(tt.expected) {
+				t.Fatalf("Expected %d periods, got %+v", len(tt.expected), trend.Periods)
+			}
+			sum := 0
+			for i, period := range trend.Periods {
+				if period != tt.expected[i] {
+					t.Errorf("Expected %+v, got %+v", tt.expected[i], period)
+				}
+				sum += period.Count
+			}
+			if sum != tt.expectedTotal {
+				t.Errorf("Expected period counts to sum to %d, got %d", tt.expectedTotal, sum)
+			}
+		})
+	}
+
+	t.Run("repository error", func(t *testing.T) {
+		service := NewRatingAnalyticsService(&mockCategoryRepo{}, &mocks.MockRatingsRepo{CountErr: errors.New("database error")}, NewTicketScoreService())
+
+		start := time.Date(2019, 10, 1, 0, 0, 0, 0, time.UTC)
+		if _, err := service.GetRatingCountTrend(context.Background(), start, start); err == nil {
+			t.Error("Expected error but got none")
+		}
+	})
+}
@@ -0,0 +1,109 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"ticket-score-service/internal/models"
+)
+
+// MaxRatingsPageSize is the largest page GetRatingsPage returns
+const MaxRatingsPageSize = 1000
+
+// ErrInvalidRatingsQuery is returned when a ratings page request is malformed
+var ErrInvalidRatingsQuery = errors.New("invalid ratings query")
+
+// RatingsQueryRepository defines the interface for filtered ratings access
+type RatingsQueryRepository interface {
+	GetFilteredRatings(ctx context.Context, filter models.RatingsFilter, field models.RatingsSortField, direction models.SortDirection, limit, offset int) ([]models.Rating, error)
+	CountFilteredRatings(ctx context.Context, filter models.RatingsFilter) (int, error)
+	GetByReviewerIDAndTicketID(ctx context.Context, reviewerID, ticketID int) ([]models.Rating, error)
+}
+
+// RatingsPage is one page of raw ratings
+type RatingsPage struct {
+	Ratings    []models.Rating `json:"ratings"`
+	TotalCount int             `json:"total_count"`
+	HasMore    bool            `json:"has_more"`
+}
+
+// RatingsQueryService lets clients browse raw ratings
+type RatingsQueryService struct {
+	ratingsRepo RatingsQueryRepository
+}
+
+// NewRatingsQueryService creates a new ratings query service instance
+func NewRatingsQueryService(ratingsRepo RatingsQueryRepository) *RatingsQueryService {
+	return &RatingsQueryService{
+		ratingsRepo: ratingsRepo,
+	}
+}
+
+// GetRatingsPage gets the ratings matching filter, sorted by field in direction, skipping
+// offset ratings and returning at most limit
+func (s *RatingsQueryService) GetRatingsPage(ctx context.Context, filter models.RatingsFilter, field models.RatingsSortField, direction models.SortDirection, limit, offset int) (*RatingsPage, error) {
+	if err := validateRatingsQuery(filter, limit, offset); err != nil {
+		return nil, err
+	}
+
+	totalCount, err := s.ratingsRepo.CountFilteredRatings(ctx, filter)
+	if err != nil {
+		return nil, logError(ctx, fmt.Errorf("failed to count ratings: %w", err))
+	}
+
+	ratings, err := s.ratingsRepo.GetFilteredRatings(ctx, filter, field, direction, limit, offset)
+	if err != nil {
+		return nil, logError(ctx, fmt.Errorf("failed to get ratings: %w", err))
+	}
+	if ratings == nil {
+		ratings = []models.Rating{}
+	}
+
+	return &RatingsPage{
+		Ratings:    ratings,
+		TotalCount: totalCount,
+		HasMore:    offset+len(ratings) < totalCount,
+	}, nil
+}
+
+// GetRatingAuditTrail gets every rating a reviewer gave a ticket, oldest first. A reviewer who
+// never rated the ticket gets an empty list.
+func (s *RatingsQueryService) GetRatingAuditTrail(ctx context.Context, reviewerID, ticketID int) ([]models.Rating, error) {
+	if reviewerID <= 0 {
+		return nil, fmt.Errorf("%w: reviewer_id must be positive", ErrInvalidRatingsQuery)
+	}
+	if ticketID <= 0 {
+		return nil, fmt.Errorf("%w: ticket_id must be positive", ErrInvalidRatingsQuery)
+	}
+
+	ratings, err := s.ratingsRepo.GetByReviewerIDAndTicketID(ctx, reviewerID, ticketID)
+	if err != nil {
+		return nil, logError(ctx, fmt.Errorf("failed to get ratings: %w", err))
+	}
+	if ratings == nil {
+		ratings = []models.Rating{}
+	}
+
+	return ratings, nil
+}
+
+// validateRatingsQuery checks the paging bounds and that the filter ranges are not inverted
+func validateRatingsQuery(filter models.RatingsFilter, limit, offset int) error {
+	if limit <= 0 || limit > MaxRatingsPageSize {
+		return fmt.Errorf("%w: limit must be between 1 and %d", ErrInvalidRatingsQuery, MaxRatingsPageSize)
+	}
+	if offset < 0 {
+		return fmt.Errorf("%w: offset must not be negative", ErrInvalidRatingsQuery)
+	}
+	if (filter.MinRating != nil && *filter.MinRating < 0) || (filter.MaxRating != nil && *filter.MaxRating < 0) {
+		return fmt.Errorf("%w: ratings must not be negative", ErrInvalidRatingsQuery)
+	}
+	if filter.MinRating != nil && filter.MaxRating != nil && *filter.MinRating > *filter.MaxRating {
+		return fmt.Errorf("%w: min_rating must not exceed max_rating", ErrInvalidRatingsQuery)
+	}
+	if !filter.StartDate.IsZero() && !filter.EndDate.IsZero() && filter.EndDate.Before(filter.StartDate) {
+		return fmt.Errorf("%w: end_date must not be before start_date", ErrInvalidRatingsQuery)
+	}
+	return nil
+}
@@ -0,0 +1,123 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"ticket-score-service/internal/models"
+	"ticket-score-service/internal/repository"
+	"ticket-score-service/internal/testutil"
+)
+
+func TestRatingsQueryService_GetRatingsPage(t *testing.T) {
+	db := testutil.NewTestDB(t)
+	day := time.Date(2019, 10, 1, 0, 0, 0, 0, time.UTC)
+	ratings := make([]models.Rating, 5)
+	for i := range ratings {
+		ratings[i] = models.Rating{ID: i + 1, Rating: i + 1, TicketID: i + 1, RatingCategoryID: 1, CreatedAt: day.Add(time.Duration(i) * time.Hour)}
+	}
+	testutil.SeedTestData(t, db, ratings, nil)
+	service := NewRatingsQueryService(repository.NewRatingsRepository(db))
+	rating := func(value int) *int { return &value }
+
+	tests := []struct {
+		name          string
+		filter        models.RatingsFilter
+		limit         int
+		offset        int
+		expectedCount int
+		expectedTotal int
+		expectedMore  bool
+		expectedError bool
+	}{
+		{name: "first page", limit: 2, expectedCount: 2, expectedTotal: 5, expectedMore: true},
+		{name: "last page", limit: 2, offset: 4, expectedCount: 1, expectedTotal: 5},
+		{name: "exact fit", limit: 5, expectedCount: 5, expectedTotal: 5},
+		{name: "past the end", limit: 2, offset: 10, expectedCount: 0, expectedTotal: 5},
+		{name: "filtered total", filter: models.RatingsFilter{MinRating: rating(3)}, limit: 1, expectedCount: 1, expectedTotal: 3, expectedMore: true},
+		{name: "zero limit", limit: 0, expectedError: true},
+		{name: "limit too large", limit: MaxRatingsPageSize + 1, expectedError: true},
+		{name: "negative offset", limit: 1, offset: -1, expectedError: true},
+		{name: "inverted rating range", filter: models.RatingsFilter{MinRating: rating(4), MaxRating: rating(2)}, limit: 1, expectedError: true},
+		{name: "negative rating", filter: models.RatingsFilter{MaxRating: rating(-1)}, limit: 1, expectedError: true},
+		{name: "inverted date range", filter: models.RatingsFilter{StartDate: day, EndDate: day.AddDate(0, 0, -1)}, limit: 1, expectedError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			page, err := service.GetRatingsPage(context.Background(), tt.filter, models.SortByID, models.SortAscending, tt.limit, tt.offset)
+			if tt.expectedError {
+				if !errors.Is(err, ErrInvalidRatingsQuery) {
+					t.Errorf("Expected ErrInvalidRatingsQuery, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if len(page.Ratings) != tt.expectedCount {
+				t.Errorf("Expected %d ratings, got %d", tt.expectedCount, len(page.Ratings))
+			}
+			if page.TotalCount != tt.expectedTotal {
+				t.Errorf("Expected total %d, got %d", tt.expectedTotal, page.TotalCount)
+			}
+			if page.HasMore != tt.expectedMore {
+				t.Errorf("Expected has more %v, got %v", tt.expectedMore, page.HasMore)
+			}
+		})
+	}
+}
+
+func TestRatingsQueryService_GetRatingAuditTrail(t *testing.T) {
+	db := testutil.NewTestDB(t)
+	day := time.Date(2019, 10, 1, 0, 0, 0, 0, time.UTC)
+	testutil.SeedTestData(t, db, []models.Rating{
+		{ID: 1, Rating: 2, TicketID: 1, RatingCategoryID: 1, ReviewerID: 1, RevieweeID: 5, CreatedAt: day.Add(2 * time.Hour)},
+		{ID: 2, Rating: 4, TicketID: 1, RatingCategoryID: 2, ReviewerID: 1, RevieweeID: 5, CreatedAt: day.Add(time.Hour)},
+		{ID: 3, Rating: 3, TicketID: 1, RatingCategoryID: 1, ReviewerID: 2, RevieweeID: 5, CreatedAt: day},
+		{ID: 4, Rating: 5, TicketID: 2, RatingCategoryID: 1, ReviewerID: 1, RevieweeID: 5, CreatedAt: day},
+	}, nil)
+	service := NewRatingsQueryService(repository.NewRatingsRepository(db))
+
+	tests := []struct {
+		name          string
+		reviewerID    int
+		ticketID      int
+		expectedIDs   []int
+		expectedError bool
+	}{
+		{name: "rated the ticket twice", reviewerID: 1, ticketID: 1, expectedIDs: []int{2, 1}},
+		{name: "never rated the ticket", reviewerID: 3, ticketID: 1, expectedIDs: []int{}},
+		{name: "invalid reviewer_id", reviewerID: -1, ticketID: 1, expectedError: true},
+		{name: "invalid ticket_id", reviewerID: 1, ticketID: 0, expectedError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ratings, err := service.GetRatingAuditTrail(context.Background(), tt.reviewerID, tt.ticketID)
+			if tt.expectedError {
+				if !errors.Is(err, ErrInvalidRatingsQuery) {
+					t.Errorf("Expected ErrInvalidRatingsQuery, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if ratings == nil {
+				t.Fatal("Expected an empty slice, got nil")
+			}
+
+			ids := make([]int, len(ratings))
+			for i, rating := range ratings {
+				ids[i] = rating.ID
+			}
+			if !reflect.DeepEqual(ids, tt.expectedIDs) {
+				t.Errorf("Expected ratings %v, got %v", tt.expectedIDs, ids)
+			}
+		})
+	}
+}
@@ -0,0 +1,143 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"ticket-score-service/internal/models"
+	"ticket-score-service/internal/utils"
+)
+
+// RevieweeCategoryScore holds a reviewee's score in one category, overall and per day
+type RevieweeCategoryScore struct {
+	RevieweeID     int          `json:"revieweeId"`
+	CategoryID     int          `json:"categoryId"`
+	CategoryName   string       `json:"categoryName"`
+	TotalRatings   int          `json:"totalRatings"`
+	Score          string       `json:"score"`
+	DailyBreakdown []DailyScore `json:"dailyBreakdown"`
+}
+
+// TicketRepository gets tickets
+type TicketRepository interface {
+	GetTicketsByRevieweeAndDateRange(ctx context.Context, revieweeID int, startDate, endDate time.Time, limit, offset int) ([]models.Ticket, int, error)
+}
+
+// RevieweeAnalyticsService handles analytics about reviewees
+type RevieweeAnalyticsService struct {
+	categoryRepo    CategoryRepository
+	ratingsRepo     RatingsRepository
+	ticketRepo      TicketRepository
+	ticketScoreServ ScoreCalculator
+	scorePrecision  int
+	ratingAnalytics *RatingAnalyticsService
+}
+
+// RevieweeAnalyticsOption configures a RevieweeAnalyticsService
+type RevieweeAnalyticsOption func(*RevieweeAnalyticsService)
+
+// WithRevieweeScorePrecision sets the number of decimal places in formatted scores. Defaults to 0.
+func WithRevieweeScorePrecision(decimals int) RevieweeAnalyticsOption {
+	return func(s *RevieweeAnalyticsService) {
+		s.scorePrecision = decimals
+	}
+}
+
+// NewRevieweeAnalyticsService creates a new reviewee analytics service instance. ratingAnalytics does the
+// daily, monthly and weekly scoring of GetRevieweeAnalytics.
+func NewRevieweeAnalyticsService(
+	categoryRepo CategoryRepository,
+	ratingsRepo RatingsRepository,
+	ticketRepo TicketRepository,
+	ticketScoreServ ScoreCalculator,
+	ratingAnalytics *RatingAnalyticsService,
+	opts ...RevieweeAnalyticsOption,
+) *RevieweeAnalyticsService {
+	s := &RevieweeAnalyticsService{
+		categoryRepo:    categoryRepo,
+		ratingsRepo:     ratingsRepo,
+		ticketRepo:      ticketRepo,
+		ticketScoreServ: ticketScoreServ,
+		ratingAnalytics: ratingAnalytics,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// GetRevieweeCategoryScores gets a reviewee's score in a category for a date range, with a score
+// for every day. A reviewee without ratings gets an "N/A" score rather than an error.
+func (s *RevieweeAnalyticsService) GetRevieweeCategoryScores(ctx context.Context, revieweeID, categoryID int, startDate, endDate time.Time) (*RevieweeCategoryScore, error) {
+	category, err := findCategory(ctx, s.categoryRepo, categoryID)
+	if err != nil {
+		return nil, err
+	}
+
+	ratings, err := s.ratingsRepo.GetByRevieweeIDAndCategoryID(ctx, revieweeID, categoryID)
+	if err != nil {
+		return nil, logError(ctx, fmt.Errorf("failed to get ratings: %w", err))
+	}
+
+	ratingsByDate := groupRatingsByDate(ratings, startDate.Location())
+
+	result := &RevieweeCategoryScore{
+		RevieweeID:   revieweeID,
+		CategoryID:   category.ID,
+		CategoryName: category.Name,
+	}
+
+	var totalRatings []models.Rating
+	for currentDate := startDate; !currentDate.After(endDate); currentDate = currentDate.AddDate(0, 0, 1) {
+		dateStr := currentDate.Format("2006-01-02")
+		dailyRatings := ratingsByDate[dateStr]
+		totalRatings = append(totalRatings, dailyRatings...)
+
+		result.DailyBreakdown = append(result.DailyBreakdown, DailyScore{
+			Date:  dateStr,
+			Score: s.score(dailyRatings, category),
+		})
+	}
+
+	result.TotalRatings = len(totalRatings)
+	result.Score = s.score(totalRatings, category)
+
+	return result, nil
+}
+
+// GetRevieweeTickets gets one page of the tickets in which a reviewee was rated in a date range,
+// newest first, and the number of such tickets across all pages
+func (s *RevieweeAnalyticsService) GetRevieweeTickets(ctx context.Context, revieweeID int, startDate, endDate time.Time, limit, offset int) ([]models.Ticket, int, error) {
+	tickets, totalCount, err := s.ticketRepo.GetTicketsByRevieweeAndDateRange(ctx, revieweeID, startDate, endDate, limit, offset)
+	if err != nil {
+		return nil, 0, logError(ctx, fmt.Errorf("failed to get reviewee tickets: %w", err))
+	}
+
+	return tickets, totalCount, nil
+}
+
+// score formats the score of ratings in a category, or "N/A" without ratings
+func (s *RevieweeAnalyticsService) score(ratings []models.Rating, category models.RatingCategory) string {
+	if len(ratings) == 0 {
+		return "N/A"
+	}
+
+	score, err := s.ticketScoreServ.CalculateScore(ratings, []models.RatingCategory{category})
+	if err != nil {
+		return "N/A"
+	}
+
+	return utils.FormatScoreWithPrecision(score, s.scorePrecision)
+}
+
+// GetRevieweeAnalytics gets the same analytics as GetCategoryAnalytics for every category, but
+// only from the ratings the reviewee received
+func (s *RevieweeAnalyticsService) GetRevieweeAnalytics(ctx context.Context, revieweeID int, startDate, endDate time.Time) ([]CategoryAnalytics, error) {
+	ratings, err := s.ratingsRepo.GetByRevieweeIDAndDateRange(ctx, revieweeID, startDate, endDate)
+	if err != nil {
+		return nil, logError(ctx, fmt.Errorf("failed to get ratings of reviewee %d: %w", revieweeID, err))
+	}
+
+	return s.ratingAnalytics.AnalyticsForRatings(ctx, ratings, startDate, endDate)
+}
@@ -0,0 +1,153 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"ticket-score-service/internal/mocks"
+	"ticket-score-service/internal/models"
+)
+
+// mockTicketRepo serves a fixed page of tickets
+type mockTicketRepo struct {
+	tickets    []models.Ticket
+	totalCount int
+	err        error
+}
+
+func (m *mockTicketRepo) GetTicketsByRevieweeAndDateRange(ctx context.Context, revieweeID int, startDate, endDate time.Time, limit, offset int) ([]models.Ticket, int, error) {
+	if m.err != nil {
+		return nil, 0, m.err
+	}
+	return m.tickets, m.totalCount, nil
+}
+
+func TestGetRevieweeCategoryScores(t *testing.T) {
+	startDate := time.Date(2019, 10, 1, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2019, 10, 3, 0, 0, 0, 0, time.UTC)
+
+	categories := []models.RatingCategory{
+		{ID: 1, Name: "Spelling", Weight: 1},
+		{ID: 2, Name: "Grammar", Weight: 1},
+	}
+	ratingsData := map[string][]models.Rating{
+		"all": {
+			{ID: 1, RevieweeID: 7, RatingCategoryID: 1, Rating: 5, CreatedAt: startDate.Add(2 * time.Hour)},
+			{ID: 2, RevieweeID: 7, RatingCategoryID: 1, Rating: 3, CreatedAt: startDate.Add(3 * time.Hour)},
+			{ID: 3, RevieweeID: 7, RatingCategoryID: 1, Rating: 2, CreatedAt: startDate.Add(50 * time.Hour)},
+			{ID: 4, RevieweeID: 7, RatingCategoryID: 1, Rating: 0, CreatedAt: startDate.Add(-time.Hour)}, // before range
+			{ID: 5, RevieweeID: 7, RatingCategoryID: 2, Rating: 0, CreatedAt: startDate.Add(2 * time.Hour)},
+			{ID: 6, RevieweeID: 8, RatingCategoryID: 1, Rating: 0, CreatedAt: startDate.Add(2 * time.Hour)},
+		},
+	}
+
+	tests := []struct {
+		name          string
+		revieweeID    int
+		expectedTotal int
+		expectedScore string
+		expectedDaily []DailyScore
+	}{
+		{
+			name:          "reviewee with ratings",
+			revieweeID:    7,
+			expectedTotal: 3,
+			expectedScore: "67%",
+			expectedDaily: []DailyScore{
+				{Date: "2019-10-01", Score: "80%"},
+				{Date: "2019-10-02", Score: "N/A"},
+				{Date: "2019-10-03", Score: "40%"},
+			},
+		},
+		{
+			name:          "reviewee not found",
+			revieweeID:    99,
+			expectedTotal: 0,
+			expectedScore: "N/A",
+			expectedDaily: []DailyScore{
+				{Date: "2019-10-01", Score: "N/A"},
+				{Date: "2019-10-02", Score: "N/A"},
+				{Date: "2019-10-03", Score: "N/A"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ratingsRepo := &mocks.MockRatingsRepo{Ratings: ratingsData}
+			service := NewRevieweeAnalyticsService(&mockCategoryRepo{categories: categories}, ratingsRepo, &mockTicketRepo{}, NewTicketScoreService(), nil)
+
+			result, err := service.GetRevieweeCategoryScores(context.Background(), tt.revieweeID, 1, startDate, endDate)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if result.RevieweeID != tt.revieweeID || result.CategoryID != 1 || result.CategoryName != "Spelling" {
+				t.Errorf("Unexpected reviewee or category in %+v", result)
+			}
+			if result.TotalRatings != tt.expectedTotal {
+				t.Errorf("Expected %d ratings, got %d", tt.expectedTotal, result.TotalRatings)
+			}
+			if result.Score != tt.expectedScore {
+				t.Errorf("Expected score %s, got %s", tt.expectedScore, result.Score)
+			}
+			if !reflect.DeepEqual(result.DailyBreakdown, tt.expectedDaily) {
+				t.Errorf("Expected daily breakdown %+v, got %+v", tt.expectedDaily, result.DailyBreakdown)
+			}
+		})
+	}
+
+	t.Run("unknown category", func(t *testing.T) {
+		service := NewRevieweeAnalyticsService(&mockCategoryRepo{categories: categories}, &mocks.MockRatingsRepo{}, &mockTicketRepo{}, NewTicketScoreService(), nil)
+
+		_, err := service.GetRevieweeCategoryScores(context.Background(), 7, 99, startDate, endDate)
+		if !errors.Is(err, ErrCategoryNotFound) {
+			t.Errorf("Expected ErrCategoryNotFound, got %v", err)
+		}
+	})
+
+	t.Run("database error", func(t *testing.T) {
+		service := NewRevieweeAnalyticsService(&mockCategoryRepo{categories: categories}, &mocks.MockRatingsRepo{Err: errors.New("database error")}, &mockTicketRepo{}, NewTicketScoreService(), nil)
+
+		_, err := service.GetRevieweeCategoryScores(context.Background(), 7, 1, startDate, endDate)
+		if err == nil {
+			t.Fatal("Expected error but got none")
+		}
+		if errors.Is(err, ErrCategoryNotFound) {
+			t.Errorf("Expected a database error, got %v", err)
+		}
+	})
+}
+
+func TestGetRevieweeTickets(t *testing.T) {
+	startDate := time.Date(2019, 10, 1, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2019, 10, 3, 0, 0, 0, 0, time.UTC)
+	tickets := []models.Ticket{
+		{ID: 3, Subject: "Billing", CreatedAt: startDate},
+		{ID: 2, Subject: "Login", CreatedAt: startDate.Add(-24 * time.Hour)},
+	}
+
+	service := NewRevieweeAnalyticsService(&mockCategoryRepo{}, &mocks.MockRatingsRepo{}, &mockTicketRepo{tickets: tickets, totalCount: 5}, NewTicketScoreService(), nil)
+
+	result, totalCount, err := service.GetRevieweeTickets(context.Background(), 7, startDate, endDate, 2, 0)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if totalCount != 5 {
+		t.Errorf("Expected total count 5, got %d", totalCount)
+	}
+	if !reflect.DeepEqual(result, tickets) {
+		t.Errorf("Expected %+v, got %+v", tickets, result)
+	}
+
+	t.Run("repository error", func(t *testing.T) {
+		service := NewRevieweeAnalyticsService(&mockCategoryRepo{}, &mocks.MockRatingsRepo{}, &mockTicketRepo{err: errors.New("database error")}, NewTicketScoreService(), nil)
+
+		if _, _, err := service.GetRevieweeTickets(context.Background(), 7, startDate, endDate, 2, 0); err == nil {
+			t.Error("Expected error but got none")
+		}
+	})
+}
@@ -0,0 +1,62 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"ticket-score-service/internal/utils"
+)
+
+// RevieweeCategoryHeatmap holds every reviewee's score in every category for a period.
+// ScoreMatrix[i][j] is the score of RevieweeIDs[i] in CategoryNames[j].
+type RevieweeCategoryHeatmap struct {
+	RevieweeIDs   []int      `json:"revieweeIds"`
+	CategoryNames []string   `json:"categoryNames"`
+	ScoreMatrix   [][]string `json:"scoreMatrix"`
+}
+
+// GetRevieweeCategoryHeatmap calculates the score of every reviewee rated from startDate to endDate in
+// every category. Reviewees are in ID order and categories in category order; cells without ratings
+// are "N/A".
+func (s *RevieweeAnalyticsService) GetRevieweeCategoryHeatmap(ctx context.Context, startDate, endDate time.Time) (*RevieweeCategoryHeatmap, error) {
+	categories, err := s.categoryRepo.GetAll(ctx)
+	if err != nil {
+		return nil, logError(ctx, err)
+	}
+
+	averages, err := s.ratingsRepo.GetAverageRatingByRevieweeAndCategory(ctx, startDate, endDate)
+	if err != nil {
+		return nil, logError(ctx, fmt.Errorf("failed to get reviewee category averages: %w", err))
+	}
+
+	heatmap := &RevieweeCategoryHeatmap{
+		RevieweeIDs:   make([]int, 0, len(averages)),
+		CategoryNames: make([]string, 0, len(categories)),
+		ScoreMatrix:   make([][]string, 0, len(averages)),
+	}
+	for revieweeID := range averages {
+		heatmap.RevieweeIDs = append(heatmap.RevieweeIDs, revieweeID)
+	}
+	sort.Ints(heatmap.RevieweeIDs)
+
+	for _, category := range categories {
+		heatmap.CategoryNames = append(heatmap.CategoryNames, category.Name)
+	}
+
+	for _, revieweeID := range heatmap.RevieweeIDs {
+		row := make([]string, 0, len(categories))
+		for _, category := range categories {
+			average, ok := averages[revieweeID][category.ID]
+			if !ok {
+				row = append(row, "N/A")
+				continue
+			}
+			row = append(row, utils.FormatScoreWithPrecision(ratingToPercent(average, s.ticketScoreServ.MaxRating()), s.scorePrecision))
+		}
+		heatmap.ScoreMatrix = append(heatmap.ScoreMatrix, row)
+	}
+
+	return heatmap, nil
+}
@@ -0,0 +1,53 @@
+package service
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"ticket-score-service/internal/mocks"
+	"ticket-score-service/internal/models"
+)
+
+func TestGetRevieweeCategoryHeatmap(t *testing.T) {
+	startDate := time.Date(2019, 10, 1, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2019, 10, 3, 0, 0, 0, 0, time.UTC)
+	createdAt := startDate.Add(2 * time.Hour)
+
+	categories := []models.RatingCategory{
+		{ID: 1, Name: "Spelling", Weight: 1},
+		{ID: 2, Name: "Grammar", Weight: 0.5},
+	}
+	ratings := map[string][]models.Rating{
+		"all": {
+			{ID: 1, RevieweeID: 9, RatingCategoryID: 1, Rating: 5, CreatedAt: createdAt},
+			{ID: 2, RevieweeID: 9, RatingCategoryID: 1, Rating: 3, CreatedAt: createdAt},
+			{ID: 3, RevieweeID: 9, RatingCategoryID: 2, Rating: 1, CreatedAt: createdAt},
+			{ID: 4, RevieweeID: 7, RatingCategoryID: 1, Rating: 2, CreatedAt: createdAt},
+			{ID: 5, RevieweeID: 7, RatingCategoryID: 2, Rating: 5, CreatedAt: createdAt},
+			{ID: 6, RevieweeID: 8, RatingCategoryID: 2, Rating: 3, CreatedAt: createdAt},
+			{ID: 7, RevieweeID: 8, RatingCategoryID: 1, Rating: 0, CreatedAt: endDate.AddDate(0, 0, 1)}, // after range
+		},
+	}
+
+	service := NewRevieweeAnalyticsService(&mockCategoryRepo{categories: categories}, &mocks.MockRatingsRepo{Ratings: ratings}, &mockTicketRepo{}, NewTicketScoreService(), nil)
+
+	heatmap, err := service.GetRevieweeCategoryHeatmap(context.Background(), startDate, endDate)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expected := &RevieweeCategoryHeatmap{
+		RevieweeIDs:   []int{7, 8, 9},
+		CategoryNames: []string{"Spelling", "Grammar"},
+		ScoreMatrix: [][]string{
+			{"40%", "100%"},
+			{"N/A", "60%"},
+			{"80%", "20%"},
+		},
+	}
+	if !reflect.DeepEqual(heatmap, expected) {
+		t.Errorf("Expected %+v, got %+v", expected, heatmap)
+	}
+}
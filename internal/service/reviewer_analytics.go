@@ -0,0 +1,255 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"ticket-score-service/internal/models"
+	"ticket-score-service/internal/utils"
+)
+
+// Fairness labels for the spread of reviewer scores within a category
+const (
+	FairnessFair         = "fair"
+	FairnessModerate     = "moderate"
+	FairnessInconsistent = "inconsistent"
+)
+
+// ReviewerFairness describes how consistently reviewers score the same category
+type ReviewerFairness struct {
+	CategoryID    int     `json:"categoryId"`
+	CategoryName  string  `json:"categoryName"`
+	ReviewerCount int     `json:"reviewerCount"`
+	ScoreStdDev   float64 `json:"scoreStdDev"`
+	FairnessLabel string  `json:"fairnessLabel"`
+}
+
+// ReviewerCategoryScore summarizes the ratings a reviewer gave in one category
+type ReviewerCategoryScore struct {
+	CategoryName  string  `json:"categoryName"`
+	RatingCount   int     `json:"ratingCount"`
+	AverageRating float64 `json:"averageRating"`
+	WeightedScore string  `json:"weightedScore"`
+}
+
+// ReviewerConsistency describes how similarly a reviewer rates one category over time
+type ReviewerConsistency struct {
+	CategoryName     string  `json:"categoryName"`
+	RatingCount      int     `json:"ratingCount"`
+	StdDev           float64 `json:"stdDev"`
+	ConsistencyGrade string  `json:"consistencyGrade"`
+}
+
+// ReviewerAnalyticsService handles analytics about reviewers
+type ReviewerAnalyticsService struct {
+	categoryRepo    CategoryRepository
+	ratingsRepo     RatingsRepository
+	ticketScoreServ ScoreCalculator
+	scorePrecision  int
+	ratingAnalytics *RatingAnalyticsService
+}
+
+// ReviewerAnalyticsOption configures a ReviewerAnalyticsService
+type ReviewerAnalyticsOption func(*ReviewerAnalyticsService)
+
+// WithReviewerScorePrecision sets the number of decimal places in formatted scores. Defaults to 0.
+func WithReviewerScorePrecision(decimals int) ReviewerAnalyticsOption {
+	return func(s *ReviewerAnalyticsService) {
+		s.scorePrecision = decimals
+	}
+}
+
+// NewReviewerAnalyticsService creates a new reviewer analytics service instance. ratingAnalytics does the
+// daily, monthly and weekly scoring of GetReviewerAnalytics.
+func NewReviewerAnalyticsService(
+	categoryRepo CategoryRepository,
+	ratingsRepo RatingsRepository,
+	ticketScoreServ ScoreCalculator,
+	ratingAnalytics *RatingAnalyticsService,
+	opts ...ReviewerAnalyticsOption,
+) *ReviewerAnalyticsService {
+	s := &ReviewerAnalyticsService{
+		categoryRepo:    categoryRepo,
+		ratingsRepo:     ratingsRepo,
+		ticketScoreServ: ticketScoreServ,
+		ratingAnalytics: ratingAnalytics,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// GetReviewerCategoryScores summarizes the ratings a reviewer gave in each category over a date
+// range, in category order. Categories the reviewer didn't rate have a count of 0 and an "N/A" score.
+func (s *ReviewerAnalyticsService) GetReviewerCategoryScores(ctx context.Context, reviewerID int, startDate, endDate time.Time) ([]ReviewerCategoryScore, error) {
+	categories, err := s.categoryRepo.GetAll(ctx)
+	if err != nil {
+		return nil, logError(ctx, err)
+	}
+
+	scores := make([]ReviewerCategoryScore, 0, len(categories))
+	for _, category := range categories {
+		ratings, err := s.ratingsRepo.GetByReviewerIDAndCategoryIDAndDateRange(ctx, reviewerID, category.ID, startDate, endDate)
+		if err != nil {
+			return nil, logError(ctx, fmt.Errorf("failed to get ratings for category %s: %w", category.Name, err))
+		}
+
+		score := ReviewerCategoryScore{
+			CategoryName:  category.Name,
+			RatingCount:   len(ratings),
+			WeightedScore: "N/A",
+		}
+		if len(ratings) > 0 {
+			sum := 0
+			for _, rating := range ratings {
+				sum += rating.Rating
+			}
+			score.AverageRating = float64(sum) / float64(len(ratings))
+
+			weighted, err := s.ticketScoreServ.CalculateScore(ratings, []models.RatingCategory{category})
+			if err != nil {
+				return nil, fmt.Errorf("failed to calculate score for category %s: %w", category.Name, err)
+			}
+			score.WeightedScore = utils.FormatScoreWithPrecision(weighted, s.scorePrecision)
+		}
+		scores = append(scores, score)
+	}
+
+	return scores, nil
+}
+
+// CalculateReviewerFairness calculates the standard deviation of per-reviewer mean scores
+// (as percentages) for a category. Fewer than two reviewers always counts as fair.
+func (s *ReviewerAnalyticsService) CalculateReviewerFairness(ctx context.Context, categoryID int, startDate, endDate time.Time) (*ReviewerFairness, error) {
+	category, err := findCategory(ctx, s.categoryRepo, categoryID)
+	if err != nil {
+		return nil, err
+	}
+
+	ratings, err := s.ratingsRepo.GetByCategoryIDAndDateRange(ctx, categoryID, startDate, endDate)
+	if err != nil {
+		return nil, logError(ctx, fmt.Errorf("failed to get ratings: %w", err))
+	}
+
+	reviewerScores := meanScoreByReviewer(ratings, s.ticketScoreServ.MaxRating())
+	stdDev := standardDeviation(reviewerScores)
+
+	return &ReviewerFairness{
+		CategoryID:    category.ID,
+		CategoryName:  category.Name,
+		ReviewerCount: len(reviewerScores),
+		ScoreStdDev:   stdDev,
+		FairnessLabel: fairnessLabel(stdDev),
+	}, nil
+}
+
+// GetReviewerConsistency calculates the standard deviation of the rating values a reviewer gave
+// in each category over a date range, in category order. Categories the reviewer didn't rate are left out.
+func (s *ReviewerAnalyticsService) GetReviewerConsistency(ctx context.Context, reviewerID int, startDate, endDate time.Time) ([]ReviewerConsistency, error) {
+	categories, err := s.categoryRepo.GetAll(ctx)
+	if err != nil {
+		return nil, logError(ctx, err)
+	}
+
+	consistency := make([]ReviewerConsistency, 0, len(categories))
+	for _, category := range categories {
+		ratings, err := s.ratingsRepo.GetByReviewerIDAndCategoryIDAndDateRange(ctx, reviewerID, category.ID, startDate, endDate)
+		if err != nil {
+			return nil, logError(ctx, fmt.Errorf("failed to get ratings for category %s: %w", category.Name, err))
+		}
+		if len(ratings) == 0 {
+			continue
+		}
+
+		values := make([]float64, len(ratings))
+		for i, rating := range ratings {
+			values[i] = float64(rating.Rating)
+		}
+		stdDev := standardDeviation(values)
+
+		consistency = append(consistency, ReviewerConsistency{
+			CategoryName:     category.Name,
+			RatingCount:      len(ratings),
+			StdDev:           stdDev,
+			ConsistencyGrade: reviewerConsistencyGrade(stdDev),
+		})
+	}
+
+	return consistency, nil
+}
+
+// meanScoreByReviewer returns each reviewer's mean rating as a percentage of the maximum rating
+func meanScoreByReviewer(ratings []models.Rating, maxRating int) []float64 {
+	sums := make(map[int]int)
+	counts := make(map[int]int)
+	for _, rating := range ratings {
+		sums[rating.ReviewerID] += rating.Rating
+		counts[rating.ReviewerID]++
+	}
+
+	scores := make([]float64, 0, len(counts))
+	for reviewerID, count := range counts {
+		scores = append(scores, ratingToPercent(float64(sums[reviewerID])/float64(count), maxRating))
+	}
+	return scores
+}
+
+// standardDeviation returns the population standard deviation of values
+func standardDeviation(values []float64) float64 {
+	if len(values) < 2 {
+		return 0
+	}
+
+	var sum float64
+	for _, value := range values {
+		sum += value
+	}
+	mean := sum / float64(len(values))
+
+	var squaredDiffs float64
+	for _, value := range values {
+		squaredDiffs += (value - mean) * (value - mean)
+	}
+
+	return math.Sqrt(squaredDiffs / float64(len(values)))
+}
+
+// fairnessLabel classifies a standard deviation of reviewer scores
+func fairnessLabel(stdDev float64) string {
+	switch {
+	case stdDev < 10:
+		return FairnessFair
+	case stdDev <= 20:
+		return FairnessModerate
+	default:
+		return FairnessInconsistent
+	}
+}
+
+// reviewerConsistencyGrade grades a standard deviation of rating values (0-5), from A (very consistent) to D
+func reviewerConsistencyGrade(stdDev float64) string {
+	switch {
+	case stdDev < 0.5:
+		return "A"
+	case stdDev < 1:
+		return "B"
+	case stdDev < 1.5:
+		return "C"
+	default:
+		return "D"
+	}
+}
+
+// GetReviewerAnalytics gets the same analytics as GetCategoryAnalytics for every category, but
+// only from the ratings the reviewer gave
+func (s *ReviewerAnalyticsService) GetReviewerAnalytics(ctx context.Context, reviewerID int, startDate, endDate time.Time) ([]CategoryAnalytics, error) {
+	ratings, err := s.ratingsRepo.GetByReviewerIDAndDateRange(ctx, reviewerID, startDate, endDate)
+	if err != nil {
+		return nil, logError(ctx, fmt.Errorf("failed to get ratings of reviewer %d: %w", reviewerID, err))
+	}
+
+	return s.ratingAnalytics.AnalyticsForRatings(ctx, ratings, startDate, endDate)
+}
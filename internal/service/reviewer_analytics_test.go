@@ -0,0 +1,318 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"math"
+	"reflect"
+	"testing"
+	"time"
+
+	"ticket-score-service/internal/mocks"
+	"ticket-score-service/internal/models"
+)
+
+// reviewerRatings creates one rating per reviewer with the given rating values, in category 1
+func reviewerRatings(createdAt time.Time, ratings ...int) []models.Rating {
+	result := make([]models.Rating, len(ratings))
+	for i, rating := range ratings {
+		result[i] = models.Rating{
+			ID:               i + 1,
+			TicketID:         i + 1,
+			RatingCategoryID: 1,
+			ReviewerID:       i + 1,
+			Rating:           rating,
+			CreatedAt:        createdAt,
+		}
+	}
+	return result
+}
+
+func TestCalculateReviewerFairness(t *testing.T) {
+	startDate := time.Date(2019, 10, 1, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2019, 10, 7, 0, 0, 0, 0, time.UTC)
+	createdAt := startDate.Add(2 * time.Hour)
+
+	categories := []models.RatingCategory{{ID: 1, Name: "Spelling", Weight: 1}}
+
+	tests := []struct {
+		name           string
+		ratings        []models.Rating
+		expectedCount  int
+		expectedStdDev float64
+		expectedLabel  string
+	}{
+		{
+			name:           "identical reviewer means",
+			ratings:        reviewerRatings(createdAt, 4, 4, 4), // 80, 80, 80
+			expectedCount:  3,
+			expectedStdDev: 0,
+			expectedLabel:  FairnessFair,
+		},
+		{
+			name:           "spread reviewer means",
+			ratings:        reviewerRatings(createdAt, 3, 4, 5), // 60, 80, 100
+			expectedCount:  3,
+			expectedStdDev: 16.33,
+			expectedLabel:  FairnessModerate,
+		},
+		{
+			name:           "widely spread reviewer means",
+			ratings:        reviewerRatings(createdAt, 0, 5), // 0, 100
+			expectedCount:  2,
+			expectedStdDev: 50,
+			expectedLabel:  FairnessInconsistent,
+		},
+		{
+			name: "means are taken per reviewer",
+			ratings: []models.Rating{
+				{ID: 1, RatingCategoryID: 1, ReviewerID: 1, Rating: 3, CreatedAt: createdAt},
+				{ID: 2, RatingCategoryID: 1, ReviewerID: 1, Rating: 5, CreatedAt: createdAt},
+				{ID: 3, RatingCategoryID: 1, ReviewerID: 2, Rating: 4, CreatedAt: createdAt},
+			},
+			expectedCount:  2,
+			expectedStdDev: 0,
+			expectedLabel:  FairnessFair,
+		},
+		{
+			name:           "no ratings",
+			expectedCount:  0,
+			expectedStdDev: 0,
+			expectedLabel:  FairnessFair,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ratingsRepo := &mocks.MockRatingsRepo{Ratings: map[string][]models.Rating{"1-2019-10-01": tt.ratings}}
+			service := NewReviewerAnalyticsService(&mockCategoryRepo{categories: categories}, ratingsRepo, NewTicketScoreService(), nil)
+
+			fairness, err := service.CalculateReviewerFairness(context.Background(), 1, startDate, endDate)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if fairness.CategoryName != "Spelling" {
+				t.Errorf("Expected category Spelling, got %s", fairness.CategoryName)
+			}
+			if fairness.ReviewerCount != tt.expectedCount {
+				t.Errorf("Expected %d reviewers, got %d", tt.expectedCount, fairness.ReviewerCount)
+			}
+			if math.Abs(fairness.ScoreStdDev-tt.expectedStdDev) > 0.01 {
+				t.Errorf("Expected standard deviation %.2f, got %.2f", tt.expectedStdDev, fairness.ScoreStdDev)
+			}
+			if fairness.FairnessLabel != tt.expectedLabel {
+				t.Errorf("Expected label %s, got %s", tt.expectedLabel, fairness.FairnessLabel)
+			}
+		})
+	}
+
+	t.Run("unknown category", func(t *testing.T) {
+		service := NewReviewerAnalyticsService(&mockCategoryRepo{categories: categories}, &mocks.MockRatingsRepo{}, NewTicketScoreService(), nil)
+
+		if _, err := service.CalculateReviewerFairness(context.Background(), 99, startDate, endDate); err == nil {
+			t.Error("Expected error but got none")
+		}
+	})
+
+	t.Run("repository error", func(t *testing.T) {
+		service := NewReviewerAnalyticsService(&mockCategoryRepo{categories: categories}, &mocks.MockRatingsRepo{Err: errors.New("database error")}, NewTicketScoreService(), nil)
+
+		if _, err := service.CalculateReviewerFairness(context.Background(), 1, startDate, endDate); err == nil {
+			t.Error("Expected error but got none")
+		}
+	})
+}
+
+func TestFairnessLabel(t *testing.T) {
+	tests := []struct {
+		stdDev   float64
+		expected string
+	}{
+		{0, FairnessFair},
+		{9.99, FairnessFair},
+		{10, FairnessModerate},
+		{20, FairnessModerate},
+		{20.01, FairnessInconsistent},
+	}
+
+	for _, tt := range tests {
+		if label := fairnessLabel(tt.stdDev); label != tt.expected {
+			t.Errorf("fairnessLabel(%.2f) = %s, expected %s", tt.stdDev, label, tt.expected)
+		}
+	}
+}
+
+func TestGetReviewerCategoryScores(t *testing.T) {
+	startDate := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2024, 1, 7, 0, 0, 0, 0, time.UTC)
+	createdAt := startDate.Add(time.Hour)
+	categories := []models.RatingCategory{
+		{ID: 1, Name: "Spelling", Weight: 1},
+		{ID: 2, Name: "Grammar", Weight: 0.7},
+	}
+
+	ratings := map[string][]models.Rating{
+		"1-2024-01-01": {
+			{ID: 1, RatingCategoryID: 1, ReviewerID: 1, Rating: 5, CreatedAt: createdAt},
+			{ID: 2, RatingCategoryID: 1, ReviewerID: 1, Rating: 4, CreatedAt: createdAt},
+			{ID: 3, RatingCategoryID: 1, ReviewerID: 2, Rating: 2, CreatedAt: createdAt},
+		},
+		"2-2024-01-01": {
+			{ID: 4, RatingCategoryID: 2, ReviewerID: 1, Rating: 3, CreatedAt: createdAt},
+		},
+		"2-2024-01-09": {
+			{ID: 5, RatingCategoryID: 2, ReviewerID: 2, Rating: 1, CreatedAt: endDate.AddDate(0, 0, 2)}, // after range
+		},
+	}
+
+	tests := []struct {
+		name       string
+		reviewerID int
+		expected   []ReviewerCategoryScore
+	}{
+		{
+			name:       "ratings in all categories",
+			reviewerID: 1,
+			expected: []ReviewerCategoryScore{
+				{CategoryName: "Spelling", RatingCount: 2, AverageRating: 4.5, WeightedScore: "90%"},
+				{CategoryName: "Grammar", RatingCount: 1, AverageRating: 3, WeightedScore: "60%"},
+			},
+		},
+		{
+			name:       "ratings in one category",
+			reviewerID: 2,
+			expected: []ReviewerCategoryScore{
+				{CategoryName: "Spelling", RatingCount: 1, AverageRating: 2, WeightedScore: "40%"},
+				{CategoryName: "Grammar", RatingCount: 0, AverageRating: 0, WeightedScore: "N/A"},
+			},
+		},
+		{
+			name:       "non-existent reviewer",
+			reviewerID: 99,
+			expected: []ReviewerCategoryScore{
+				{CategoryName: "Spelling", WeightedScore: "N/A"},
+				{CategoryName: "Grammar", WeightedScore: "N/A"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := NewReviewerAnalyticsService(&mockCategoryRepo{categories: categories}, &mocks.MockRatingsRepo{Ratings: ratings}, NewTicketScoreService(), nil)
+
+			scores, err := service.GetReviewerCategoryScores(context.Background(), tt.reviewerID, startDate, endDate)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(scores, tt.expected) {
+				t.Errorf("Expected %+v, got %+v", tt.expected, scores)
+			}
+		})
+	}
+
+	t.Run("repository error", func(t *testing.T) {
+		service := NewReviewerAnalyticsService(&mockCategoryRepo{categories: categories}, &mocks.MockRatingsRepo{Err: errors.New("database error")}, NewTicketScoreService(), nil)
+
+		if _, err := service.GetReviewerCategoryScores(context.Background(), 1, startDate, endDate); err == nil {
+			t.Error("Expected error but got none")
+		}
+	})
+}
+
+func TestGetReviewerConsistency(t *testing.T) {
+	startDate := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2024, 1, 7, 0, 0, 0, 0, time.UTC)
+	categories := []models.RatingCategory{
+		{ID: 1, Name: "Spelling", Weight: 1},
+		{ID: 2, Name: "Grammar", Weight: 0.7},
+	}
+
+	// Reviewer 1 always gives 5s, reviewer 2 alternates between 3 and 5
+	var spelling []models.Rating
+	for i := 0; i < 4; i++ {
+		createdAt := startDate.AddDate(0, 0, i).Add(time.Hour)
+		spelling = append(spelling,
+			models.Rating{ID: i*2 + 1, RatingCategoryID: 1, ReviewerID: 1, Rating: 5, CreatedAt: createdAt},
+			models.Rating{ID: i*2 + 2, RatingCategoryID: 1, ReviewerID: 2, Rating: 3 + 2*(i%2), CreatedAt: createdAt},
+		)
+	}
+	ratings := map[string][]models.Rating{
+		"1-2024-01-01": spelling,
+		"2-2024-01-01": {
+			{ID: 9, RatingCategoryID: 2, ReviewerID: 1, Rating: 4, CreatedAt: startDate.Add(time.Hour)},
+		},
+		"2-2024-01-09": {
+			{ID: 10, RatingCategoryID: 2, ReviewerID: 1, Rating: 0, CreatedAt: endDate.AddDate(0, 0, 2)}, // after range
+		},
+	}
+
+	tests := []struct {
+		name       string
+		reviewerID int
+		expected   []ReviewerConsistency
+	}{
+		{
+			name:       "always gives the same rating",
+			reviewerID: 1,
+			expected: []ReviewerConsistency{
+				{CategoryName: "Spelling", RatingCount: 4, StdDev: 0, ConsistencyGrade: "A"},
+				{CategoryName: "Grammar", RatingCount: 1, StdDev: 0, ConsistencyGrade: "A"},
+			},
+		},
+		{
+			name:       "alternates between two ratings",
+			reviewerID: 2,
+			expected: []ReviewerConsistency{
+				{CategoryName: "Spelling", RatingCount: 4, StdDev: 1, ConsistencyGrade: "C"},
+			},
+		},
+		{
+			name:       "non-existent reviewer",
+			reviewerID: 99,
+			expected:   []ReviewerConsistency{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := NewReviewerAnalyticsService(&mockCategoryRepo{categories: categories}, &mocks.MockRatingsRepo{Ratings: ratings}, NewTicketScoreService(), nil)
+
+			consistency, err := service.GetReviewerConsistency(context.Background(), tt.reviewerID, startDate, endDate)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(consistency, tt.expected) {
+				t.Errorf("Expected %+v, got %+v", tt.expected, consistency)
+			}
+		})
+	}
+
+	t.Run("repository error", func(t *testing.T) {
+		service := NewReviewerAnalyticsService(&mockCategoryRepo{categories: categories}, &mocks.MockRatingsRepo{Err: errors.New("database error")}, NewTicketScoreService(), nil)
+
+		if _, err := service.GetReviewerConsistency(context.Background(), 1, startDate, endDate); err == nil {
+			t.Error("Expected error but got none")
+		}
+	})
+}
+
+func TestReviewerConsistencyGrade(t *testing.T) {
+	tests := []struct {
+		stdDev   float64
+		expected string
+	}{
+		{0, "A"},
+		{0.49, "A"},
+		{0.5, "B"},
+		{1, "C"},
+		{1.49, "C"},
+		{1.5, "D"},
+	}
+
+	for _, tt := range tests {
+		if grade := reviewerConsistencyGrade(tt.stdDev); grade != tt.expected {
+			t.Errorf("reviewerConsistencyGrade(%.2f) = %s, expected %s", tt.stdDev, grade, tt.expected)
+		}
+	}
+}
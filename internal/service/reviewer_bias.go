@@ -0,0 +1,102 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"ticket-score-service/internal/models"
+)
+
+// Bias labels for how a reviewer's ratings compare with other reviewers' ratings of the same tickets
+const (
+	BiasLenient = "lenient"
+	BiasStrict  = "strict"
+	BiasFair    = "fair"
+)
+
+// CategoryBias is how far a reviewer's ratings in a category are from other reviewers' on average
+type CategoryBias struct {
+	CategoryName string  `json:"categoryName"`
+	MeanBias     float64 `json:"meanBias"`
+	SampleSize   int     `json:"sampleSize"`
+	BiasLabel    string  `json:"biasLabel"`
+}
+
+// BiasAnalysis shows per category whether a reviewer rates above or below the other reviewers
+type BiasAnalysis struct {
+	ReviewerID     int            `json:"reviewerId"`
+	CategoryBiases []CategoryBias `json:"categoryBiases"`
+}
+
+// GetReviewerBiasAnalysis compares each rating a reviewer gave from startDate to endDate with the
+// mean rating other reviewers gave the same ticket in the same category over that range. The bias
+// of a rating is the difference in rating points; categories are listed in category order with
+// the mean bias over their ratings. Ratings of tickets no one else rated in the category have
+// nothing to compare with and are left out, as are categories left without any such rating.
+func (s *ReviewerAnalyticsService) GetReviewerBiasAnalysis(ctx context.Context, reviewerID int, startDate, endDate time.Time) (*BiasAnalysis, error) {
+	categories, err := s.categoryRepo.GetAll(ctx)
+	if err != nil {
+		return nil, logError(ctx, err)
+	}
+
+	analysis := &BiasAnalysis{
+		ReviewerID:     reviewerID,
+		CategoryBiases: []CategoryBias{},
+	}
+	for _, category := range categories {
+		ratings, err := s.ratingsRepo.GetByCategoryIDAndDateRange(ctx, category.ID, startDate, endDate)
+		if err != nil {
+			return nil, logError(ctx, fmt.Errorf("failed to get ratings for category %s: %w", category.Name, err))
+		}
+
+		var own []models.Rating
+		otherSums := make(map[int]int)
+		otherCounts := make(map[int]int)
+		for _, rating := range ratings {
+			if rating.ReviewerID == reviewerID {
+				own = append(own, rating)
+				continue
+			}
+			otherSums[rating.TicketID] += rating.Rating
+			otherCounts[rating.TicketID]++
+		}
+
+		var biasSum float64
+		sampleSize := 0
+		for _, rating := range own {
+			count := otherCounts[rating.TicketID]
+			if count == 0 {
+				continue
+			}
+			otherMean := float64(otherSums[rating.TicketID]) / float64(count)
+			biasSum += float64(rating.Rating) - otherMean
+			sampleSize++
+		}
+		if sampleSize == 0 {
+			continue
+		}
+
+		meanBias := biasSum / float64(sampleSize)
+		analysis.CategoryBiases = append(analysis.CategoryBiases, CategoryBias{
+			CategoryName: category.Name,
+			MeanBias:     meanBias,
+			SampleSize:   sampleSize,
+			BiasLabel:    biasLabel(meanBias),
+		})
+	}
+
+	return analysis, nil
+}
+
+// biasLabel classifies a mean bias in rating points
+func biasLabel(meanBias float64) string {
+	switch {
+	case meanBias > 0.5:
+		return BiasLenient
+	case meanBias < -0.5:
+		return BiasStrict
+	default:
+		return BiasFair
+	}
+}
@@ -0,0 +1,79 @@
+package service
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"ticket-score-service/internal/mocks"
+	"ticket-score-service/internal/models"
+)
+
+func TestGetReviewerBiasAnalysis(t *testing.T) {
+	startDate := time.Date(2019, 10, 1, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2019, 10, 7, 0, 0, 0, 0, time.UTC)
+	createdAt := startDate.Add(2 * time.Hour)
+
+	categories := []models.RatingCategory{
+		{ID: 1, Name: "Spelling", Weight: 1},
+		{ID: 2, Name: "Grammar", Weight: 1},
+		{ID: 3, Name: "GDPR", Weight: 1},
+	}
+	ratings := map[string][]models.Rating{
+		// Reviewer 1 gives 2 where everyone else gives 5
+		"1-2019-10-01": {
+			{ID: 1, TicketID: 1, RatingCategoryID: 1, ReviewerID: 1, Rating: 2, CreatedAt: createdAt},
+			{ID: 2, TicketID: 1, RatingCategoryID: 1, ReviewerID: 2, Rating: 5, CreatedAt: createdAt},
+			{ID: 3, TicketID: 1, RatingCategoryID: 1, ReviewerID: 3, Rating: 5, CreatedAt: createdAt},
+			{ID: 4, TicketID: 2, RatingCategoryID: 1, ReviewerID: 1, Rating: 2, CreatedAt: createdAt},
+			{ID: 5, TicketID: 2, RatingCategoryID: 1, ReviewerID: 2, Rating: 5, CreatedAt: createdAt},
+			// Nobody else rated ticket 3
+			{ID: 6, TicketID: 3, RatingCategoryID: 1, ReviewerID: 1, Rating: 5, CreatedAt: createdAt},
+		},
+		// Reviewer 1 gives 4 where the others give 3 and 5
+		"2-2019-10-01": {
+			{ID: 7, TicketID: 1, RatingCategoryID: 2, ReviewerID: 1, Rating: 4, CreatedAt: createdAt},
+			{ID: 8, TicketID: 1, RatingCategoryID: 2, ReviewerID: 2, Rating: 3, CreatedAt: createdAt},
+			{ID: 9, TicketID: 1, RatingCategoryID: 2, ReviewerID: 3, Rating: 5, CreatedAt: createdAt},
+		},
+		// Reviewer 1 didn't rate GDPR
+		"3-2019-10-01": {
+			{ID: 10, TicketID: 1, RatingCategoryID: 3, ReviewerID: 2, Rating: 1, CreatedAt: createdAt},
+		},
+	}
+
+	service := NewReviewerAnalyticsService(&mockCategoryRepo{categories: categories}, &mocks.MockRatingsRepo{Ratings: ratings}, NewTicketScoreService(), nil)
+
+	analysis, err := service.GetReviewerBiasAnalysis(context.Background(), 1, startDate, endDate)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expected := &BiasAnalysis{
+		ReviewerID: 1,
+		CategoryBiases: []CategoryBias{
+			{CategoryName: "Spelling", MeanBias: -3, SampleSize: 2, BiasLabel: BiasStrict},
+			{CategoryName: "Grammar", MeanBias: 0, SampleSize: 1, BiasLabel: BiasFair},
+		},
+	}
+	if !reflect.DeepEqual(analysis, expected) {
+		t.Errorf("Expected %+v, got %+v", expected, analysis)
+	}
+
+	t.Run("lenient reviewer", func(t *testing.T) {
+		analysis, err := service.GetReviewerBiasAnalysis(context.Background(), 2, startDate, endDate)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		// Spelling: ticket 1 against 3.5 and ticket 2 against 2; Grammar: ticket 1 against 4.5
+		expected := []CategoryBias{
+			{CategoryName: "Spelling", MeanBias: 2.25, SampleSize: 2, BiasLabel: BiasLenient},
+			{CategoryName: "Grammar", MeanBias: -1.5, SampleSize: 1, BiasLabel: BiasStrict},
+		}
+		if !reflect.DeepEqual(analysis.CategoryBiases, expected) {
+			t.Errorf("Expected %+v, got %+v", expected, analysis.CategoryBiases)
+		}
+	})
+}
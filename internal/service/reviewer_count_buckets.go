@@ -0,0 +1,54 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// reviewerCountLabels names the reviewer count buckets; tickets with more reviewers go in the last one
+var reviewerCountLabels = []string{"1 reviewer", "2 reviewers", "3+ reviewers"}
+
+// ReviewerCountBucket is the average score of the tickets rated by a given number of reviewers
+type ReviewerCountBucket struct {
+	ReviewerCountLabel string `json:"reviewerCountLabel"`
+	TicketCount        int    `json:"ticketCount"`
+	AverageScore       string `json:"averageScore"`
+}
+
+// GetScoreByReviewerCountBucket groups the tickets rated in a category from startDate to endDate by
+// how many distinct reviewers rated them there (1, 2 or 3+) and averages the tickets' category scores
+// in each group. Every bucket is listed; buckets without tickets score "N/A".
+func (s *RatingAnalyticsService) GetScoreByReviewerCountBucket(ctx context.Context, categoryID int, startDate, endDate time.Time) ([]ReviewerCountBucket, error) {
+	if _, err := findCategory(ctx, s.categoryRepo, categoryID); err != nil {
+		return nil, err
+	}
+
+	tickets, err := s.ratingsRepo.GetReviewerCountAndAverageRatingByTicket(ctx, categoryID, startDate, endDate)
+	if err != nil {
+		return nil, logError(ctx, fmt.Errorf("failed to get reviewer counts by ticket: %w", err))
+	}
+
+	// Tickets whose ratings have no reviewer count as rated by one
+	scoreSums := make([]float64, len(reviewerCountLabels))
+	ticketCounts := make([]int, len(reviewerCountLabels))
+	for _, ticket := range tickets {
+		bucket := min(max(ticket.ReviewerCount, 1), len(reviewerCountLabels)) - 1
+		scoreSums[bucket] += ratingToPercent(ticket.AverageRating, s.ticketScoreServ.MaxRating())
+		ticketCounts[bucket]++
+	}
+
+	buckets := make([]ReviewerCountBucket, len(reviewerCountLabels))
+	for i, label := range reviewerCountLabels {
+		buckets[i] = ReviewerCountBucket{
+			ReviewerCountLabel: label,
+			TicketCount:        ticketCounts[i],
+			AverageScore:       "N/A",
+		}
+		if ticketCounts[i] > 0 {
+			buckets[i].AverageScore = s.formatScore(scoreSums[i] / float64(ticketCounts[i]))
+		}
+	}
+
+	return buckets, nil
+}
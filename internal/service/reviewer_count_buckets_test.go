@@ -0,0 +1,61 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"ticket-score-service/internal/mocks"
+	"ticket-score-service/internal/models"
+)
+
+func TestGetScoreByReviewerCountBucket(t *testing.T) {
+	startDate := time.Date(2019, 10, 1, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2019, 10, 7, 0, 0, 0, 0, time.UTC)
+	createdAt := startDate.Add(2 * time.Hour)
+
+	categories := []models.RatingCategory{
+		{ID: 1, Name: "Spelling", Weight: 1},
+		{ID: 2, Name: "Grammar", Weight: 1},
+	}
+	ratings := map[string][]models.Rating{
+		"1-2019-10-01": {
+			// Ticket 1: one reviewer rating twice, 100% and 60%
+			{ID: 1, TicketID: 1, RatingCategoryID: 1, ReviewerID: 1, Rating: 5, CreatedAt: createdAt},
+			{ID: 2, TicketID: 1, RatingCategoryID: 1, ReviewerID: 1, Rating: 3, CreatedAt: createdAt},
+			// Ticket 2: one reviewer, 40%
+			{ID: 3, TicketID: 2, RatingCategoryID: 1, ReviewerID: 2, Rating: 2, CreatedAt: createdAt},
+			// Ticket 3: two reviewers, 20% and 40%
+			{ID: 4, TicketID: 3, RatingCategoryID: 1, ReviewerID: 1, Rating: 1, CreatedAt: createdAt},
+			{ID: 5, TicketID: 3, RatingCategoryID: 1, ReviewerID: 2, Rating: 2, CreatedAt: createdAt},
+		},
+		// A second reviewer of ticket 2, but in another category
+		"2-2019-10-01": {
+			{ID: 6, TicketID: 2, RatingCategoryID: 2, ReviewerID: 3, Rating: 5, CreatedAt: createdAt},
+		},
+	}
+
+	service := NewRatingAnalyticsService(&mockCategoryRepo{categories: categories}, &mocks.MockRatingsRepo{Ratings: ratings}, NewTicketScoreService())
+
+	buckets, err := service.GetScoreByReviewerCountBucket(context.Background(), 1, startDate, endDate)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expected := []ReviewerCountBucket{
+		{ReviewerCountLabel: "1 reviewer", TicketCount: 2, AverageScore: "60%"},
+		{ReviewerCountLabel: "2 reviewers", TicketCount: 1, AverageScore: "30%"},
+		{ReviewerCountLabel: "3+ reviewers", TicketCount: 0, AverageScore: "N/A"},
+	}
+	if !reflect.DeepEqual(buckets, expected) {
+		t.Errorf("Expected %+v, got %+v", expected, buckets)
+	}
+
+	t.Run("unknown category", func(t *testing.T) {
+		if _, err := service.GetScoreByReviewerCountBucket(context.Background(), 99, startDate, endDate); !errors.Is(err, ErrCategoryNotFound) {
+			t.Errorf("Expected ErrCategoryNotFound, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,79 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"ticket-score-service/internal/utils"
+)
+
+// ReviewerWorkload is the share of a period's ratings given by one reviewer
+type ReviewerWorkload struct {
+	ReviewerID         int    `json:"reviewerId"`
+	RatingCount        int    `json:"ratingCount"`
+	SharePct           string `json:"sharePct"`
+	CategoriesReviewed int    `json:"categoriesReviewed"`
+}
+
+// WorkloadReport shows how a period's ratings are spread across reviewers
+type WorkloadReport struct {
+	TotalRatings int                `json:"totalRatings"`
+	Reviewers    []ReviewerWorkload `json:"reviewers"`
+}
+
+// GetWorkloadBalance gets how many of the ratings from startDate to endDate each reviewer gave
+// and in how many categories, busiest reviewer first
+func (s *ReviewerAnalyticsService) GetWorkloadBalance(ctx context.Context, startDate, endDate time.Time) (*WorkloadReport, error) {
+	counts, err := s.ratingsRepo.GetRatingCountByReviewerAndDateRange(ctx, startDate, endDate)
+	if err != nil {
+		return nil, logError(ctx, fmt.Errorf("failed to count ratings per reviewer: %w", err))
+	}
+
+	categories, err := s.categoryRepo.GetAll(ctx)
+	if err != nil {
+		return nil, logError(ctx, err)
+	}
+
+	categoriesReviewed := make(map[int]int)
+	for _, category := range categories {
+		ratings, err := s.ratingsRepo.GetByCategoryIDAndDateRange(ctx, category.ID, startDate, endDate)
+		if err != nil {
+			return nil, logError(ctx, fmt.Errorf("failed to get ratings for category %s: %w", category.Name, err))
+		}
+
+		reviewers := make(map[int]bool)
+		for _, rating := range ratings {
+			reviewers[rating.ReviewerID] = true
+		}
+		for reviewerID := range reviewers {
+			categoriesReviewed[reviewerID]++
+		}
+	}
+
+	report := &WorkloadReport{
+		Reviewers: make([]ReviewerWorkload, 0, len(counts)),
+	}
+	for _, count := range counts {
+		report.TotalRatings += count
+	}
+
+	for reviewerID, count := range counts {
+		report.Reviewers = append(report.Reviewers, ReviewerWorkload{
+			ReviewerID:         reviewerID,
+			RatingCount:        count,
+			SharePct:           utils.FormatScoreWithPrecision(float64(count)/float64(report.TotalRatings)*100, s.scorePrecision),
+			CategoriesReviewed: categoriesReviewed[reviewerID],
+		})
+	}
+
+	sort.Slice(report.Reviewers, func(i, j int) bool {
+		if report.Reviewers[i].RatingCount != report.Reviewers[j].RatingCount {
+			return report.Reviewers[i].RatingCount > report.Reviewers[j].RatingCount
+		}
+		return report.Reviewers[i].ReviewerID < report.Reviewers[j].ReviewerID
+	})
+
+	return report, nil
+}
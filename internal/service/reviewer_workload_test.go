@@ -0,0 +1,79 @@
+package service
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"ticket-score-service/internal/mocks"
+	"ticket-score-service/internal/models"
+)
+
+func TestGetWorkloadBalance(t *testing.T) {
+	startDate := time.Date(2019, 10, 1, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2019, 10, 7, 0, 0, 0, 0, time.UTC)
+	createdAt := startDate.Add(2 * time.Hour)
+
+	categories := []models.RatingCategory{
+		{ID: 1, Name: "Spelling", Weight: 1},
+		{ID: 2, Name: "Grammar", Weight: 1},
+	}
+	ratings := map[string][]models.Rating{
+		"1-2019-10-01": {
+			{ID: 1, RatingCategoryID: 1, ReviewerID: 1, Rating: 5, CreatedAt: createdAt},
+			{ID: 2, RatingCategoryID: 1, ReviewerID: 1, Rating: 4, CreatedAt: createdAt},
+			{ID: 3, RatingCategoryID: 1, ReviewerID: 2, Rating: 3, CreatedAt: createdAt},
+			{ID: 4, RatingCategoryID: 1, ReviewerID: 3, Rating: 3, CreatedAt: createdAt},
+		},
+		"2-2019-10-01": {
+			{ID: 5, RatingCategoryID: 2, ReviewerID: 1, Rating: 2, CreatedAt: createdAt},
+			{ID: 6, RatingCategoryID: 2, ReviewerID: 2, Rating: 5, CreatedAt: createdAt},
+			{ID: 7, RatingCategoryID: 2, ReviewerID: 1, Rating: 5, CreatedAt: createdAt},
+			{ID: 8, RatingCategoryID: 2, ReviewerID: 3, Rating: 1, CreatedAt: createdAt},
+		},
+		// Outside the date range
+		"2-2019-10-08": {{ID: 9, RatingCategoryID: 2, ReviewerID: 3, Rating: 1, CreatedAt: endDate.AddDate(0, 0, 1)}},
+	}
+
+	service := NewReviewerAnalyticsService(&mockCategoryRepo{categories: categories}, &mocks.MockRatingsRepo{Ratings: ratings}, NewTicketScoreService(), nil)
+
+	report, err := service.GetWorkloadBalance(context.Background(), startDate, endDate)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expected := &WorkloadReport{
+		TotalRatings: 8,
+		Reviewers: []ReviewerWorkload{
+			{ReviewerID: 1, RatingCount: 4, SharePct: "50%", CategoriesReviewed: 2},
+			{ReviewerID: 2, RatingCount: 2, SharePct: "25%", CategoriesReviewed: 2},
+			{ReviewerID: 3, RatingCount: 2, SharePct: "25%", CategoriesReviewed: 2},
+		},
+	}
+	if !reflect.DeepEqual(report, expected) {
+		t.Fatalf("Expected %+v, got %+v", expected, report)
+	}
+
+	var totalShare float64
+	for _, reviewer := range report.Reviewers {
+		share, ok := parseScore(reviewer.SharePct)
+		if !ok {
+			t.Fatalf("Expected a percentage share, got %s", reviewer.SharePct)
+		}
+		totalShare += share
+	}
+	if totalShare != 100 {
+		t.Errorf("Expected shares to sum to 100%%, got %.2f%%", totalShare)
+	}
+
+	t.Run("no ratings", func(t *testing.T) {
+		report, err := service.GetWorkloadBalance(context.Background(), endDate.AddDate(0, 1, 0), endDate.AddDate(0, 1, 6))
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if report.TotalRatings != 0 || len(report.Reviewers) != 0 {
+			t.Errorf("Expected an empty report, got %+v", report)
+		}
+	})
+}
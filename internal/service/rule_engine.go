@@ -0,0 +1,66 @@
+package service
+
+import (
+	"fmt"
+
+	"ticket-score-service/internal/models"
+)
+
+// RuleViolation is a rating that breaks a scoring rule
+type RuleViolation struct {
+	Rule            models.ScoringRule `json:"rule"`
+	TriggerRating   models.Rating      `json:"triggerRating"`
+	ViolatingRating models.Rating      `json:"violatingRating"`
+}
+
+// String describes the violation
+func (v RuleViolation) String() string {
+	return fmt.Sprintf("category %d rated %d, above %d, while category %d is rated %d",
+		v.ViolatingRating.RatingCategoryID, v.ViolatingRating.Rating, v.Rule.ThenMaxRating,
+		v.TriggerRating.RatingCategoryID, v.TriggerRating.Rating)
+}
+
+// RuleEngine checks a ticket's ratings against a set of scoring rules
+type RuleEngine struct {
+	rules []models.ScoringRule
+}
+
+// NewRuleEngine creates a rule engine for the given rules
+func NewRuleEngine(rules []models.ScoringRule) *RuleEngine {
+	return &RuleEngine{
+		rules: rules,
+	}
+}
+
+// Validate finds the ratings of a single ticket that break a rule, in rule order and then in rating
+// order. A rule is triggered by the first rating at or below its IfMaxRating in its IfCategoryID.
+func (e *RuleEngine) Validate(ratings []models.Rating) []RuleViolation {
+	var violations []RuleViolation
+	for _, rule := range e.rules {
+		trigger, triggered := ruleTrigger(rule, ratings)
+		if !triggered {
+			continue
+		}
+
+		for _, rating := range ratings {
+			if rating.RatingCategoryID == rule.ThenCategoryID && rating.Rating > rule.ThenMaxRating {
+				violations = append(violations, RuleViolation{
+					Rule:            rule,
+					TriggerRating:   trigger,
+					ViolatingRating: rating,
+				})
+			}
+		}
+	}
+	return violations
+}
+
+// ruleTrigger returns the first rating that triggers rule
+func ruleTrigger(rule models.ScoringRule, ratings []models.Rating) (models.Rating, bool) {
+	for _, rating := range ratings {
+		if rating.RatingCategoryID == rule.IfCategoryID && rating.Rating <= rule.IfMaxRating {
+			return rating, true
+		}
+	}
+	return models.Rating{}, false
+}
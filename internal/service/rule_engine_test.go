@@ -0,0 +1,77 @@
+package service
+
+import (
+	"reflect"
+	"testing"
+
+	"ticket-score-service/internal/models"
+)
+
+func TestRuleEngine_Validate(t *testing.T) {
+	// Spelling (1) can't be above 3 when Grammar (2) is rated 1 or less,
+	// and Tone (3) can't be above 2 when Grammar is rated 0
+	rules := []models.ScoringRule{
+		{ID: 1, IfCategoryID: 2, IfMaxRating: 1, ThenCategoryID: 1, ThenMaxRating: 3},
+		{ID: 2, IfCategoryID: 2, IfMaxRating: 0, ThenCategoryID: 3, ThenMaxRating: 2},
+	}
+
+	tests := []struct {
+		name     string
+		rules    []models.ScoringRule
+		ratings  []models.Rating
+		expected []string
+	}{
+		{
+			name:  "no violations",
+			rules: rules,
+			ratings: []models.Rating{
+				{ID: 1, RatingCategoryID: 2, Rating: 1},
+				{ID: 2, RatingCategoryID: 1, Rating: 3},
+				{ID: 3, RatingCategoryID: 3, Rating: 5}, // rule 2 not triggered
+			},
+		},
+		{
+			name:  "single violation",
+			rules: rules,
+			ratings: []models.Rating{
+				{ID: 1, RatingCategoryID: 2, Rating: 1},
+				{ID: 2, RatingCategoryID: 1, Rating: 4},
+			},
+			expected: []string{"category 1 rated 4, above 3, while category 2 is rated 1"},
+		},
+		{
+			name:  "multiple violations",
+			rules: rules,
+			ratings: []models.Rating{
+				{ID: 1, RatingCategoryID: 1, Rating: 5},
+				{ID: 2, RatingCategoryID: 2, Rating: 0},
+				{ID: 3, RatingCategoryID: 1, Rating: 4},
+				{ID: 4, RatingCategoryID: 3, Rating: 3},
+			},
+			expected: []string{
+				"category 1 rated 5, above 3, while category 2 is rated 0",
+				"category 1 rated 4, above 3, while category 2 is rated 0",
+				"category 3 rated 3, above 2, while category 2 is rated 0",
+			},
+		},
+		{
+			name: "empty rule set",
+			ratings: []models.Rating{
+				{ID: 1, RatingCategoryID: 2, Rating: 0},
+				{ID: 2, RatingCategoryID: 1, Rating: 5},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var messages []string
+			for _, violation := range NewRuleEngine(tt.rules).Validate(tt.ratings) {
+				messages = append(messages, violation.String())
+			}
+			if !reflect.DeepEqual(messages, tt.expected) {
+				t.Errorf("Expected violations %v, got %v", tt.expected, messages)
+			}
+		})
+	}
+}
@@ -0,0 +1,164 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"ticket-score-service/internal/models"
+	"ticket-score-service/internal/utils"
+)
+
+// ScoreBucket is a score range [Min, Max) used to group tickets. The bucket ending at 100 includes 100.
+type ScoreBucket struct {
+	Min   float64 `json:"min"`
+	Max   float64 `json:"max"`
+	Label string  `json:"label"`
+}
+
+// BucketResult holds the number of tickets whose score falls into a bucket
+type BucketResult struct {
+	Label      string `json:"label"`
+	Count      int    `json:"count"`
+	Percentage string `json:"percentage"`
+}
+
+// ScoreBucketReport holds ticket counts for each score bucket
+type ScoreBucketReport struct {
+	Buckets []BucketResult `json:"buckets"`
+}
+
+// DefaultScoreBuckets splits the score range into five equal buckets
+var DefaultScoreBuckets = []ScoreBucket{
+	{Min: 0, Max: 20, Label: "0-20%"},
+	{Min: 20, Max: 40, Label: "20-40%"},
+	{Min: 40, Max: 60, Label: "40-60%"},
+	{Min: 60, Max: 80, Label: "60-80%"},
+	{Min: 80, Max: 100, Label: "80-100%"},
+}
+
+// ValidateScoreBuckets checks that buckets don't overlap and together cover [0, 100]
+func ValidateScoreBuckets(buckets []ScoreBucket) error {
+	if len(buckets) == 0 {
+		return fmt.Errorf("at least one bucket is required")
+	}
+
+	sorted := make([]ScoreBucket, len(buckets))
+	copy(sorted, buckets)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Min < sorted[j].Min
+	})
+
+	if sorted[0].Min != 0 {
+		return fmt.Errorf("buckets must start at 0, first bucket starts at %g", sorted[0].Min)
+	}
+	if sorted[len(sorted)-1].Max != 100 {
+		return fmt.Errorf("buckets must end at 100, last bucket ends at %g", sorted[len(sorted)-1].Max)
+	}
+
+	for i, bucket := range sorted {
+		if bucket.Min >= bucket.Max {
+			return fmt.Errorf("bucket %q has min %g not below max %g", bucket.Label, bucket.Min, bucket.Max)
+		}
+		if i == 0 {
+			continue
+		}
+
+		previous := sorted[i-1]
+		if bucket.Min < previous.Max {
+			return fmt.Errorf("bucket %q overlaps bucket %q", bucket.Label, previous.Label)
+		}
+		if bucket.Min > previous.Max {
+			return fmt.Errorf("gap between bucket %q and bucket %q", previous.Label, bucket.Label)
+		}
+	}
+
+	return nil
+}
+
+// GetTicketCountByScoreBucket counts how many tickets fall into each score bucket.
+// A ticket's score is the weighted score of all its ratings, unrounded, so tickets near a bucket
+// boundary fall on the side their ratings put them.
+func (s *TicketScoresService) GetTicketCountByScoreBucket(ctx context.Context, startDate, endDate time.Time, buckets []ScoreBucket) (*ScoreBucketReport, error) {
+	if err := ValidateScoreBuckets(buckets); err != nil {
+		return nil, fmt.Errorf("invalid buckets: %w", err)
+	}
+
+	scores, err := s.collectTicketScoreValues(ctx, startDate, endDate)
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make([]int, len(buckets))
+	total := 0
+	for _, score := range scores {
+		for i, bucket := range buckets {
+			if bucket.contains(score) {
+				counts[i]++
+				total++
+				break
+			}
+		}
+	}
+
+	report := &ScoreBucketReport{Buckets: make([]BucketResult, len(buckets))}
+	for i, bucket := range buckets {
+		var percentage float64
+		if total > 0 {
+			percentage = float64(counts[i]) / float64(total) * 100
+		}
+
+		report.Buckets[i] = BucketResult{
+			Label:      bucket.Label,
+			Count:      counts[i],
+			Percentage: utils.FormatScore(percentage),
+		}
+	}
+
+	return report, nil
+}
+
+// contains reports whether a score falls into the bucket
+func (b ScoreBucket) contains(score float64) bool {
+	if b.Max == 100 {
+		return score >= b.Min && score <= b.Max
+	}
+	return score >= b.Min && score < b.Max
+}
+
+// collectTicketScoreValues scores every ticket rated within a date range from all of its ratings,
+// unrounded. Tickets whose ratings can't be scored are skipped.
+func (s *TicketScoresService) collectTicketScoreValues(ctx context.Context, startDate, endDate time.Time) ([]float64, error) {
+	var scores []float64
+	err := s.forEachTicketRatings(ctx, startDate, endDate, func(ticketID int, categories []models.RatingCategory, ratingsByCategory map[int][]models.Rating) bool {
+		var ratings []models.Rating
+		for _, category := range categories {
+			ratings = append(ratings, ratingsByCategory[category.ID]...)
+		}
+		if len(ratings) == 0 {
+			return true
+		}
+
+		if score, err := s.ticketScoreServ.CalculateScore(ratings, categories); err == nil {
+			scores = append(scores, score)
+		}
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return scores, nil
+}
+
+// parseScore parses a formatted score such as "85%", returning false for "N/A"
+func parseScore(score string) (float64, bool) {
+	value, err := strconv.ParseFloat(strings.TrimSuffix(score, "%"), 64)
+	if err != nil {
+		return 0, false
+	}
+	return value, true
+}
@@ -0,0 +1,237 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"ticket-score-service/internal/mocks"
+	"ticket-score-service/internal/models"
+)
+
+func TestValidateScoreBuckets(t *testing.T) {
+	tests := []struct {
+		name        string
+		buckets     []ScoreBucket
+		expectError bool
+	}{
+		{name: "default buckets", buckets: DefaultScoreBuckets},
+		{
+			name: "unordered buckets",
+			buckets: []ScoreBucket{
+				{Min: 50, Max: 100, Label: "high"},
+				{Min: 0, Max: 50, Label: "low"},
+			},
+		},
+		{name: "no buckets", buckets: nil, expectError: true},
+		{
+			name: "overlapping buckets",
+			buckets: []ScoreBucket{
+				{Min: 0, Max: 60, Label: "low"},
+				{Min: 50, Max: 100, Label: "high"},
+			},
+			expectError: true,
+		},
+		{
+			name: "gap between buckets",
+			buckets: []ScoreBucket{
+				{Min: 0, Max: 40, Label: "low"},
+				{Min: 50, Max: 100, Label: "high"},
+			},
+			expectError: true,
+		},
+		{
+			name:        "does not start at 0",
+			buckets:     []ScoreBucket{{Min: 10, Max: 100, Label: "all"}},
+			expectError: true,
+		},
+		{
+			name:        "does not end at 100",
+			buckets:     []ScoreBucket{{Min: 0, Max: 90, Label: "all"}},
+			expectError: true,
+		},
+		{
+			name: "empty bucket",
+			buckets: []ScoreBucket{
+				{Min: 0, Max: 0, Label: "zero"},
+				{Min: 0, Max: 100, Label: "all"},
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateScoreBuckets(tt.buckets)
+			if tt.expectError && err == nil {
+				t.Error("Expected error but got none")
+			}
+			if !tt.expectError && err != nil {
+				t.Errorf("Unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestGetTicketCountByScoreBucket(t *testing.T) {
+	startDate := time.Date(2019, 10, 1, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2019, 10, 3, 0, 0, 0, 0, time.UTC)
+	createdAt := startDate.Add(1 * time.Hour)
+
+	categories := []models.RatingCategory{
+		{ID: 1, Name: "Spelling", Weight: 1},
+	}
+
+	// Tickets 1-6 score 100%, 80%, 60%, 40%, 20% and 0%.
+	// Ticket 7 only has a rating in an unknown category, so it has no score.
+	ratingsData := map[string][]models.Rating{
+		"1-2019-10-01": {
+			{ID: 1, TicketID: 1, RatingCategoryID: 1, Rating: 5, CreatedAt: createdAt},
+			{ID: 2, TicketID: 2, RatingCategoryID: 1, Rating: 4, CreatedAt: createdAt},
+			{ID: 3, TicketID: 3, RatingCategoryID: 1, Rating: 3, CreatedAt: createdAt},
+			{ID: 4, TicketID: 4, RatingCategoryID: 1, Rating: 2, CreatedAt: createdAt},
+			{ID: 5, TicketID: 5, RatingCategoryID: 1, Rating: 1, CreatedAt: createdAt},
+			{ID: 6, TicketID: 6, RatingCategoryID: 1, Rating: 0, CreatedAt: createdAt},
+		},
+		"9-2019-10-01": {
+			{ID: 7, TicketID: 7, RatingCategoryID: 9, Rating: 5, CreatedAt: createdAt},
+		},
+	}
+	scoredTickets := 6
+
+	tests := []struct {
+		name           string
+		buckets        []ScoreBucket
+		expectedCounts map[string]int
+	}{
+		{
+			name:    "default buckets",
+			buckets: DefaultScoreBuckets,
+			expectedCounts: map[string]int{
+				"0-20%":   1,
+				"20-40%":  1,
+				"40-60%":  1,
+				"60-80%":  1,
+				"80-100%": 2,
+			},
+		},
+		{
+			name: "two buckets",
+			buckets: []ScoreBucket{
+				{Min: 0, Max: 50, Label: "low"},
+				{Min: 50, Max: 100, Label: "high"},
+			},
+			expectedCounts: map[string]int{
+				"low":  3,
+				"high": 3,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := NewTicketScoresService(
+				&mockCategoryRepo{categories: categories},
+				&mocks.MockRatingsRepo{Ratings: ratingsData},
+				NewTicketScoreService(),
+			)
+
+			report, err := service.GetTicketCountByScoreBucket(context.Background(), startDate, endDate, tt.buckets)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if len(report.Buckets) != len(tt.buckets) {
+				t.Fatalf("Expected %d buckets, got %d", len(tt.buckets), len(report.Buckets))
+			}
+
+			total := 0
+			for _, bucket := range report.Buckets {
+				if bucket.Count != tt.expectedCounts[bucket.Label] {
+					t.Errorf("Expected %d tickets in bucket %s, got %d", tt.expectedCounts[bucket.Label], bucket.Label, bucket.Count)
+				}
+				total += bucket.Count
+			}
+
+			if total != scoredTickets {
+				t.Errorf("Expected bucket counts to sum to %d, got %d", scoredTickets, total)
+			}
+		})
+	}
+
+	t.Run("percentages", func(t *testing.T) {
+		service := NewTicketScoresService(
+			&mockCategoryRepo{categories: categories},
+			&mocks.MockRatingsRepo{Ratings: ratingsData},
+			NewTicketScoreService(),
+		)
+
+		report, err := service.GetTicketCountByScoreBucket(context.Background(), startDate, endDate, []ScoreBucket{
+			{Min: 0, Max: 50, Label: "low"},
+			{Min: 50, Max: 100, Label: "high"},
+		})
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		for _, bucket := range report.Buckets {
+			if bucket.Percentage != "50%" {
+				t.Errorf("Expected 50%% for bucket %s, got %s", bucket.Label, bucket.Percentage)
+			}
+		}
+	})
+
+	t.Run("invalid buckets", func(t *testing.T) {
+		service := NewTicketScoresService(&mockCategoryRepo{categories: categories}, &mocks.MockRatingsRepo{}, NewTicketScoreService())
+
+		_, err := service.GetTicketCountByScoreBucket(context.Background(), startDate, endDate, []ScoreBucket{{Min: 0, Max: 50, Label: "low"}})
+		if err == nil {
+			t.Error("Expected error but got none")
+		}
+	})
+
+	t.Run("stream error", func(t *testing.T) {
+		service := NewTicketScoresService(
+			&mockCategoryRepo{categories: categories},
+			&mocks.MockRatingsRepo{Err: errors.New("database error")},
+			NewTicketScoreService(),
+		)
+
+		_, err := service.GetTicketCountByScoreBucket(context.Background(), startDate, endDate, DefaultScoreBuckets)
+		if err == nil {
+			t.Error("Expected error but got none")
+		}
+	})
+
+	t.Run("ticket scored from weighted ratings", func(t *testing.T) {
+		weighted := []models.RatingCategory{
+			{ID: 1, Name: "Spelling", Weight: 1},
+			{ID: 2, Name: "GDPR", Weight: 3},
+		}
+		// Spelling scores 100% and GDPR 20%: the mean of the two is 60%, the weighted score 40%
+		service := NewTicketScoresService(
+			&mockCategoryRepo{categories: weighted},
+			&mocks.MockRatingsRepo{Ratings: map[string][]models.Rating{
+				"1-2019-10-01": {{ID: 1, TicketID: 1, RatingCategoryID: 1, Rating: 5, CreatedAt: createdAt}},
+				"2-2019-10-01": {{ID: 2, TicketID: 1, RatingCategoryID: 2, Rating: 1, CreatedAt: createdAt}},
+			}},
+			NewTicketScoreService(),
+		)
+
+		report, err := service.GetTicketCountByScoreBucket(context.Background(), startDate, endDate, DefaultScoreBuckets)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		for _, bucket := range report.Buckets {
+			expected := 0
+			if bucket.Label == "40-60%" {
+				expected = 1
+			}
+			if bucket.Count != expected {
+				t.Errorf("Expected %d tickets in bucket %s, got %d", expected, bucket.Label, bucket.Count)
+			}
+		}
+	})
+}
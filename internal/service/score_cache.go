@@ -0,0 +1,90 @@
+package service
+
+import (
+	"container/list"
+	"sync"
+)
+
+// maxCachedScores is the number of period scores an OverallQualityService keeps cached
+const maxCachedScores = 1000
+
+// scoreCache holds up to capacity overall quality scores, evicting the least recently used
+type scoreCache struct {
+	mu       sync.Mutex
+	capacity int
+	order    *list.List // Most recently used first
+	entries  map[string]*list.Element
+
+	// gen counts the calls to clear, so scores calculated before one aren't cached after it
+	gen uint64
+}
+
+// scoreCacheEntry is the value of each element in scoreCache.order
+type scoreCacheEntry struct {
+	key   string
+	score OverallQualityScore
+}
+
+func newScoreCache(capacity int) *scoreCache {
+	return &scoreCache{
+		capacity: capacity,
+		order:    list.New(),
+		entries:  make(map[string]*list.Element),
+	}
+}
+
+// get returns a copy of the score cached under key
+func (c *scoreCache) get(key string) (OverallQualityScore, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	element, ok := c.entries[key]
+	if !ok {
+		return OverallQualityScore{}, false
+	}
+	c.order.MoveToFront(element)
+	return element.Value.(*scoreCacheEntry).score, true
+}
+
+// generation returns the current generation of the cache. Take it before calculating a score and
+// pass it to put.
+func (c *scoreCache) generation() uint64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.gen
+}
+
+// put caches score under key, evicting the least recently used score when the cache is full. The
+// score is dropped if the cache was cleared since generation was taken, as it may be out of date.
+func (c *scoreCache) put(key string, score OverallQualityScore, generation uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if generation != c.gen {
+		return
+	}
+
+	if element, ok := c.entries[key]; ok {
+		element.Value.(*scoreCacheEntry).score = score
+		c.order.MoveToFront(element)
+		return
+	}
+
+	c.entries[key] = c.order.PushFront(&scoreCacheEntry{key: key, score: score})
+	if c.order.Len() > c.capacity {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*scoreCacheEntry).key)
+	}
+}
+
+// clear drops every cached score
+func (c *scoreCache) clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.order.Init()
+	clear(c.entries)
+	c.gen++
+}
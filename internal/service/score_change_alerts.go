@@ -0,0 +1,70 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+)
+
+// Directions of a score change
+const (
+	ScoreChangeUp   = "up"
+	ScoreChangeDown = "down"
+)
+
+// ScoreChangeAlert is a large change between the scores of two consecutive rated days
+type ScoreChangeAlert struct {
+	FromDate  string  `json:"fromDate"`
+	ToDate    string  `json:"toDate"`
+	FromScore string  `json:"fromScore"`
+	ToScore   string  `json:"toScore"`
+	Change    float64 `json:"change"` // ToScore - FromScore in percentage points
+	Direction string  `json:"direction"`
+}
+
+// GetScoreChangeAlerts finds where a category's daily score changed by more than minChangePct percentage
+// points from one rated day to the next. Days without ratings are skipped, so a change across them is
+// reported from the last rated day before them.
+func (s *RatingAnalyticsService) GetScoreChangeAlerts(ctx context.Context, categoryID int, startDate, endDate time.Time, minChangePct float64) ([]ScoreChangeAlert, error) {
+	category, err := findCategory(ctx, s.categoryRepo, categoryID)
+	if err != nil {
+		return nil, err
+	}
+
+	dailyScores, _, err := s.calculateDailyScores(ctx, category, startDate, endDate)
+	if err != nil {
+		return nil, fmt.Errorf("failed to calculate daily scores: %w", err)
+	}
+
+	var alerts []ScoreChangeAlert
+	var previous *DailyScore
+	var previousValue float64
+	for i := range dailyScores {
+		value, ok := parseScore(dailyScores[i].Score)
+		if !ok {
+			continue
+		}
+
+		if previous != nil {
+			if change := value - previousValue; math.Abs(change) > minChangePct {
+				direction := ScoreChangeUp
+				if change < 0 {
+					direction = ScoreChangeDown
+				}
+				alerts = append(alerts, ScoreChangeAlert{
+					FromDate:  previous.Date,
+					ToDate:    dailyScores[i].Date,
+					FromScore: previous.Score,
+					ToScore:   dailyScores[i].Score,
+					Change:    change,
+					Direction: direction,
+				})
+			}
+		}
+
+		previous, previousValue = &dailyScores[i], value
+	}
+
+	return alerts, nil
+}
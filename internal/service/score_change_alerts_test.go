@@ -0,0 +1,95 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"ticket-score-service/internal/mocks"
+	"ticket-score-service/internal/models"
+)
+
+func TestGetScoreChangeAlerts(t *testing.T) {
+	startDate := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	categories := []models.RatingCategory{{ID: 1, Name: "Spelling", Weight: 1}}
+
+	// dailyRatings gives each day one rating with the given value; 0 leaves the day empty
+	dailyRatings := func(values ...int) map[string][]models.Rating {
+		ratings := make(map[string][]models.Rating)
+		for i, value := range values {
+			if value == 0 {
+				continue
+			}
+			day := startDate.AddDate(0, 0, i)
+			ratings[fmt.Sprintf("1-%s", day.Format("2006-01-02"))] = []models.Rating{{ID: i + 1, RatingCategoryID: 1, Rating: value, CreatedAt: day.Add(time.Hour)}}
+		}
+		return ratings
+	}
+
+	tests := []struct {
+		name         string
+		ratings      map[string][]models.Rating
+		days         int
+		minChangePct float64
+		expected     []ScoreChangeAlert
+	}{
+		{
+			name:         "drop and recovery",
+			ratings:      dailyRatings(4, 4, 3, 4, 4), // 80%, 80%, 60%, 80%, 80%
+			days:         5,
+			minChangePct: 15,
+			expected: []ScoreChangeAlert{
+				{FromDate: "2024-01-02", ToDate: "2024-01-03", FromScore: "80%", ToScore: "60%", Change: -20, Direction: ScoreChangeDown},
+				{FromDate: "2024-01-03", ToDate: "2024-01-04", FromScore: "60%", ToScore: "80%", Change: 20, Direction: ScoreChangeUp},
+			},
+		},
+		{
+			name:         "change equal to the threshold",
+			ratings:      dailyRatings(4, 4, 3, 4, 4),
+			days:         5,
+			minChangePct: 20,
+			expected:     nil,
+		},
+		{
+			name:         "days without ratings are skipped",
+			ratings:      dailyRatings(4, 0, 0, 2),
+			days:         4,
+			minChangePct: 15,
+			expected: []ScoreChangeAlert{
+				{FromDate: "2024-01-01", ToDate: "2024-01-04", FromScore: "80%", ToScore: "40%", Change: -40, Direction: ScoreChangeDown},
+			},
+		},
+		{
+			name:         "no ratings",
+			ratings:      nil,
+			days:         3,
+			minChangePct: 15,
+			expected:     nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := NewRatingAnalyticsService(&mockCategoryRepo{categories: categories}, &mocks.MockRatingsRepo{Ratings: tt.ratings}, NewTicketScoreService())
+
+			alerts, err := service.GetScoreChangeAlerts(context.Background(), 1, startDate, startDate.AddDate(0, 0, tt.days-1), tt.minChangePct)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(alerts, tt.expected) {
+				t.Errorf("Expected %+v, got %+v", tt.expected, alerts)
+			}
+		})
+	}
+
+	t.Run("unknown category", func(t *testing.T) {
+		service := NewRatingAnalyticsService(&mockCategoryRepo{categories: categories}, &mocks.MockRatingsRepo{}, NewTicketScoreService())
+
+		if _, err := service.GetScoreChangeAlerts(context.Background(), 99, startDate, startDate, 15); !errors.Is(err, ErrCategoryNotFound) {
+			t.Errorf("Expected ErrCategoryNotFound, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,79 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"ticket-score-service/internal/models"
+)
+
+// ConsistencyReport describes how much a category's daily scores vary over a date range
+type ConsistencyReport struct {
+	CategoryName           string  `json:"category_name"`
+	Mean                   float64 `json:"mean"`
+	StdDev                 float64 `json:"std_dev"`
+	CoefficientOfVariation float64 `json:"coefficient_of_variation"`
+	ConsistencyGrade       string  `json:"consistency_grade"`
+}
+
+// GetCategoryScoreConsistency calculates the mean, standard deviation and coefficient of variation
+// (stdDev / mean × 100) of a category's daily scores. Days without ratings are left out; when there
+// are none at all the grade is "N/A".
+func (s *RatingAnalyticsService) GetCategoryScoreConsistency(ctx context.Context, categoryID int, startDate, endDate time.Time) (*ConsistencyReport, error) {
+	category, err := findCategory(ctx, s.categoryRepo, categoryID)
+	if err != nil {
+		return nil, err
+	}
+
+	ratings, err := s.ratingsRepo.GetByCategoryIDAndDateRange(ctx, categoryID, startDate, endDate)
+	if err != nil {
+		return nil, logError(ctx, fmt.Errorf("failed to get ratings: %w", err))
+	}
+
+	var dailyScores []float64
+	for _, dailyRatings := range groupRatingsByDate(ratings, startDate.Location()) {
+		score, err := s.ticketScoreServ.CalculateScore(dailyRatings, []models.RatingCategory{category})
+		if err != nil {
+			return nil, fmt.Errorf("failed to calculate daily score: %w", err)
+		}
+		dailyScores = append(dailyScores, score)
+	}
+
+	report := &ConsistencyReport{
+		CategoryName:     category.Name,
+		ConsistencyGrade: "N/A",
+	}
+	if len(dailyScores) == 0 {
+		return report, nil
+	}
+
+	var sum float64
+	for _, score := range dailyScores {
+		sum += score
+	}
+	report.Mean = sum / float64(len(dailyScores))
+	report.StdDev = standardDeviation(dailyScores)
+	if report.Mean > 0 {
+		report.CoefficientOfVariation = report.StdDev / report.Mean * 100
+	}
+	report.ConsistencyGrade = consistencyGrade(report.CoefficientOfVariation)
+
+	return report, nil
+}
+
+// consistencyGrade grades a coefficient of variation, from A (very consistent) to F
+func consistencyGrade(coefficientOfVariation float64) string {
+	switch {
+	case coefficientOfVariation < 5:
+		return "A"
+	case coefficientOfVariation < 10:
+		return "B"
+	case coefficientOfVariation < 20:
+		return "C"
+	case coefficientOfVariation < 30:
+		return "D"
+	default:
+		return "F"
+	}
+}
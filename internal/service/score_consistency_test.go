@@ -0,0 +1,124 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"testing"
+	"time"
+
+	"ticket-score-service/internal/mocks"
+	"ticket-score-service/internal/models"
+)
+
+func TestGetCategoryScoreConsistency(t *testing.T) {
+	startDate := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2024, 1, 7, 0, 0, 0, 0, time.UTC)
+	categories := []models.RatingCategory{{ID: 1, Name: "Spelling", Weight: 1}}
+
+	// dailyRatings gives each day one rating with the given value
+	dailyRatings := func(values ...int) map[string][]models.Rating {
+		ratings := make(map[string][]models.Rating)
+		for i, value := range values {
+			day := startDate.AddDate(0, 0, i)
+			key := fmt.Sprintf("1-%s", day.Format("2006-01-02"))
+			ratings[key] = []models.Rating{{ID: i + 1, RatingCategoryID: 1, Rating: value, CreatedAt: day.Add(time.Hour)}}
+		}
+		return ratings
+	}
+
+	tests := []struct {
+		name          string
+		ratings       map[string][]models.Rating
+		expectedMean  float64
+		expectedStd   float64
+		expectedCV    float64
+		expectedGrade string
+	}{
+		{
+			name:          "identical daily scores",
+			ratings:       dailyRatings(4, 4, 4),
+			expectedMean:  80,
+			expectedStd:   0,
+			expectedCV:    0,
+			expectedGrade: "A",
+		},
+		{
+			name:          "slightly varying daily scores",
+			ratings:       dailyRatings(4, 5),
+			expectedMean:  90,
+			expectedStd:   10,
+			expectedCV:    100.0 / 9.0,
+			expectedGrade: "C",
+		},
+		{
+			name:          "widely varying daily scores",
+			ratings:       dailyRatings(1, 5, 1, 5),
+			expectedMean:  60,
+			expectedStd:   40,
+			expectedCV:    200.0 / 3.0,
+			expectedGrade: "F",
+		},
+		{
+			name:          "no ratings",
+			ratings:       map[string][]models.Rating{},
+			expectedGrade: "N/A",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := NewRatingAnalyticsService(&mockCategoryRepo{categories: categories}, &mocks.MockRatingsRepo{Ratings: tt.ratings}, NewTicketScoreService())
+
+			report, err := service.GetCategoryScoreConsistency(context.Background(), 1, startDate, endDate)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if report.CategoryName != "Spelling" {
+				t.Errorf("Expected category Spelling, got %s", report.CategoryName)
+			}
+			if math.Abs(report.Mean-tt.expectedMean) > 1e-9 {
+				t.Errorf("Expected mean %.4f, got %.4f", tt.expectedMean, report.Mean)
+			}
+			if math.Abs(report.StdDev-tt.expectedStd) > 1e-9 {
+				t.Errorf("Expected standard deviation %.4f, got %.4f", tt.expectedStd, report.StdDev)
+			}
+			if math.Abs(report.CoefficientOfVariation-tt.expectedCV) > 1e-9 {
+				t.Errorf("Expected coefficient of variation %.4f, got %.4f", tt.expectedCV, report.CoefficientOfVariation)
+			}
+			if report.ConsistencyGrade != tt.expectedGrade {
+				t.Errorf("Expected grade %s, got %s", tt.expectedGrade, report.ConsistencyGrade)
+			}
+		})
+	}
+
+	t.Run("unknown category", func(t *testing.T) {
+		service := NewRatingAnalyticsService(&mockCategoryRepo{categories: categories}, &mocks.MockRatingsRepo{}, NewTicketScoreService())
+		if _, err := service.GetCategoryScoreConsistency(context.Background(), 99, startDate, endDate); !errors.Is(err, ErrCategoryNotFound) {
+			t.Errorf("Expected ErrCategoryNotFound, got %v", err)
+		}
+	})
+}
+
+func TestConsistencyGrade(t *testing.T) {
+	tests := []struct {
+		coefficientOfVariation float64
+		expected               string
+	}{
+		{0, "A"},
+		{4.9, "A"},
+		{5, "B"},
+		{10, "C"},
+		{20, "D"},
+		{30, "F"},
+		{150, "F"},
+	}
+
+	for _, tt := range tests {
+		if result := consistencyGrade(tt.coefficientOfVariation); result != tt.expected {
+			t.Errorf("consistencyGrade(%.1f) = %s, expected %s", tt.coefficientOfVariation, result, tt.expected)
+		}
+	}
+}
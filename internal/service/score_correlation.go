@@ -0,0 +1,121 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+)
+
+// Correlation labels for the strength of a Pearson correlation coefficient
+const (
+	CorrelationStrong   = "strong"
+	CorrelationModerate = "moderate"
+	CorrelationWeak     = "weak"
+)
+
+// ErrInsufficientCorrelationData is returned when two score series can't be correlated
+var ErrInsufficientCorrelationData = errors.New("insufficient data for correlation")
+
+// CorrelationReport describes how closely two categories' daily scores move together
+type CorrelationReport struct {
+	Category1        string  `json:"category1"`
+	Category2        string  `json:"category2"`
+	PearsonR         float64 `json:"pearsonR"`
+	CorrelationLabel string  `json:"correlationLabel"`
+	DataPointsUsed   int     `json:"dataPointsUsed"`
+}
+
+// GetCategoryScoreCorrelation calculates the Pearson correlation coefficient of two categories'
+// daily scores, using only the days on which both categories have ratings. It fails with
+// ErrInsufficientCorrelationData when fewer than two such days exist or either series is constant.
+func (s *RatingAnalyticsService) GetCategoryScoreCorrelation(ctx context.Context, categoryID1, categoryID2 int, startDate, endDate time.Time) (*CorrelationReport, error) {
+	category1, err := findCategory(ctx, s.categoryRepo, categoryID1)
+	if err != nil {
+		return nil, err
+	}
+	category2, err := findCategory(ctx, s.categoryRepo, categoryID2)
+	if err != nil {
+		return nil, err
+	}
+
+	daily1, dates1, err := s.ratedDailyScores(ctx, category1, startDate, endDate)
+	if err != nil {
+		return nil, fmt.Errorf("failed to calculate daily scores for category %s: %w", category1.Name, err)
+	}
+	daily2, dates2, err := s.ratedDailyScores(ctx, category2, startDate, endDate)
+	if err != nil {
+		return nil, fmt.Errorf("failed to calculate daily scores for category %s: %w", category2.Name, err)
+	}
+
+	// Both series are in date order, so the shared days are found in a single pass
+	var scores1, scores2 []float64
+	for i, j := 0, 0; i < len(dates1) && j < len(dates2); {
+		switch {
+		case dates1[i] < dates2[j]:
+			i++
+		case dates1[i] > dates2[j]:
+			j++
+		default:
+			scores1 = append(scores1, daily1[i])
+			scores2 = append(scores2, daily2[j])
+			i++
+			j++
+		}
+	}
+
+	if len(scores1) < 2 {
+		return nil, fmt.Errorf("%w: %d days with ratings in both categories, need at least 2", ErrInsufficientCorrelationData, len(scores1))
+	}
+
+	r, ok := pearsonCorrelation(scores1, scores2)
+	if !ok {
+		return nil, fmt.Errorf("%w: scores of a category don't vary", ErrInsufficientCorrelationData)
+	}
+
+	return &CorrelationReport{
+		Category1:        category1.Name,
+		Category2:        category2.Name,
+		PearsonR:         r,
+		CorrelationLabel: correlationLabel(r),
+		DataPointsUsed:   len(scores1),
+	}, nil
+}
+
+// pearsonCorrelation returns the Pearson correlation coefficient of two equally long series,
+// or false if either series has no variance
+func pearsonCorrelation(xs, ys []float64) (float64, bool) {
+	var sumX, sumY float64
+	for i := range xs {
+		sumX += xs[i]
+		sumY += ys[i]
+	}
+	meanX := sumX / float64(len(xs))
+	meanY := sumY / float64(len(ys))
+
+	var covariance, varianceX, varianceY float64
+	for i := range xs {
+		dx, dy := xs[i]-meanX, ys[i]-meanY
+		covariance += dx * dy
+		varianceX += dx * dx
+		varianceY += dy * dy
+	}
+	if varianceX == 0 || varianceY == 0 {
+		return 0, false
+	}
+
+	return covariance / math.Sqrt(varianceX*varianceY), true
+}
+
+// correlationLabel classifies the strength of a correlation coefficient
+func correlationLabel(r float64) string {
+	switch {
+	case math.Abs(r) > 0.7:
+		return CorrelationStrong
+	case math.Abs(r) > 0.4:
+		return CorrelationModerate
+	default:
+		return CorrelationWeak
+	}
+}
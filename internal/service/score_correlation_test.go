@@ -0,0 +1,149 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"testing"
+	"time"
+
+	"ticket-score-service/internal/mocks"
+	"ticket-score-service/internal/models"
+)
+
+func TestGetCategoryScoreCorrelation(t *testing.T) {
+	startDate := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2024, 1, 7, 0, 0, 0, 0, time.UTC)
+	categories := []models.RatingCategory{
+		{ID: 1, Name: "Spelling", Weight: 1},
+		{ID: 2, Name: "Grammar", Weight: 1},
+	}
+
+	// dailyRatings gives each category one rating per day with the given values; -1 leaves the day empty
+	dailyRatings := func(values1, values2 []int) map[string][]models.Rating {
+		ratings := make(map[string][]models.Rating)
+		id := 1
+		for categoryID, values := range map[int][]int{1: values1, 2: values2} {
+			for i, value := range values {
+				if value < 0 {
+					continue
+				}
+				day := startDate.AddDate(0, 0, i)
+				key := fmt.Sprintf("%d-%s", categoryID, day.Format("2006-01-02"))
+				ratings[key] = []models.Rating{{ID: id, RatingCategoryID: categoryID, Rating: value, CreatedAt: day.Add(time.Hour)}}
+				id++
+			}
+		}
+		return ratings
+	}
+
+	tests := []struct {
+		name          string
+		ratings       map[string][]models.Rating
+		expectedR     float64
+		expectedLabel string
+		expectedDays  int
+	}{
+		{
+			name:          "identical series",
+			ratings:       dailyRatings([]int{1, 2, 3, 4, 5}, []int{1, 2, 3, 4, 5}),
+			expectedR:     1,
+			expectedLabel: CorrelationStrong,
+			expectedDays:  5,
+		},
+		{
+			name:          "inverse series",
+			ratings:       dailyRatings([]int{1, 2, 3, 4, 5}, []int{5, 4, 3, 2, 1}),
+			expectedR:     -1,
+			expectedLabel: CorrelationStrong,
+			expectedDays:  5,
+		},
+		{
+			name:          "days without ratings in either category are skipped",
+			ratings:       dailyRatings([]int{1, 2, -1, 4, 5, 3}, []int{1, -1, 0, 4, 5, 3}),
+			expectedR:     1,
+			expectedLabel: CorrelationStrong,
+			expectedDays:  4,
+		},
+		{
+			name:          "uncorrelated series",
+			ratings:       dailyRatings([]int{1, 2, 3, 4}, []int{2, 4, 4, 2}),
+			expectedR:     0,
+			expectedLabel: CorrelationWeak,
+			expectedDays:  4,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := NewRatingAnalyticsService(&mockCategoryRepo{categories: categories}, &mocks.MockRatingsRepo{Ratings: tt.ratings}, NewTicketScoreService())
+
+			report, err := service.GetCategoryScoreCorrelation(context.Background(), 1, 2, startDate, endDate)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if report.Category1 != "Spelling" || report.Category2 != "Grammar" {
+				t.Errorf("Expected Spelling and Grammar, got %s and %s", report.Category1, report.Category2)
+			}
+			if math.Abs(report.PearsonR-tt.expectedR) > 1e-9 {
+				t.Errorf("Expected r %.4f, got %.4f", tt.expectedR, report.PearsonR)
+			}
+			if report.CorrelationLabel != tt.expectedLabel {
+				t.Errorf("Expected label %s, got %s", tt.expectedLabel, report.CorrelationLabel)
+			}
+			if report.DataPointsUsed != tt.expectedDays {
+				t.Errorf("Expected %d data points, got %d", tt.expectedDays, report.DataPointsUsed)
+			}
+		})
+	}
+
+	errorTests := []struct {
+		name     string
+		ratings  map[string][]models.Rating
+		expected error
+	}{
+		{name: "zero-length overlap", ratings: dailyRatings([]int{1, 2, -1, -1}, []int{-1, -1, 3, 4}), expected: ErrInsufficientCorrelationData},
+		{name: "single overlapping day", ratings: dailyRatings([]int{1, 2}, []int{-1, 4}), expected: ErrInsufficientCorrelationData},
+		{name: "constant series", ratings: dailyRatings([]int{3, 3, 3}, []int{1, 2, 3}), expected: ErrInsufficientCorrelationData},
+	}
+
+	for _, tt := range errorTests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := NewRatingAnalyticsService(&mockCategoryRepo{categories: categories}, &mocks.MockRatingsRepo{Ratings: tt.ratings}, NewTicketScoreService())
+
+			if _, err := service.GetCategoryScoreCorrelation(context.Background(), 1, 2, startDate, endDate); !errors.Is(err, tt.expected) {
+				t.Errorf("Expected %v, got %v", tt.expected, err)
+			}
+		})
+	}
+
+	t.Run("unknown category", func(t *testing.T) {
+		service := NewRatingAnalyticsService(&mockCategoryRepo{categories: categories}, &mocks.MockRatingsRepo{}, NewTicketScoreService())
+
+		if _, err := service.GetCategoryScoreCorrelation(context.Background(), 1, 99, startDate, endDate); !errors.Is(err, ErrCategoryNotFound) {
+			t.Errorf("Expected ErrCategoryNotFound, got %v", err)
+		}
+	})
+}
+
+func TestCorrelationLabel(t *testing.T) {
+	tests := []struct {
+		r        float64
+		expected string
+	}{
+		{1, CorrelationStrong},
+		{-0.71, CorrelationStrong},
+		{0.7, CorrelationModerate},
+		{-0.41, CorrelationModerate},
+		{0.4, CorrelationWeak},
+		{0, CorrelationWeak},
+	}
+
+	for _, tt := range tests {
+		if label := correlationLabel(tt.r); label != tt.expected {
+			t.Errorf("correlationLabel(%.2f) = %s, expected %s", tt.r, label, tt.expected)
+		}
+	}
+}
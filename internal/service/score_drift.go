@@ -0,0 +1,42 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"ticket-score-service/internal/utils"
+)
+
+// DriftReport is the outcome of a Mann-Kendall trend test on a category's daily scores
+type DriftReport struct {
+	CategoryName   string  `json:"categoryName"`
+	ZScore         float64 `json:"zScore"`
+	TrendDirection string  `json:"trendDirection"`
+	PValue         float64 `json:"pValue"`
+	DataPoints     int     `json:"dataPoints"`
+}
+
+// GetCategoryScoreDrift runs the Mann-Kendall trend test on a category's daily scores from
+// startDate to endDate, in date order, to detect a long-term upward or downward drift. Days
+// without ratings are left out.
+func (s *RatingAnalyticsService) GetCategoryScoreDrift(ctx context.Context, categoryID int, startDate, endDate time.Time) (*DriftReport, error) {
+	category, err := findCategory(ctx, s.categoryRepo, categoryID)
+	if err != nil {
+		return nil, err
+	}
+
+	dailyScores, _, err := s.ratedDailyScores(ctx, category, startDate, endDate)
+	if err != nil {
+		return nil, err
+	}
+
+	result := utils.MannKendall(dailyScores)
+
+	return &DriftReport{
+		CategoryName:   category.Name,
+		ZScore:         result.ZScore,
+		TrendDirection: result.Trend,
+		PValue:         result.PValue,
+		DataPoints:     len(dailyScores),
+	}, nil
+}
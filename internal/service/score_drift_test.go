@@ -0,0 +1,59 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"ticket-score-service/internal/mocks"
+	"ticket-score-service/internal/models"
+	"ticket-score-service/internal/utils"
+)
+
+func TestGetCategoryScoreDrift(t *testing.T) {
+	startDate := time.Date(2019, 10, 1, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2019, 10, 10, 0, 0, 0, 0, time.UTC)
+	categories := []models.RatingCategory{{ID: 1, Name: "Spelling", Weight: 1}}
+
+	// Scores rise every day from 20% to 100%, with no ratings on 2019-10-05
+	ratings := map[string][]models.Rating{}
+	step := 0
+	for day := 0; day < 10; day++ {
+		if day == 4 {
+			continue
+		}
+		createdAt := startDate.AddDate(0, 0, day).Add(time.Hour)
+		step++
+		ratings[createdAt.Format("2006-01-02")] = []models.Rating{
+			{ID: day*2 + 1, RatingCategoryID: 1, Rating: (step + 1) / 2, CreatedAt: createdAt},
+			{ID: day*2 + 2, RatingCategoryID: 1, Rating: step/2 + 1, CreatedAt: createdAt},
+		}
+	}
+
+	service := NewRatingAnalyticsService(&mockCategoryRepo{categories: categories}, &mocks.MockRatingsRepo{Ratings: ratings}, NewTicketScoreService())
+
+	report, err := service.GetCategoryScoreDrift(context.Background(), 1, startDate, endDate)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if report.CategoryName != "Spelling" || report.DataPoints != 9 {
+		t.Errorf("Expected 9 data points for Spelling, got %d for %s", report.DataPoints, report.CategoryName)
+	}
+	if report.ZScore <= 0 {
+		t.Errorf("Expected a positive Z score, got %.3f", report.ZScore)
+	}
+	if report.TrendDirection != utils.TrendUpward {
+		t.Errorf("Expected an upward trend, got %q", report.TrendDirection)
+	}
+	if report.PValue >= 0.05 {
+		t.Errorf("Expected a significant p-value, got %.4f", report.PValue)
+	}
+
+	t.Run("unknown category", func(t *testing.T) {
+		if _, err := service.GetCategoryScoreDrift(context.Background(), 99, startDate, endDate); !errors.Is(err, ErrCategoryNotFound) {
+			t.Errorf("Expected ErrCategoryNotFound, got %v", err)
+		}
+	})
+}
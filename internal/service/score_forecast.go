@@ -0,0 +1,108 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+)
+
+// ErrInsufficientForecastData is returned when a category has too few rated days to fit a trend
+var ErrInsufficientForecastData = errors.New("insufficient data for forecast")
+
+// ForecastDay is a day's score in a forecast, either observed or projected from the trend
+type ForecastDay struct {
+	Date        string `json:"date"`
+	Score       string `json:"score"`
+	IsProjected bool   `json:"isProjected"`
+}
+
+// ScoreForecast holds a category's recent daily scores and the days projected from their trend
+type ScoreForecast struct {
+	CategoryName   string        `json:"categoryName"`
+	Slope          float64       `json:"slope"`
+	HistoricalDays []DailyScore  `json:"historicalDays"`
+	ForecastedDays []ForecastDay `json:"forecastedDays"`
+}
+
+// GetCategoryScoreForecast fits a least-squares line to a category's daily scores over the last
+// lookbackDays days up to and including today (UTC), skipping days without ratings, and projects it
+// forecastDays days past today. Projected scores are clamped to [0, 100]; Slope is in percentage
+// points per day. It fails with ErrInsufficientForecastData when fewer than two days have ratings.
+func (s *RatingAnalyticsService) GetCategoryScoreForecast(ctx context.Context, categoryID int, lookbackDays, forecastDays int) (*ScoreForecast, error) {
+	category, err := findCategory(ctx, s.categoryRepo, categoryID)
+	if err != nil {
+		return nil, err
+	}
+
+	endDate := time.Now().UTC().Truncate(24 * time.Hour)
+	startDate := endDate.AddDate(0, 0, -(lookbackDays - 1))
+
+	scores, dates, err := s.ratedDailyScores(ctx, category, startDate, endDate)
+	if err != nil {
+		return nil, fmt.Errorf("failed to calculate daily scores: %w", err)
+	}
+
+	// Every day is reported, and the days with ratings are fitted by their offset from startDate
+	var historicalDays []DailyScore
+	var xs, ys []float64
+	for day, next := 0, 0; day < lookbackDays; day++ {
+		dateStr := startDate.AddDate(0, 0, day).Format("2006-01-02")
+		if next == len(dates) || dates[next] != dateStr {
+			historicalDays = append(historicalDays, DailyScore{Date: dateStr, Score: "N/A"})
+			continue
+		}
+
+		historicalDays = append(historicalDays, DailyScore{Date: dateStr, Score: s.formatScore(scores[next])})
+		xs = append(xs, float64(day))
+		ys = append(ys, scores[next])
+		next++
+	}
+
+	if len(xs) < 2 {
+		return nil, fmt.Errorf("%w: %d days with ratings, need at least 2", ErrInsufficientForecastData, len(xs))
+	}
+
+	slope, intercept := linearFit(xs, ys)
+
+	forecast := &ScoreForecast{
+		CategoryName:   category.Name,
+		Slope:          slope,
+		HistoricalDays: historicalDays,
+		ForecastedDays: []ForecastDay{},
+	}
+	for day := 1; day <= forecastDays; day++ {
+		x := float64(lookbackDays - 1 + day)
+		projected := math.Max(0, math.Min(100, intercept+slope*x))
+		forecast.ForecastedDays = append(forecast.ForecastedDays, ForecastDay{
+			Date:        endDate.AddDate(0, 0, day).Format("2006-01-02"),
+			Score:       s.formatScore(projected),
+			IsProjected: true,
+		})
+	}
+
+	return forecast, nil
+}
+
+// linearFit returns the slope and intercept of the least-squares line through the points (xs[i], ys[i]).
+// The xs must not all be equal.
+func linearFit(xs, ys []float64) (float64, float64) {
+	n := float64(len(xs))
+
+	var sumX, sumY float64
+	for i := range xs {
+		sumX += xs[i]
+		sumY += ys[i]
+	}
+	meanX, meanY := sumX/n, sumY/n
+
+	var covariance, varianceX float64
+	for i := range xs {
+		covariance += (xs[i] - meanX) * (ys[i] - meanY)
+		varianceX += (xs[i] - meanX) * (xs[i] - meanX)
+	}
+
+	slope := covariance / varianceX
+	return slope, meanY - slope*meanX
+}
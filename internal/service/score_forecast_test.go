@@ -0,0 +1,87 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"ticket-score-service/internal/mocks"
+	"ticket-score-service/internal/models"
+)
+
+func TestGetCategoryScoreForecast(t *testing.T) {
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+	categories := []models.RatingCategory{{ID: 1, Name: "Spelling", Weight: 1}}
+
+	// Four rated days in the last five scoring 20%, 30%, 40% and 50%, with no ratings two days ago
+	ratings := make(map[string][]models.Rating)
+	dailyRatings := map[int][2]int{4: {1, 1}, 3: {1, 2}, 1: {2, 2}, 0: {2, 3}}
+	for daysAgo, values := range dailyRatings {
+		day := today.AddDate(0, 0, -daysAgo)
+		ratings[day.Format("2006-01-02")] = []models.Rating{
+			{ID: daysAgo*2 + 1, RatingCategoryID: 1, Rating: values[0], CreatedAt: day.Add(time.Hour)},
+			{ID: daysAgo*2 + 2, RatingCategoryID: 1, Rating: values[1], CreatedAt: day.Add(2 * time.Hour)},
+		}
+	}
+
+	service := NewRatingAnalyticsService(&mockCategoryRepo{categories: categories}, &mocks.MockRatingsRepo{Ratings: ratings}, NewTicketScoreService())
+
+	forecast, err := service.GetCategoryScoreForecast(context.Background(), 1, 5, 3)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if forecast.CategoryName != "Spelling" {
+		t.Errorf("Expected category Spelling, got %s", forecast.CategoryName)
+	}
+	// The skipped day keeps its place on the x axis, so the fit is 7 points per day rather than 10
+	if math.Abs(forecast.Slope-7) > 0.01 {
+		t.Errorf("Expected a slope of 7 points per day, got %.2f", forecast.Slope)
+	}
+	if len(forecast.HistoricalDays) != 5 || forecast.HistoricalDays[2].Score != "N/A" {
+		t.Errorf("Expected 5 historical days with N/A two days ago, got %+v", forecast.HistoricalDays)
+	}
+	if len(forecast.ForecastedDays) != 3 {
+		t.Fatalf("Expected 3 forecasted days, got %d", len(forecast.ForecastedDays))
+	}
+
+	previous, _ := parseScore(forecast.HistoricalDays[len(forecast.HistoricalDays)-1].Score)
+	for i, day := range forecast.ForecastedDays {
+		expectedDate := today.AddDate(0, 0, i+1).Format("2006-01-02")
+		if day.Date != expectedDate || !day.IsProjected {
+			t.Errorf("Expected projected day %s, got %+v", expectedDate, day)
+		}
+
+		score, ok := parseScore(day.Score)
+		if !ok || score <= previous {
+			t.Errorf("Expected day %s to score above %.0f%%, got %s", day.Date, previous, day.Score)
+		}
+		previous = score
+	}
+
+	t.Run("projection clamped to 100", func(t *testing.T) {
+		forecast, err := service.GetCategoryScoreForecast(context.Background(), 1, 5, 10)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if last := forecast.ForecastedDays[len(forecast.ForecastedDays)-1]; last.Score != "100%" {
+			t.Errorf("Expected the last projection to be clamped to 100%%, got %s", last.Score)
+		}
+	})
+
+	t.Run("insufficient data", func(t *testing.T) {
+		_, err := service.GetCategoryScoreForecast(context.Background(), 1, 1, 3)
+		if !errors.Is(err, ErrInsufficientForecastData) {
+			t.Errorf("Expected ErrInsufficientForecastData, got %v", err)
+		}
+	})
+
+	t.Run("unknown category", func(t *testing.T) {
+		_, err := service.GetCategoryScoreForecast(context.Background(), 99, 5, 3)
+		if !errors.Is(err, ErrCategoryNotFound) {
+			t.Errorf("Expected ErrCategoryNotFound, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,82 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"ticket-score-service/internal/models"
+)
+
+// ScoreGap describes the spread between the best and worst scoring categories
+type ScoreGap struct {
+	BestCategory  string  `json:"best_category"`
+	BestScore     string  `json:"best_score"`
+	WorstCategory string  `json:"worst_category"`
+	WorstScore    string  `json:"worst_score"`
+	Gap           float64 `json:"gap"`
+	GapLabel      string  `json:"gap_label"`
+}
+
+// GetScoreGap finds the best and worst category scores over a date range and the gap between
+// them in percentage points. Categories without ratings are left out; when no category has
+// ratings the scores are "N/A" and the gap is 0. Ties go to the category listed first.
+func (s *RatingAnalyticsService) GetScoreGap(ctx context.Context, startDate, endDate time.Time) (*ScoreGap, error) {
+	categories, err := s.categoryRepo.GetAll(ctx)
+	if err != nil {
+		return nil, logError(ctx, err)
+	}
+
+	gap := &ScoreGap{BestScore: "N/A", WorstScore: "N/A"}
+	var best, worst float64
+	found := false
+	for _, category := range categories {
+		ratings, err := s.ratingsRepo.GetByCategoryIDAndDateRange(ctx, category.ID, startDate, endDate)
+		if err != nil {
+			return nil, logError(ctx, err)
+		}
+		if len(ratings) == 0 {
+			continue
+		}
+
+		rawScore, err := s.ticketScoreServ.CalculateScore(ratings, []models.RatingCategory{category})
+		if err != nil {
+			return nil, fmt.Errorf("failed to calculate score for category %s: %w", category.Name, err)
+		}
+
+		// Compare scores as displayed so equal-looking scores tie
+		formatted := s.formatScore(rawScore)
+		score, _ := parseScore(formatted)
+		if !found || score > best {
+			best = score
+			gap.BestCategory = category.Name
+			gap.BestScore = formatted
+		}
+		if !found || score < worst {
+			worst = score
+			gap.WorstCategory = category.Name
+			gap.WorstScore = formatted
+		}
+		found = true
+	}
+
+	// Round away float noise from subtracting the parsed scores
+	scale := math.Pow(10, float64(s.scorePrecision))
+	gap.Gap = math.Round((best-worst)*scale) / scale
+	gap.GapLabel = gapLabel(gap.Gap)
+
+	return gap, nil
+}
+
+// gapLabel describes a score gap as "tight" (under 5 points), "moderate" (5 to 15) or "wide"
+func gapLabel(gap float64) string {
+	switch {
+	case gap < 5:
+		return "tight"
+	case gap <= 15:
+		return "moderate"
+	default:
+		return "wide"
+	}
+}
@@ -0,0 +1,121 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"ticket-score-service/internal/mocks"
+	"ticket-score-service/internal/models"
+)
+
+func TestGetScoreGap(t *testing.T) {
+	startDate := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2024, 1, 7, 0, 0, 0, 0, time.UTC)
+	createdAt := startDate.Add(time.Hour)
+
+	categories := []models.RatingCategory{
+		{ID: 1, Name: "Spelling", Weight: 1},
+		{ID: 2, Name: "Grammar", Weight: 0.7},
+		{ID: 3, Name: "Tone", Weight: 0.5},
+	}
+
+	// ratingsFor gives each category one rating with the given value; 0 leaves the category without ratings
+	ratingsFor := func(values ...int) map[string][]models.Rating {
+		ratings := make(map[string][]models.Rating)
+		for i, value := range values {
+			if value == 0 {
+				continue
+			}
+			key := fmt.Sprintf("%d-2024-01-01", i+1)
+			ratings[key] = []models.Rating{{ID: i + 1, RatingCategoryID: i + 1, Rating: value, CreatedAt: createdAt}}
+		}
+		return ratings
+	}
+
+	tests := []struct {
+		name     string
+		ratings  map[string][]models.Rating
+		expected ScoreGap
+	}{
+		{
+			name:     "all N/A",
+			ratings:  ratingsFor(),
+			expected: ScoreGap{BestScore: "N/A", WorstScore: "N/A", Gap: 0, GapLabel: "tight"},
+		},
+		{
+			name:     "one category with data",
+			ratings:  ratingsFor(0, 4),
+			expected: ScoreGap{BestCategory: "Grammar", BestScore: "80%", WorstCategory: "Grammar", WorstScore: "80%", Gap: 0, GapLabel: "tight"},
+		},
+		{
+			name:     "all equal",
+			ratings:  ratingsFor(3, 3, 3),
+			expected: ScoreGap{BestCategory: "Spelling", BestScore: "60%", WorstCategory: "Spelling", WorstScore: "60%", Gap: 0, GapLabel: "tight"},
+		},
+		{
+			name: "moderate gap",
+			ratings: map[string][]models.Rating{
+				"1-2024-01-01": {{ID: 1, RatingCategoryID: 1, Rating: 5, CreatedAt: createdAt}},
+				"2-2024-01-01": {
+					{ID: 2, RatingCategoryID: 2, Rating: 5, CreatedAt: createdAt},
+					{ID: 3, RatingCategoryID: 2, Rating: 4, CreatedAt: createdAt},
+				},
+			},
+			expected: ScoreGap{BestCategory: "Spelling", BestScore: "100%", WorstCategory: "Grammar", WorstScore: "90%", Gap: 10, GapLabel: "moderate"},
+		},
+		{
+			name:     "categories without ratings are skipped",
+			ratings:  ratingsFor(5, 0, 4),
+			expected: ScoreGap{BestCategory: "Spelling", BestScore: "100%", WorstCategory: "Tone", WorstScore: "80%", Gap: 20, GapLabel: "wide"},
+		},
+		{
+			name:     "wide gap",
+			ratings:  ratingsFor(1, 5, 3),
+			expected: ScoreGap{BestCategory: "Grammar", BestScore: "100%", WorstCategory: "Spelling", WorstScore: "20%", Gap: 80, GapLabel: "wide"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := NewRatingAnalyticsService(&mockCategoryRepo{categories: categories}, &mocks.MockRatingsRepo{Ratings: tt.ratings}, NewTicketScoreService())
+
+			gap, err := service.GetScoreGap(context.Background(), startDate, endDate)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if *gap != tt.expected {
+				t.Errorf("Expected %+v, got %+v", tt.expected, *gap)
+			}
+		})
+	}
+
+	t.Run("category error", func(t *testing.T) {
+		errorService := NewRatingAnalyticsService(&mockCategoryRepo{err: fmt.Errorf("database error")}, &mocks.MockRatingsRepo{}, NewTicketScoreService())
+		if _, err := errorService.GetScoreGap(context.Background(), startDate, endDate); err == nil {
+			t.Error("Expected error but got none")
+		}
+	})
+}
+
+func TestGapLabel(t *testing.T) {
+	tests := []struct {
+		gap      float64
+		expected string
+	}{
+		{0, "tight"},
+		{4.99, "tight"},
+		{5, "moderate"},
+		{15, "moderate"},
+		{15.01, "wide"},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%g", tt.gap), func(t *testing.T) {
+			if label := gapLabel(tt.gap); label != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, label)
+			}
+		})
+	}
+}
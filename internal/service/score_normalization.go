@@ -0,0 +1,81 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"ticket-score-service/internal/models"
+)
+
+// ScoreNormalizationService adjusts ratings for reviewer harshness bias before scoring
+type ScoreNormalizationService struct {
+	ratingsRepo               RatingsRepository
+	ticketScoreServ           ScoreCalculator
+	useReviewerBiasCorrection bool
+}
+
+// NewScoreNormalizationService creates a new score normalization service instance
+func NewScoreNormalizationService(
+	ratingsRepo RatingsRepository,
+	ticketScoreServ ScoreCalculator,
+	useReviewerBiasCorrection bool,
+) *ScoreNormalizationService {
+	return &ScoreNormalizationService{
+		ratingsRepo:               ratingsRepo,
+		ticketScoreServ:           ticketScoreServ,
+		useReviewerBiasCorrection: useReviewerBiasCorrection,
+	}
+}
+
+// NormalizeScoreForReviewerBias removes each reviewer's bias from their ratings.
+// A reviewer's bias is the deviation of their mean rating from the global mean
+// of all reviewer means within the date range.
+// Ratings are returned unchanged when bias correction is disabled.
+func (s *ScoreNormalizationService) NormalizeScoreForReviewerBias(ctx context.Context, ratings []models.Rating, startDate, endDate time.Time) ([]models.Rating, error) {
+	if !s.useReviewerBiasCorrection || len(ratings) == 0 {
+		return ratings, nil
+	}
+
+	reviewerAverages, err := s.ratingsRepo.GetAverageScoreByReviewer(ctx, startDate, endDate)
+	if err != nil {
+		return nil, logError(ctx, fmt.Errorf("failed to get reviewer averages: %w", err))
+	}
+
+	if len(reviewerAverages) == 0 {
+		return ratings, nil
+	}
+
+	var globalSum float64
+	for _, average := range reviewerAverages {
+		globalSum += average
+	}
+	globalMean := globalSum / float64(len(reviewerAverages))
+
+	normalized := make([]models.Rating, len(ratings))
+	for i, rating := range ratings {
+		normalized[i] = rating
+
+		average, exists := reviewerAverages[rating.ReviewerID]
+		if !exists {
+			continue
+		}
+
+		bias := average - globalMean
+		adjusted := math.Round(float64(rating.Rating) - bias)
+		normalized[i].Rating = int(math.Max(0, math.Min(float64(s.ticketScoreServ.MaxRating()), adjusted)))
+	}
+
+	return normalized, nil
+}
+
+// CalculateNormalizedScore calculates the weighted score after removing reviewer bias
+func (s *ScoreNormalizationService) CalculateNormalizedScore(ctx context.Context, ratings []models.Rating, categories []models.RatingCategory, startDate, endDate time.Time) (float64, error) {
+	normalized, err := s.NormalizeScoreForReviewerBias(ctx, ratings, startDate, endDate)
+	if err != nil {
+		return 0, err
+	}
+
+	return s.ticketScoreServ.CalculateScore(normalized, categories)
+}
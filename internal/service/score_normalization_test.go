@@ -0,0 +1,158 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"ticket-score-service/internal/mocks"
+	"ticket-score-service/internal/models"
+)
+
+func TestNormalizeScoreForReviewerBias(t *testing.T) {
+	startDate := time.Date(2019, 10, 1, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2019, 10, 7, 0, 0, 0, 0, time.UTC)
+	createdAt := startDate.Add(2 * time.Hour)
+
+	// Reviewer 1 always rates 5 and reviewer 2 always rates 3, so the global mean
+	// is 4 and reviewer 2 rates 1 point below average
+	history := map[string][]models.Rating{
+		"1-2019-10-01": {
+			{ID: 1, TicketID: 1, RatingCategoryID: 1, ReviewerID: 1, Rating: 5, CreatedAt: createdAt},
+			{ID: 2, TicketID: 2, RatingCategoryID: 1, ReviewerID: 1, Rating: 5, CreatedAt: createdAt},
+			{ID: 3, TicketID: 1, RatingCategoryID: 1, ReviewerID: 2, Rating: 3, CreatedAt: createdAt},
+			{ID: 4, TicketID: 2, RatingCategoryID: 1, ReviewerID: 2, Rating: 3, CreatedAt: createdAt},
+		},
+	}
+
+	t.Run("strict reviewer is adjusted upward by one point", func(t *testing.T) {
+		service := NewScoreNormalizationService(&mocks.MockRatingsRepo{Ratings: history}, NewTicketScoreService(), true)
+
+		ratings := []models.Rating{
+			{ID: 10, RatingCategoryID: 1, ReviewerID: 2, Rating: 3},
+			{ID: 11, RatingCategoryID: 1, ReviewerID: 2, Rating: 2},
+		}
+
+		normalized, err := service.NormalizeScoreForReviewerBias(context.Background(), ratings, startDate, endDate)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		for i, rating := range normalized {
+			expected := ratings[i].Rating + 1
+			if rating.Rating != expected {
+				t.Errorf("Expected rating %d to be adjusted to %d, got %d", rating.ID, expected, rating.Rating)
+			}
+		}
+
+		// Input must not be modified
+		if ratings[0].Rating != 3 {
+			t.Errorf("Expected input ratings to be left untouched, got %d", ratings[0].Rating)
+		}
+	})
+
+	t.Run("lenient reviewer is adjusted downward", func(t *testing.T) {
+		service := NewScoreNormalizationService(&mocks.MockRatingsRepo{Ratings: history}, NewTicketScoreService(), true)
+
+		ratings := []models.Rating{{ID: 10, RatingCategoryID: 1, ReviewerID: 1, Rating: 5}}
+
+		normalized, err := service.NormalizeScoreForReviewerBias(context.Background(), ratings, startDate, endDate)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		if normalized[0].Rating != 4 {
+			t.Errorf("Expected rating 4, got %d", normalized[0].Rating)
+		}
+	})
+
+	t.Run("adjusted ratings are clamped to the rating scale", func(t *testing.T) {
+		service := NewScoreNormalizationService(&mocks.MockRatingsRepo{Ratings: history}, NewTicketScoreService(), true)
+
+		ratings := []models.Rating{
+			{ID: 10, RatingCategoryID: 1, ReviewerID: 2, Rating: 5},
+			{ID: 11, RatingCategoryID: 1, ReviewerID: 1, Rating: 0},
+		}
+
+		normalized, err := service.NormalizeScoreForReviewerBias(context.Background(), ratings, startDate, endDate)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		if normalized[0].Rating != 5 {
+			t.Errorf("Expected rating clamped to 5, got %d", normalized[0].Rating)
+		}
+		if normalized[1].Rating != 0 {
+			t.Errorf("Expected rating clamped to 0, got %d", normalized[1].Rating)
+		}
+	})
+
+	t.Run("unknown reviewer is left unchanged", func(t *testing.T) {
+		service := NewScoreNormalizationService(&mocks.MockRatingsRepo{Ratings: history}, NewTicketScoreService(), true)
+
+		ratings := []models.Rating{{ID: 10, RatingCategoryID: 1, ReviewerID: 99, Rating: 3}}
+
+		normalized, err := service.NormalizeScoreForReviewerBias(context.Background(), ratings, startDate, endDate)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		if normalized[0].Rating != 3 {
+			t.Errorf("Expected rating 3, got %d", normalized[0].Rating)
+		}
+	})
+
+	t.Run("correction disabled", func(t *testing.T) {
+		service := NewScoreNormalizationService(&mocks.MockRatingsRepo{Ratings: history}, NewTicketScoreService(), false)
+
+		ratings := []models.Rating{{ID: 10, RatingCategoryID: 1, ReviewerID: 2, Rating: 3}}
+
+		normalized, err := service.NormalizeScoreForReviewerBias(context.Background(), ratings, startDate, endDate)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		if normalized[0].Rating != 3 {
+			t.Errorf("Expected rating 3, got %d", normalized[0].Rating)
+		}
+	})
+
+	t.Run("repository error", func(t *testing.T) {
+		mockRepo := &mocks.MockRatingsRepo{Err: errors.New("database error")}
+		service := NewScoreNormalizationService(mockRepo, NewTicketScoreService(), true)
+
+		ratings := []models.Rating{{ID: 10, RatingCategoryID: 1, ReviewerID: 2, Rating: 3}}
+
+		if _, err := service.NormalizeScoreForReviewerBias(context.Background(), ratings, startDate, endDate); err == nil {
+			t.Error("Expected error but got none")
+		}
+	})
+}
+
+func TestCalculateNormalizedScore(t *testing.T) {
+	startDate := time.Date(2019, 10, 1, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2019, 10, 7, 0, 0, 0, 0, time.UTC)
+	createdAt := startDate.Add(2 * time.Hour)
+
+	history := map[string][]models.Rating{
+		"1-2019-10-01": {
+			{ID: 1, RatingCategoryID: 1, ReviewerID: 1, Rating: 5, CreatedAt: createdAt},
+			{ID: 2, RatingCategoryID: 1, ReviewerID: 2, Rating: 3, CreatedAt: createdAt},
+		},
+	}
+	categories := []models.RatingCategory{{ID: 1, Name: "Spelling", Weight: 1}}
+	ratings := []models.Rating{{ID: 10, RatingCategoryID: 1, ReviewerID: 2, Rating: 3}}
+
+	service := NewScoreNormalizationService(&mocks.MockRatingsRepo{Ratings: history}, NewTicketScoreService(), true)
+
+	score, err := service.CalculateNormalizedScore(context.Background(), ratings, categories, startDate, endDate)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expected := 80.0 // rating adjusted from 3 to 4: 4/5 * 100
+	if score != expected {
+		t.Errorf("Expected score %f, got %f", expected, score)
+	}
+}
@@ -0,0 +1,65 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"ticket-score-service/internal/models"
+)
+
+// DayPercentile positions a day's category score among the scores of every day with ratings
+type DayPercentile struct {
+	Date                string  `json:"date"`
+	Score               string  `json:"score"`
+	Percentile          float64 `json:"percentile"`
+	HistoricalDaysCount int     `json:"historicalDaysCount"`
+}
+
+// GetCategoryScoreDayPercentile calculates the percentage of days with ratings in a category whose
+// score is at or below the score on date, so the best day ever is at the 100th percentile.
+// A date without ratings scores "N/A" at the 0th percentile.
+func (s *RatingAnalyticsService) GetCategoryScoreDayPercentile(ctx context.Context, categoryID int, date time.Time) (*DayPercentile, error) {
+	category, err := findCategory(ctx, s.categoryRepo, categoryID)
+	if err != nil {
+		return nil, err
+	}
+
+	ratings, err := s.ratingsRepo.GetByCategoryID(ctx, categoryID)
+	if err != nil {
+		return nil, logError(ctx, fmt.Errorf("failed to get ratings: %w", err))
+	}
+
+	dailyScores := make(map[string]float64)
+	for day, dailyRatings := range groupRatingsByDate(ratings, date.Location()) {
+		score, err := s.ticketScoreServ.CalculateScore(dailyRatings, []models.RatingCategory{category})
+		if err != nil {
+			return nil, fmt.Errorf("failed to calculate score for %s: %w", day, err)
+		}
+		dailyScores[day] = score
+	}
+
+	dateStr := date.Format("2006-01-02")
+	result := &DayPercentile{
+		Date:                dateStr,
+		Score:               "N/A",
+		HistoricalDaysCount: len(dailyScores),
+	}
+
+	score, exists := dailyScores[dateStr]
+	if !exists {
+		return result, nil
+	}
+
+	atOrBelow := 0
+	for _, dailyScore := range dailyScores {
+		if dailyScore <= score {
+			atOrBelow++
+		}
+	}
+
+	result.Score = s.formatScore(score)
+	result.Percentile = float64(atOrBelow) / float64(len(dailyScores)) * 100
+
+	return result, nil
+}
@@ -0,0 +1,85 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"testing"
+	"time"
+
+	"ticket-score-service/internal/mocks"
+	"ticket-score-service/internal/models"
+)
+
+func TestGetCategoryScoreDayPercentile(t *testing.T) {
+	firstDay := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	categories := []models.RatingCategory{
+		{ID: 1, Name: "Spelling", Weight: 1},
+		{ID: 2, Name: "Grammar", Weight: 1},
+	}
+
+	// 10 days scoring 0%, 10%, ..., 90%: each day has one rating of 0-5 out of
+	// ten possible points, in shuffled order
+	dayScores := []int{3, 0, 8, 5, 1, 9, 4, 7, 2, 6}
+	ratings := make(map[string][]models.Rating)
+	for i, score := range dayScores {
+		day := firstDay.AddDate(0, 0, i)
+		key := fmt.Sprintf("1-%s", day.Format("2006-01-02"))
+		ratings[key] = []models.Rating{
+			{ID: i*2 + 1, RatingCategoryID: 1, Rating: score / 2, CreatedAt: day.Add(time.Hour)},
+			{ID: i*2 + 2, RatingCategoryID: 1, Rating: score - score/2, CreatedAt: day.Add(2 * time.Hour)},
+		}
+	}
+	ratings["2-2024-01-20"] = []models.Rating{{ID: 100, RatingCategoryID: 2, Rating: 5, CreatedAt: firstDay.AddDate(0, 0, 19)}}
+
+	tests := []struct {
+		name               string
+		date               time.Time
+		expectedScore      string
+		expectedPercentile float64
+	}{
+		{name: "median day", date: firstDay.AddDate(0, 0, 6), expectedScore: "40%", expectedPercentile: 50},
+		{name: "best day", date: firstDay.AddDate(0, 0, 5), expectedScore: "90%", expectedPercentile: 100},
+		{name: "worst day", date: firstDay.AddDate(0, 0, 1), expectedScore: "0%", expectedPercentile: 10},
+		{name: "day without ratings", date: firstDay.AddDate(0, 0, 10), expectedScore: "N/A", expectedPercentile: 0},
+	}
+
+	service := NewRatingAnalyticsService(&mockCategoryRepo{categories: categories}, &mocks.MockRatingsRepo{Ratings: ratings}, NewTicketScoreService())
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			percentile, err := service.GetCategoryScoreDayPercentile(context.Background(), 1, tt.date)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if percentile.Date != tt.date.Format("2006-01-02") {
+				t.Errorf("Expected date %s, got %s", tt.date.Format("2006-01-02"), percentile.Date)
+			}
+			if percentile.Score != tt.expectedScore {
+				t.Errorf("Expected score %s, got %s", tt.expectedScore, percentile.Score)
+			}
+			if math.Abs(percentile.Percentile-tt.expectedPercentile) > 0.01 {
+				t.Errorf("Expected percentile %.2f, got %.2f", tt.expectedPercentile, percentile.Percentile)
+			}
+			if percentile.HistoricalDaysCount != 10 {
+				t.Errorf("Expected 10 historical days, got %d", percentile.HistoricalDaysCount)
+			}
+		})
+	}
+
+	t.Run("unknown category", func(t *testing.T) {
+		if _, err := service.GetCategoryScoreDayPercentile(context.Background(), 99, firstDay); !errors.Is(err, ErrCategoryNotFound) {
+			t.Errorf("Expected ErrCategoryNotFound, got %v", err)
+		}
+	})
+
+	t.Run("repository error", func(t *testing.T) {
+		service := NewRatingAnalyticsService(&mockCategoryRepo{categories: categories}, &mocks.MockRatingsRepo{Err: errors.New("database error")}, NewTicketScoreService())
+
+		if _, err := service.GetCategoryScoreDayPercentile(context.Background(), 1, firstDay); err == nil {
+			t.Error("Expected error but got none")
+		}
+	})
+}
@@ -0,0 +1,60 @@
+package service
+
+import (
+	"context"
+	"math"
+	"sort"
+	"time"
+
+	"ticket-score-service/internal/utils"
+)
+
+// ScoreQuantiles summarizes the spread of ticket scores in a period
+type ScoreQuantiles struct {
+	Period     string `json:"period"`
+	Q1         string `json:"q1"`
+	Median     string `json:"median"`
+	Q3         string `json:"q3"`
+	IQR        string `json:"iqr"`
+	SampleSize int    `json:"sampleSize"`
+}
+
+// GetTicketScoreQuantiles calculates the quartiles of the scores of tickets rated from startDate
+// to endDate. A ticket's score is the weighted score of all its ratings, unrounded. All values are
+// "N/A" if no ticket has a score.
+func (s *TicketScoresService) GetTicketScoreQuantiles(ctx context.Context, startDate, endDate time.Time) (*ScoreQuantiles, error) {
+	scores, err := s.collectTicketScoreValues(ctx, startDate, endDate)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &ScoreQuantiles{
+		Period:     utils.FormatDateRange(startDate, endDate),
+		Q1:         "N/A",
+		Median:     "N/A",
+		Q3:         "N/A",
+		IQR:        "N/A",
+		SampleSize: len(scores),
+	}
+	if len(scores) == 0 {
+		return result, nil
+	}
+
+	sort.Float64s(scores)
+	q1, q3 := quantile(scores, 0.25), quantile(scores, 0.75)
+	result.Q1 = s.formatScore(q1)
+	result.Median = s.formatScore(quantile(scores, 0.5))
+	result.Q3 = s.formatScore(q3)
+	result.IQR = s.formatScore(q3 - q1)
+
+	return result, nil
+}
+
+// quantile interpolates linearly between the two closest ranks of sorted values, so the median of
+// an even number of values is the mean of the middle two
+func quantile(sorted []float64, q float64) float64 {
+	position := q * float64(len(sorted)-1)
+	lower := int(math.Floor(position))
+	upper := int(math.Ceil(position))
+	return sorted[lower] + (sorted[upper]-sorted[lower])*(position-float64(lower))
+}
@@ -0,0 +1,77 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"ticket-score-service/internal/mocks"
+	"ticket-score-service/internal/models"
+)
+
+func TestGetTicketScoreQuantiles(t *testing.T) {
+	startDate := time.Date(2019, 10, 1, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2019, 10, 7, 0, 0, 0, 0, time.UTC)
+	createdAt := startDate.Add(time.Hour)
+
+	categories := []models.RatingCategory{{ID: 1, Name: "Spelling", Weight: 1}}
+
+	// Tickets 1-5 score 60%, 70%, 80%, 90% and 100% from two ratings each
+	var ratings []models.Rating
+	for ticketID := 1; ticketID <= 5; ticketID++ {
+		step := ticketID + 5
+		ratings = append(ratings,
+			models.Rating{ID: 2*ticketID - 1, TicketID: ticketID, RatingCategoryID: 1, Rating: step / 2, CreatedAt: createdAt},
+			models.Rating{ID: 2 * ticketID, TicketID: ticketID, RatingCategoryID: 1, Rating: (step + 1) / 2, CreatedAt: createdAt},
+		)
+	}
+
+	tests := []struct {
+		name      string
+		ratings   []models.Rating
+		precision int
+		expected  ScoreQuantiles
+	}{
+		{
+			name:     "odd sample size",
+			ratings:  ratings,
+			expected: ScoreQuantiles{Q1: "70%", Median: "80%", Q3: "90%", IQR: "20%", SampleSize: 5},
+		},
+		{
+			name:      "even sample size",
+			ratings:   ratings[:8],
+			precision: 1,
+			expected:  ScoreQuantiles{Q1: "67.5%", Median: "75.0%", Q3: "82.5%", IQR: "15.0%", SampleSize: 4},
+		},
+		{
+			name:     "single ticket",
+			ratings:  ratings[:2],
+			expected: ScoreQuantiles{Q1: "60%", Median: "60%", Q3: "60%", IQR: "0%", SampleSize: 1},
+		},
+		{
+			name:     "no ratings",
+			expected: ScoreQuantiles{Q1: "N/A", Median: "N/A", Q3: "N/A", IQR: "N/A"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := NewTicketScoresService(
+				&mockCategoryRepo{categories: categories},
+				&mocks.MockRatingsRepo{Ratings: map[string][]models.Rating{"1-2019-10-01": tt.ratings}},
+				NewTicketScoreService(),
+				WithTicketScoresPrecision(tt.precision),
+			)
+
+			quantiles, err := service.GetTicketScoreQuantiles(context.Background(), startDate, endDate)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			tt.expected.Period = "2019-10-01 to 2019-10-07"
+			if *quantiles != tt.expected {
+				t.Errorf("Expected %+v, got %+v", tt.expected, *quantiles)
+			}
+		})
+	}
+}
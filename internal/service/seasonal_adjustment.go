@@ -0,0 +1,60 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// GetSeasonallyAdjustedScores calculates a category's daily scores with the weekly pattern removed.
+// Each day's score is shifted by how far the period's overall score is from the score of its weekday,
+// the same weekday scores as GetCategoryScoreByWeekday but unrounded:
+// adjusted = raw + (overall mean - weekday mean). Days without ratings stay "N/A".
+func (s *RatingAnalyticsService) GetSeasonallyAdjustedScores(ctx context.Context, categoryID int, startDate, endDate time.Time) ([]DailyScore, error) {
+	category, err := findCategory(ctx, s.categoryRepo, categoryID)
+	if err != nil {
+		return nil, err
+	}
+
+	weekdayRatings, err := s.ratingsRepo.GetRatingsByWeekdayAndCategoryID(ctx, categoryID, startDate, endDate)
+	if err != nil {
+		return nil, logError(ctx, fmt.Errorf("failed to get ratings by weekday: %w", err))
+	}
+
+	var weekdayMeans [7]float64
+	var ratingSum float64
+	var ratingCount int
+	for _, ratings := range weekdayRatings {
+		weekdayMeans[ratings.Weekday] = ratingToPercent(ratings.AverageRating, s.ticketScoreServ.MaxRating())
+		ratingSum += ratings.AverageRating * float64(ratings.Count)
+		ratingCount += ratings.Count
+	}
+
+	var globalMean float64
+	if ratingCount > 0 {
+		globalMean = ratingToPercent(ratingSum/float64(ratingCount), s.ticketScoreServ.MaxRating())
+	}
+
+	rawScores, dates, err := s.ratedDailyScores(ctx, category, startDate, endDate)
+	if err != nil {
+		return nil, err
+	}
+
+	var scores []DailyScore
+	next := 0
+	for currentDate := startDate; !currentDate.After(endDate); currentDate = currentDate.AddDate(0, 0, 1) {
+		dateStr := currentDate.Format("2006-01-02")
+		if next == len(dates) || dates[next] != dateStr {
+			scores = append(scores, DailyScore{Date: dateStr, Score: "N/A"})
+			continue
+		}
+
+		scores = append(scores, DailyScore{
+			Date:  dateStr,
+			Score: s.formatScore(rawScores[next] + globalMean - weekdayMeans[currentDate.Weekday()]),
+		})
+		next++
+	}
+
+	return scores, nil
+}
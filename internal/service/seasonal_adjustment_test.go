@@ -0,0 +1,78 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"ticket-score-service/internal/mocks"
+	"ticket-score-service/internal/models"
+)
+
+func TestGetSeasonallyAdjustedScores(t *testing.T) {
+	// 2024-01-01 is a Monday
+	startDate := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	endDate := startDate.AddDate(0, 0, 13)
+	categories := []models.RatingCategory{{ID: 1, Name: "Spelling", Weight: 1}}
+
+	// Mondays score 70% and every other day 80%, except one day without ratings
+	var ratings []models.Rating
+	for day := 0; day < 14; day++ {
+		date := startDate.AddDate(0, 0, day)
+		switch {
+		case day == 10:
+			continue
+		case date.Weekday() == time.Monday:
+			ratings = append(ratings,
+				models.Rating{ID: len(ratings) + 1, RatingCategoryID: 1, Rating: 3, CreatedAt: date.Add(9 * time.Hour)},
+				models.Rating{ID: len(ratings) + 2, RatingCategoryID: 1, Rating: 4, CreatedAt: date.Add(10 * time.Hour)},
+			)
+		default:
+			ratings = append(ratings, models.Rating{ID: len(ratings) + 1, RatingCategoryID: 1, Rating: 4, CreatedAt: date.Add(9 * time.Hour)})
+		}
+	}
+
+	service := NewRatingAnalyticsService(&mockCategoryRepo{categories: categories}, &mocks.MockRatingsRepo{Ratings: map[string][]models.Rating{"all": ratings}}, NewTicketScoreService(), WithAnalyticsScorePrecision(2))
+
+	scores, err := service.GetSeasonallyAdjustedScores(context.Background(), 1, startDate, endDate)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(scores) != 14 {
+		t.Fatalf("Expected 14 days, got %d", len(scores))
+	}
+
+	// The overall mean is (11*4 + 2*(3+4)) / 15 out of 5, so every rated day adjusts to 77.33%
+	for i, score := range scores {
+		expected := "77.33%"
+		if i == 10 {
+			expected = "N/A"
+		}
+		if score.Score != expected {
+			t.Errorf("Expected %s on %s, got %s", expected, score.Date, score.Score)
+		}
+	}
+
+	t.Run("no ratings", func(t *testing.T) {
+		service := NewRatingAnalyticsService(&mockCategoryRepo{categories: categories}, &mocks.MockRatingsRepo{}, NewTicketScoreService())
+
+		scores, err := service.GetSeasonallyAdjustedScores(context.Background(), 1, startDate, startDate.AddDate(0, 0, 2))
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		for _, score := range scores {
+			if score.Score != "N/A" {
+				t.Errorf("Expected N/A on %s, got %s", score.Date, score.Score)
+			}
+		}
+	})
+
+	t.Run("unknown category", func(t *testing.T) {
+		service := NewRatingAnalyticsService(&mockCategoryRepo{categories: categories}, &mocks.MockRatingsRepo{}, NewTicketScoreService())
+
+		if _, err := service.GetSeasonallyAdjustedScores(context.Background(), 99, startDate, endDate); !errors.Is(err, ErrCategoryNotFound) {
+			t.Errorf("Expected ErrCategoryNotFound, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,192 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"ticket-score-service/internal/models"
+	"ticket-score-service/internal/utils"
+)
+
+// ErrSnapshotNotFound is returned when a snapshot does not exist
+var ErrSnapshotNotFound = errors.New("snapshot not found")
+
+// ErrLockNotAcquired is returned when another process is already taking a snapshot of the same week
+var ErrLockNotAcquired = errors.New("lock not acquired")
+
+// snapshotLockTTLSeconds bounds how long a snapshot lock is held if its holder never releases it
+const snapshotLockTTLSeconds = 60
+
+// Locker provides locks shared by every process using the same database
+type Locker interface {
+	AcquireLock(ctx context.Context, lockKey string, ttlSeconds int) (owner string, acquired bool, err error)
+	ReleaseLock(ctx context.Context, lockKey, owner string) error
+}
+
+// SnapshotRepository defines the interface for weekly score snapshot data access
+type SnapshotRepository interface {
+	CreateSnapshot(ctx context.Context, weekLabel string, createdAt time.Time, scores []models.SnapshotScore) (int, error)
+	GetBySnapshotID(ctx context.Context, snapshotID int) ([]models.SnapshotScore, error)
+	ListSnapshots(ctx context.Context, startDate, endDate time.Time) ([]models.SnapshotSummary, error)
+}
+
+// CategoryAnalyticsProvider provides the current per-category scores for a date range
+type CategoryAnalyticsProvider interface {
+	GetCategoryAnalytics(ctx context.Context, startDate, endDate time.Time) ([]CategoryAnalytics, error)
+}
+
+// SnapshotCategoryScore is a category's score as recorded in a snapshot
+type SnapshotCategoryScore struct {
+	CategoryID   int    `json:"category_id"`
+	CategoryName string `json:"category_name"`
+	Score        string `json:"score"`
+	RatingCount  int    `json:"rating_count"`
+}
+
+// WeeklySnapshot is a stored snapshot of category scores for one week
+type WeeklySnapshot struct {
+	SnapshotID int                     `json:"snapshot_id"`
+	WeekLabel  string                  `json:"week_label"`
+	CreatedAt  time.Time               `json:"created_at"`
+	Scores     []SnapshotCategoryScore `json:"scores"`
+}
+
+// SnapshotMeta describes a stored snapshot without its scores
+type SnapshotMeta struct {
+	SnapshotID    int       `json:"snapshot_id"`
+	WeekLabel     string    `json:"week_label"`
+	CategoryCount int       `json:"category_count"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+// SnapshotService stores and retrieves weekly snapshots of category scores
+type SnapshotService struct {
+	analytics    CategoryAnalyticsProvider
+	snapshotRepo SnapshotRepository
+	locker       Locker
+}
+
+// SnapshotOption configures a SnapshotService
+type SnapshotOption func(*SnapshotService)
+
+// WithLocker makes TakeWeeklySnapshot hold a lock per week, so concurrent snapshots of the same
+// week across processes fail with ErrLockNotAcquired instead of both creating one
+func WithLocker(locker Locker) SnapshotOption {
+	return func(s *SnapshotService) {
+		s.locker = locker
+	}
+}
+
+// NewSnapshotService creates a new snapshot service instance
+func NewSnapshotService(analytics CategoryAnalyticsProvider, snapshotRepo SnapshotRepository, opts ...SnapshotOption) *SnapshotService {
+	s := &SnapshotService{
+		analytics:    analytics,
+		snapshotRepo: snapshotRepo,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// TakeWeeklySnapshot stores the current category scores for the week starting at weekStart
+// and returns the new snapshot's ID. Later rating changes do not affect stored snapshots.
+// A week is only snapshotted once: if it already has a snapshot, that snapshot's ID is returned.
+func (s *SnapshotService) TakeWeeklySnapshot(ctx context.Context, weekStart time.Time) (int, error) {
+	if s.locker != nil {
+		year, week := weekStart.ISOWeek()
+		lockKey := fmt.Sprintf("snapshot-%d-W%02d", year, week)
+
+		owner, acquired, err := s.locker.AcquireLock(ctx, lockKey, snapshotLockTTLSeconds)
+		if err != nil {
+			return 0, fmt.Errorf("failed to acquire snapshot lock: %w", err)
+		}
+		if !acquired {
+			return 0, fmt.Errorf("%w: %s", ErrLockNotAcquired, lockKey)
+		}
+		// A lock that fails to release expires after snapshotLockTTLSeconds
+		defer s.locker.ReleaseLock(context.WithoutCancel(ctx), lockKey, owner)
+	}
+
+	existing, err := s.snapshotRepo.ListSnapshots(ctx, weekStart, weekStart)
+	if err != nil {
+		return 0, logError(ctx, fmt.Errorf("failed to check for an existing snapshot: %w", err))
+	}
+	if len(existing) > 0 {
+		return existing[0].SnapshotID, nil
+	}
+
+	weekEnd := weekStart.AddDate(0, 0, 6)
+
+	analytics, err := s.analytics.GetCategoryAnalytics(ctx, weekStart, weekEnd)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get category analytics: %w", err)
+	}
+
+	scores := make([]models.SnapshotScore, 0, len(analytics))
+	for _, category := range analytics {
+		scores = append(scores, models.SnapshotScore{
+			CategoryID:   category.CategoryID,
+			CategoryName: category.Category,
+			Score:        category.Score,
+			RatingCount:  category.Ratings,
+		})
+	}
+
+	snapshotID, err := s.snapshotRepo.CreateSnapshot(ctx, utils.FormatDateRange(weekStart, weekEnd), time.Now().UTC(), scores)
+	if err != nil {
+		return 0, logError(ctx, fmt.Errorf("failed to store snapshot: %w", err))
+	}
+
+	return snapshotID, nil
+}
+
+// GetSnapshot gets a stored snapshot by ID
+func (s *SnapshotService) GetSnapshot(ctx context.Context, snapshotID int) (*WeeklySnapshot, error) {
+	rows, err := s.snapshotRepo.GetBySnapshotID(ctx, snapshotID)
+	if err != nil {
+		return nil, logError(ctx, fmt.Errorf("failed to get snapshot: %w", err))
+	}
+	if len(rows) == 0 {
+		return nil, fmt.Errorf("%w: %d", ErrSnapshotNotFound, snapshotID)
+	}
+
+	snapshot := &WeeklySnapshot{
+		SnapshotID: snapshotID,
+		WeekLabel:  rows[0].WeekLabel,
+		CreatedAt:  rows[0].CreatedAt,
+		Scores:     make([]SnapshotCategoryScore, 0, len(rows)),
+	}
+	for _, row := range rows {
+		snapshot.Scores = append(snapshot.Scores, SnapshotCategoryScore{
+			CategoryID:   row.CategoryID,
+			CategoryName: row.CategoryName,
+			Score:        row.Score,
+			RatingCount:  row.RatingCount,
+		})
+	}
+
+	return snapshot, nil
+}
+
+// ListSnapshots lists the snapshots of weeks starting between startDate and endDate inclusive
+func (s *SnapshotService) ListSnapshots(ctx context.Context, startDate, endDate time.Time) ([]SnapshotMeta, error) {
+	summaries, err := s.snapshotRepo.ListSnapshots(ctx, startDate, endDate)
+	if err != nil {
+		return nil, logError(ctx, fmt.Errorf("failed to list snapshots: %w", err))
+	}
+
+	metas := make([]SnapshotMeta, 0, len(summaries))
+	for _, summary := range summaries {
+		metas = append(metas, SnapshotMeta{
+			SnapshotID:    summary.SnapshotID,
+			WeekLabel:     summary.WeekLabel,
+			CategoryCount: summary.CategoryCount,
+			CreatedAt:     summary.CreatedAt,
+		})
+	}
+
+	return metas, nil
+}
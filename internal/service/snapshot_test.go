@@ -0,0 +1,179 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"ticket-score-service/internal/models"
+	"ticket-score-service/internal/repository"
+	"ticket-score-service/internal/testutil"
+)
+
+func TestSnapshotService_SnapshotUnaffectedByRatingChanges(t *testing.T) {
+	ctx := context.Background()
+	db := testutil.NewTestDB(t)
+
+	weekStart := time.Date(2019, 10, 7, 0, 0, 0, 0, time.UTC)
+	testutil.SeedTestData(t, db, []models.Rating{
+		{ID: 1, Rating: 5, TicketID: 1, RatingCategoryID: 1, ReviewerID: 1, RevieweeID: 1, CreatedAt: weekStart.Add(2 * time.Hour)},
+		{ID: 2, Rating: 3, TicketID: 2, RatingCategoryID: 1, ReviewerID: 1, RevieweeID: 1, CreatedAt: weekStart.Add(50 * time.Hour)},
+		{ID: 3, Rating: 4, TicketID: 1, RatingCategoryID: 2, ReviewerID: 1, RevieweeID: 1, CreatedAt: weekStart.Add(3 * time.Hour)},
+	}, nil)
+
+	categoryRepo := &mockCategoryRepo{categories: []models.RatingCategory{
+		{ID: 1, Name: "Spelling", Weight: 1},
+		{ID: 2, Name: "Grammar", Weight: 0.7},
+	}}
+	analytics := NewRatingAnalyticsService(categoryRepo, repository.NewRatingsRepository(db), NewTicketScoreService())
+	snapshotService := NewSnapshotService(analytics, repository.NewSnapshotRepository(db))
+
+	snapshotID, err := snapshotService.TakeWeeklySnapshot(ctx, weekStart)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	before, err := snapshotService.GetSnapshot(ctx, snapshotID)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if before.WeekLabel != "2019-10-07 to 2019-10-13" {
+		t.Errorf("Expected week label 2019-10-07 to 2019-10-13, got %q", before.WeekLabel)
+	}
+	if len(before.Scores) != 2 {
+		t.Fatalf("Expected 2 category scores, got %d", len(before.Scores))
+	}
+
+	// Change every rating after the snapshot was taken
+	if _, err := db.ExecContext(ctx, `UPDATE ratings SET rating = 1`); err != nil {
+		t.Fatalf("Failed to update ratings: %v", err)
+	}
+
+	current, err := analytics.GetCategoryAnalytics(ctx, weekStart, weekStart.AddDate(0, 0, 6))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if current[0].Score == before.Scores[0].Score {
+		t.Fatalf("Expected the current score to change, both are %q", current[0].Score)
+	}
+
+	after, err := snapshotService.GetSnapshot(ctx, snapshotID)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	for i := range before.Scores {
+		if after.Scores[i] != before.Scores[i] {
+			t.Errorf("Snapshot score changed from %+v to %+v", before.Scores[i], after.Scores[i])
+		}
+	}
+}
+
+func TestSnapshotService_GetSnapshot(t *testing.T) {
+	tests := []struct {
+		name          string
+		snapshotID    int
+		expectedError error
+		expectedCount int
+	}{
+		{name: "existing snapshot", snapshotID: 1, expectedCount: 1},
+		{name: "missing snapshot", snapshotID: 99, expectedError: ErrSnapshotNotFound},
+	}
+
+	ctx := context.Background()
+	db := testutil.NewTestDB(t)
+	analytics := NewRatingAnalyticsService(
+		&mockCategoryRepo{categories: []models.RatingCategory{{ID: 1, Name: "Spelling", Weight: 1}}},
+		repository.NewRatingsRepository(db),
+		NewTicketScoreService(),
+	)
+	snapshotService := NewSnapshotService(analytics, repository.NewSnapshotRepository(db))
+	if _, err := snapshotService.TakeWeeklySnapshot(ctx, time.Date(2019, 10, 7, 0, 0, 0, 0, time.UTC)); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			snapshot, err := snapshotService.GetSnapshot(ctx, tt.snapshotID)
+			if tt.expectedError != nil {
+				if !errors.Is(err, tt.expectedError) {
+					t.Fatalf("Expected error %v, got %v", tt.expectedError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if len(snapshot.Scores) != tt.expectedCount {
+				t.Errorf("Expected %d scores, got %d", tt.expectedCount, len(snapshot.Scores))
+			}
+			if snapshot.Scores[0].Score != "N/A" {
+				t.Errorf("Expected N/A score for a week without ratings, got %q", snapshot.Scores[0].Score)
+			}
+		})
+	}
+}
+
+// blockingAnalytics holds every GetCategoryAnalytics call until release is closed
+type blockingAnalytics struct {
+	started chan struct{}
+	release chan struct{}
+}
+
+func (b *blockingAnalytics) GetCategoryAnalytics(ctx context.Context, startDate, endDate time.Time) ([]CategoryAnalytics, error) {
+	b.started <- struct{}{}
+	<-b.release
+	return []CategoryAnalytics{{CategoryID: 1, Category: "Spelling", Score: "N/A"}}, nil
+}
+
+func TestSnapshotService_ConcurrentSnapshotsOfSameWeek(t *testing.T) {
+	ctx := context.Background()
+	db := testutil.NewTestDB(t)
+	weekStart := time.Date(2019, 10, 7, 0, 0, 0, 0, time.UTC)
+
+	analytics := &blockingAnalytics{started: make(chan struct{}, 2), release: make(chan struct{})}
+	snapshotService := NewSnapshotService(analytics, repository.NewSnapshotRepository(db), WithLocker(db))
+
+	// The first attempt holds the lock while it reads the scores
+	firstDone := make(chan error, 1)
+	go func() {
+		_, err := snapshotService.TakeWeeklySnapshot(ctx, weekStart)
+		firstDone <- err
+	}()
+	<-analytics.started
+
+	if _, err := snapshotService.TakeWeeklySnapshot(ctx, weekStart); !errors.Is(err, ErrLockNotAcquired) {
+		t.Errorf("Expected ErrLockNotAcquired for the second attempt, got %v", err)
+	}
+
+	close(analytics.release)
+	if err := <-firstDone; err != nil {
+		t.Fatalf("Unexpected error from the first attempt: %v", err)
+	}
+
+	snapshots, err := snapshotService.ListSnapshots(ctx, weekStart, weekStart)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(snapshots) != 1 {
+		t.Fatalf("Expected 1 snapshot, got %d", len(snapshots))
+	}
+
+	t.Run("lock released after the snapshot", func(t *testing.T) {
+		snapshotID, err := snapshotService.TakeWeeklySnapshot(ctx, weekStart)
+		if err != nil {
+			t.Fatalf("Expected a later snapshot to acquire the lock, got %v", err)
+		}
+		if snapshotID != snapshots[0].SnapshotID {
+			t.Errorf("Expected the existing snapshot %d, got %d", snapshots[0].SnapshotID, snapshotID)
+		}
+
+		snapshots, err := snapshotService.ListSnapshots(ctx, weekStart, weekStart)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if len(snapshots) != 1 {
+			t.Errorf("Expected 1 snapshot, got %d", len(snapshots))
+		}
+	})
+}
@@ -0,0 +1,85 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+)
+
+// maxScoreVariance is the largest possible variance of scores between 0 and 100
+const maxScoreVariance = 50 * 50
+
+// StabilityIndex rates how dependable a category's score is for a date range, from 0 to 1
+type StabilityIndex struct {
+	CategoryName      string  `json:"categoryName"`
+	Index             float64 `json:"index"`
+	Grade             string  `json:"grade"`
+	CoverageComponent float64 `json:"coverageComponent"`
+	VarianceComponent float64 `json:"varianceComponent"`
+	VolumeComponent   float64 `json:"volumeComponent"`
+}
+
+// GetCategoryStabilityIndex multiplies three components, each between 0 and 1, into one index:
+// coverage is the share of the tickets rated from startDate to endDate that were rated in the
+// category, variance is 1 - the variance of its daily scores over the largest possible variance,
+// and volume is log10(ratings + 1) / 3, so a thousand ratings count fully.
+func (s *RatingAnalyticsService) GetCategoryStabilityIndex(ctx context.Context, categoryID int, startDate, endDate time.Time) (*StabilityIndex, error) {
+	category, err := findCategory(ctx, s.categoryRepo, categoryID)
+	if err != nil {
+		return nil, err
+	}
+
+	ticketIDs, err := s.ratingsRepo.GetDistinctTicketIDsByDateRange(ctx, startDate, endDate)
+	if err != nil {
+		return nil, logError(ctx, fmt.Errorf("failed to get ticket IDs: %w", err))
+	}
+	ratedTickets, err := s.ratingsRepo.GetDistinctTicketCountByCategoryIDAndDateRange(ctx, categoryID, startDate, endDate)
+	if err != nil {
+		return nil, logError(ctx, fmt.Errorf("failed to count rated tickets: %w", err))
+	}
+
+	ratings, err := s.ratingsRepo.GetByCategoryIDAndDateRange(ctx, categoryID, startDate, endDate)
+	if err != nil {
+		return nil, logError(ctx, fmt.Errorf("failed to get ratings: %w", err))
+	}
+
+	dailyScores, _, err := s.scoreRatedDays(ratings, category, startDate, endDate)
+	if err != nil {
+		return nil, err
+	}
+
+	index := &StabilityIndex{CategoryName: category.Name}
+	if len(ticketIDs) > 0 {
+		index.CoverageComponent = unitInterval(float64(ratedTickets) / float64(len(ticketIDs)))
+	}
+	stdDev := standardDeviation(dailyScores)
+	index.VarianceComponent = unitInterval(1 - stdDev*stdDev/maxScoreVariance)
+	index.VolumeComponent = unitInterval(math.Log10(float64(len(ratings))+1) / 3)
+
+	index.Index = index.CoverageComponent * index.VarianceComponent * index.VolumeComponent
+	index.Grade = stabilityGrade(index.Index)
+
+	return index, nil
+}
+
+// unitInterval bounds value to [0, 1]
+func unitInterval(value float64) float64 {
+	return math.Max(0, math.Min(1, value))
+}
+
+// stabilityGrade grades a stability index, from A (most stable) to F
+func stabilityGrade(index float64) string {
+	switch {
+	case index >= 0.8:
+		return "A"
+	case index >= 0.6:
+		return "B"
+	case index >= 0.4:
+		return "C"
+	case index >= 0.2:
+		return "D"
+	default:
+		return "F"
+	}
+}
@@ -0,0 +1,88 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"ticket-score-service/internal/mocks"
+	"ticket-score-service/internal/models"
+)
+
+func TestGetCategoryStabilityIndex(t *testing.T) {
+	startDate := time.Date(2019, 10, 1, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2019, 10, 5, 0, 0, 0, 0, time.UTC)
+	categories := []models.RatingCategory{
+		{ID: 1, Name: "Spelling", Weight: 1},
+		{ID: 2, Name: "Grammar", Weight: 1},
+	}
+
+	// Every ticket rated 5 in Spelling twice a day for five days: 1000 ratings
+	ratings := map[string][]models.Rating{}
+	id := 0
+	for day := 0; day < 5; day++ {
+		createdAt := startDate.AddDate(0, 0, day).Add(time.Hour)
+		key := createdAt.Format("2006-01-02")
+		for ticketID := 1; ticketID <= 100; ticketID++ {
+			for i := 0; i < 2; i++ {
+				id++
+				ratings[key] = append(ratings[key], models.Rating{ID: id, TicketID: ticketID, RatingCategoryID: 1, Rating: 5, CreatedAt: createdAt})
+			}
+		}
+	}
+	// Grammar: one ticket at 20% on the first day and one at 100% on the second
+	ratings["grammar"] = []models.Rating{
+		{ID: id + 1, TicketID: 1, RatingCategoryID: 2, Rating: 1, CreatedAt: startDate.Add(time.Hour)},
+		{ID: id + 2, TicketID: 2, RatingCategoryID: 2, Rating: 5, CreatedAt: startDate.AddDate(0, 0, 1).Add(time.Hour)},
+	}
+
+	service := NewRatingAnalyticsService(&mockCategoryRepo{categories: categories}, &mocks.MockRatingsRepo{Ratings: ratings}, NewTicketScoreService())
+
+	tests := []struct {
+		name             string
+		categoryID       int
+		expectedCoverage float64
+		expectedVariance float64
+		expectedVolume   float64
+		expectedIndex    float64
+		expectedGrade    string
+	}{
+		// Full coverage, no variance and 1000 ratings
+		{name: "perfect data", categoryID: 1, expectedCoverage: 1, expectedVariance: 1, expectedVolume: 1, expectedIndex: 1, expectedGrade: "A"},
+		// 2 of 100 tickets, daily scores 20% and 100% (variance 1600 of 2500), log10(3) / 3
+		{name: "sparse and volatile data", categoryID: 2, expectedCoverage: 0.02, expectedVariance: 0.36, expectedVolume: 0.159, expectedIndex: 0.02 * 0.36 * math.Log10(3) / 3, expectedGrade: "F"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			index, err := service.GetCategoryStabilityIndex(context.Background(), tt.categoryID, startDate, endDate)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if math.Abs(index.CoverageComponent-tt.expectedCoverage) > 0.001 {
+				t.Errorf("Expected coverage component %.3f, got %.3f", tt.expectedCoverage, index.CoverageComponent)
+			}
+			if math.Abs(index.VarianceComponent-tt.expectedVariance) > 0.001 {
+				t.Errorf("Expected variance component %.3f, got %.3f", tt.expectedVariance, index.VarianceComponent)
+			}
+			if math.Abs(index.VolumeComponent-tt.expectedVolume) > 0.001 {
+				t.Errorf("Expected volume component %.3f, got %.3f", tt.expectedVolume, index.VolumeComponent)
+			}
+			if math.Abs(index.Index-tt.expectedIndex) > 0.0001 {
+				t.Errorf("Expected index %.4f, got %.4f", tt.expectedIndex, index.Index)
+			}
+			if index.Grade != tt.expectedGrade {
+				t.Errorf("Expected grade %s, got %s", tt.expectedGrade, index.Grade)
+			}
+		})
+	}
+
+	t.Run("unknown category", func(t *testing.T) {
+		if _, err := service.GetCategoryStabilityIndex(context.Background(), 99, startDate, endDate); !errors.Is(err, ErrCategoryNotFound) {
+			t.Errorf("Expected ErrCategoryNotFound, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,133 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"ticket-score-service/internal/models"
+	"ticket-score-service/internal/utils"
+)
+
+// SubjectGroupQuality is the overall quality of the tickets whose subject contains a keyword
+type SubjectGroupQuality struct {
+	Keyword        string `json:"keyword"`
+	MatchedTickets int    `json:"matchedTickets"`
+	AverageScore   string `json:"averageScore"`
+}
+
+// SubjectTicketRepository groups tickets by their subject
+type SubjectTicketRepository interface {
+	GroupTicketsBySubjectKeyword(ctx context.Context, keywords []string, startDate, endDate time.Time) (map[string][]models.Ticket, error)
+}
+
+// SubjectGroupAnalyticsService compares quality across groups of tickets with similar subjects
+type SubjectGroupAnalyticsService struct {
+	categoryRepo    CategoryRepository
+	ratingsRepo     RatingsRepository
+	ticketRepo      SubjectTicketRepository
+	ticketScoreServ ScoreCalculator
+	scorePrecision  int
+}
+
+// SubjectGroupAnalyticsOption configures a SubjectGroupAnalyticsService
+type SubjectGroupAnalyticsOption func(*SubjectGroupAnalyticsService)
+
+// WithSubjectGroupScorePrecision sets the number of decimal places in formatted scores. Defaults to 0.
+func WithSubjectGroupScorePrecision(decimals int) SubjectGroupAnalyticsOption {
+	return func(s *SubjectGroupAnalyticsService) {
+		s.scorePrecision = decimals
+	}
+}
+
+// NewSubjectGroupAnalyticsService creates a new subject group analytics service instance
+func NewSubjectGroupAnalyticsService(
+	categoryRepo CategoryRepository,
+	ratingsRepo RatingsRepository,
+	ticketRepo SubjectTicketRepository,
+	ticketScoreServ ScoreCalculator,
+	opts ...SubjectGroupAnalyticsOption,
+) *SubjectGroupAnalyticsService {
+	s := &SubjectGroupAnalyticsService{
+		categoryRepo:    categoryRepo,
+		ratingsRepo:     ratingsRepo,
+		ticketRepo:      ticketRepo,
+		ticketScoreServ: ticketScoreServ,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// GetQualityBySubjectGroup scores, for each keyword, all ratings of the tickets created in a date range
+// whose subject contains the keyword. Groups are returned in keyword order; a group without rated
+// tickets gets an "N/A" score.
+func (s *SubjectGroupAnalyticsService) GetQualityBySubjectGroup(ctx context.Context, keywords []string, startDate, endDate time.Time) ([]SubjectGroupQuality, error) {
+	groups, err := s.ticketRepo.GroupTicketsBySubjectKeyword(ctx, keywords, startDate, endDate)
+	if err != nil {
+		return nil, logError(ctx, fmt.Errorf("failed to group tickets: %w", err))
+	}
+
+	categories, err := s.categoryRepo.GetAll(ctx)
+	if err != nil {
+		return nil, logError(ctx, fmt.Errorf("failed to get categories: %w", err))
+	}
+
+	categoryIDs := make([]int, len(categories))
+	for i, category := range categories {
+		categoryIDs[i] = category.ID
+	}
+
+	results := make([]SubjectGroupQuality, 0, len(keywords))
+	for _, keyword := range keywords {
+		tickets := groups[keyword]
+		result := SubjectGroupQuality{
+			Keyword:        keyword,
+			MatchedTickets: len(tickets),
+			AverageScore:   "N/A",
+		}
+
+		if len(tickets) > 0 {
+			score, err := s.groupScore(ctx, tickets, categories, categoryIDs)
+			if err != nil {
+				return nil, fmt.Errorf("failed to score keyword %q: %w", keyword, err)
+			}
+			result.AverageScore = score
+		}
+
+		results = append(results, result)
+	}
+
+	return results, nil
+}
+
+// groupScore calculates the formatted score of all ratings of the given tickets, or "N/A" if none are rated
+func (s *SubjectGroupAnalyticsService) groupScore(ctx context.Context, tickets []models.Ticket, categories []models.RatingCategory, categoryIDs []int) (string, error) {
+	ticketIDs := make([]int, len(tickets))
+	for i, ticket := range tickets {
+		ticketIDs[i] = ticket.ID
+	}
+
+	ratingsByTicket, err := s.ratingsRepo.GetRatingsByTicketIDsAndCategoryIDs(ctx, ticketIDs, categoryIDs)
+	if err != nil {
+		return "", logError(ctx, fmt.Errorf("failed to get ratings: %w", err))
+	}
+
+	var ratings []models.Rating
+	for _, ticketID := range ticketIDs {
+		for _, categoryID := range categoryIDs {
+			ratings = append(ratings, ratingsByTicket[ticketID][categoryID]...)
+		}
+	}
+	if len(ratings) == 0 {
+		return "N/A", nil
+	}
+
+	score, err := s.ticketScoreServ.CalculateScore(ratings, categories)
+	if err != nil {
+		return "", fmt.Errorf("failed to calculate score: %w", err)
+	}
+
+	return utils.FormatScoreWithPrecision(score, s.scorePrecision), nil
+}
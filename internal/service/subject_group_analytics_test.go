@@ -0,0 +1,96 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"ticket-score-service/internal/mocks"
+	"ticket-score-service/internal/models"
+)
+
+// mockSubjectTicketRepo matches keywords against ticket subjects in memory
+type mockSubjectTicketRepo struct {
+	tickets []models.Ticket
+	err     error
+}
+
+func (m *mockSubjectTicketRepo) GroupTicketsBySubjectKeyword(ctx context.Context, keywords []string, startDate, endDate time.Time) (map[string][]models.Ticket, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	groups := make(map[string][]models.Ticket)
+	for _, keyword := range keywords {
+		for _, ticket := range m.tickets {
+			if strings.Contains(strings.ToLower(ticket.Subject), strings.ToLower(keyword)) {
+				groups[keyword] = append(groups[keyword], ticket)
+			}
+		}
+	}
+	return groups, nil
+}
+
+func TestGetQualityBySubjectGroup(t *testing.T) {
+	startDate := time.Date(2019, 10, 1, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2019, 10, 7, 0, 0, 0, 0, time.UTC)
+
+	categories := []models.RatingCategory{
+		{ID: 1, Name: "Spelling", Weight: 1},
+		{ID: 2, Name: "Grammar", Weight: 3},
+	}
+	tickets := []models.Ticket{
+		{ID: 1, Subject: "Billing question", CreatedAt: startDate},
+		{ID: 2, Subject: "Wrong billing address", CreatedAt: startDate},
+		{ID: 3, Subject: "Technical issue with login", CreatedAt: startDate},
+		{ID: 4, Subject: "Technical error", CreatedAt: startDate}, // not rated
+	}
+	ratings := map[string][]models.Rating{
+		"all": {
+			{ID: 1, TicketID: 1, RatingCategoryID: 1, Rating: 5},
+			{ID: 2, TicketID: 1, RatingCategoryID: 2, Rating: 5},
+			{ID: 3, TicketID: 2, RatingCategoryID: 2, Rating: 3},
+			{ID: 4, TicketID: 3, RatingCategoryID: 1, Rating: 1},
+			{ID: 5, TicketID: 3, RatingCategoryID: 2, Rating: 2},
+		},
+	}
+
+	service := NewSubjectGroupAnalyticsService(
+		&mockCategoryRepo{categories: categories},
+		&mocks.MockRatingsRepo{Ratings: ratings},
+		&mockSubjectTicketRepo{tickets: tickets},
+		NewTicketScoreService(),
+	)
+
+	results, err := service.GetQualityBySubjectGroup(context.Background(), []string{"technical", "billing", "refund"}, startDate, endDate)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expected := []SubjectGroupQuality{
+		// (1*1 + 2*3) / (4*5) = 35%
+		{Keyword: "technical", MatchedTickets: 2, AverageScore: "35%"},
+		// (5*1 + 5*3 + 3*3) / (7*5) = 82.86%
+		{Keyword: "billing", MatchedTickets: 2, AverageScore: "83%"},
+		{Keyword: "refund", MatchedTickets: 0, AverageScore: "N/A"},
+	}
+	if !reflect.DeepEqual(results, expected) {
+		t.Errorf("Expected %+v, got %+v", expected, results)
+	}
+
+	t.Run("repository error", func(t *testing.T) {
+		repoErr := errors.New("database unavailable")
+		service := NewSubjectGroupAnalyticsService(
+			&mockCategoryRepo{categories: categories},
+			&mocks.MockRatingsRepo{Ratings: ratings},
+			&mockSubjectTicketRepo{err: repoErr},
+			NewTicketScoreService(),
+		)
+
+		if _, err := service.GetQualityBySubjectGroup(context.Background(), []string{"billing"}, startDate, endDate); !errors.Is(err, repoErr) {
+			t.Errorf("Expected %v, got %v", repoErr, err)
+		}
+	})
+}
@@ -3,22 +3,89 @@ package service
 import (
 	"fmt"
 	"ticket-score-service/internal/models"
+	"ticket-score-service/internal/utils"
 )
 
-type TicketScoreService struct{}
+// defaultMaxRating is the highest rating value unless configured otherwise
+const defaultMaxRating = 5
 
-func NewTicketScoreService() *TicketScoreService {
-	return &TicketScoreService{}
+// ratingToPercent converts an average rating to a score from 0 to 100, where maxRating scores 100.
+// Every rating in one category has the same weight, so this is that category's score for the
+// ratings averaged, without loading them.
+func ratingToPercent(average float64, maxRating int) float64 {
+	return average / float64(maxRating) * 100
+}
+
+type TicketScoreService struct {
+	scorePrecision int
+	maxRating      int
+}
+
+// TicketScoreOption configures a TicketScoreService
+type TicketScoreOption func(*TicketScoreService)
+
+// WithMaxRating sets the highest rating value, which scores 100%. Defaults to 5. Values that
+// aren't positive are ignored, as no rating could score then.
+func WithMaxRating(maxRating int) TicketScoreOption {
+	return func(s *TicketScoreService) {
+		if maxRating > 0 {
+			s.maxRating = maxRating
+		}
+	}
+}
+
+// WithScorePrecision sets the number of decimal places in formatted scores. Defaults to 0.
+func WithScorePrecision(decimals int) TicketScoreOption {
+	return func(s *TicketScoreService) {
+		s.scorePrecision = decimals
+	}
+}
+
+func NewTicketScoreService(opts ...TicketScoreOption) *TicketScoreService {
+	s := &TicketScoreService{
+		maxRating: defaultMaxRating,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// MaxRating returns the highest rating value, which scores 100%
+func (s *TicketScoreService) MaxRating() int {
+	return s.maxRating
+}
+
+// FormatScore formats a score with the configured precision
+func (s *TicketScoreService) FormatScore(score float64) string {
+	return utils.FormatScoreWithPrecision(score, s.scorePrecision)
+}
+
+// ScoreResult holds a calculated score together with its intermediate sums
+type ScoreResult struct {
+	Score       float64
+	WeightedSum float64
+	MaxSum      float64
+}
+
+// CalculateScore calculates the weighted score percentage for the given ratings
+func (s *TicketScoreService) CalculateScore(ratings []models.Rating,
+	categories []models.RatingCategory) (float64, error) {
+	result, err := s.CalculateScoreResult(ratings, categories)
+	if err != nil {
+		return 0, err
+	}
+	return result.Score, nil
 }
 
 // The algorithm:
 // Calculates weighted scores: rating × weight for each category
-// Normalizes against maximum possible score: weight × 5
+// Normalizes against maximum possible score: weight × max rating (5 by default)
 // Returns percentage => (total weighted score / total max possible score) * 100
-func (s *TicketScoreService) CalculateScore(ratings []models.Rating,
-	categories []models.RatingCategory) (float64, error) {
+func (s *TicketScoreService) CalculateScoreResult(ratings []models.Rating,
+	categories []models.RatingCategory) (*ScoreResult, error) {
 	if len(ratings) == 0 {
-		return 0, fmt.Errorf("no ratings provided")
+		return nil, fmt.Errorf("no ratings provided")
 	}
 
 	categoryWeights := make(map[int]float64)
@@ -32,23 +99,52 @@ func (s *TicketScoreService) CalculateScore(ratings []models.Rating,
 	for _, rating := range ratings {
 		weight, exists := categoryWeights[rating.RatingCategoryID]
 		if !exists {
-			return 0, fmt.Errorf("rating category %d not found",
+			return nil, fmt.Errorf("rating category %d not found",
 				rating.RatingCategoryID)
 		}
 
-		if rating.Rating < 0 || rating.Rating > 5 {
-			return 0, fmt.Errorf("rating value %d is out of range (0-5)",
-				rating.Rating)
+		if rating.Rating < 0 || rating.Rating > s.maxRating {
+			return nil, fmt.Errorf("rating value %d is out of range (0-%d)",
+				rating.Rating, s.maxRating)
 		}
 
 		totalWeightedScore += float64(rating.Rating) * weight
-		totalMaxPossibleScore += float64(weight * 5)
+		totalMaxPossibleScore += weight * float64(s.maxRating)
 	}
 
 	if totalMaxPossibleScore == 0 {
-		return 0, fmt.Errorf("total possible score is zero")
+		return nil, fmt.Errorf("total possible score is zero")
+	}
+
+	return &ScoreResult{
+		Score:       (totalWeightedScore / totalMaxPossibleScore) * 100,
+		WeightedSum: totalWeightedScore,
+		MaxSum:      totalMaxPossibleScore,
+	}, nil
+}
+
+// SimulateScore returns the current score of the existing ratings and the
+// projected score once the hypothetical rating is added.
+// The current score is 0 when there are no existing ratings.
+func (s *TicketScoreService) SimulateScore(existing []models.Rating, hypothetical models.Rating,
+	categories []models.RatingCategory) (float64, float64, error) {
+	var current float64
+	if len(existing) > 0 {
+		score, err := s.CalculateScore(existing, categories)
+		if err != nil {
+			return 0, 0, fmt.Errorf("failed to calculate current score: %w", err)
+		}
+		current = score
+	}
+
+	withHypothetical := make([]models.Rating, 0, len(existing)+1)
+	withHypothetical = append(withHypothetical, existing...)
+	withHypothetical = append(withHypothetical, hypothetical)
+
+	projected, err := s.CalculateScore(withHypothetical, categories)
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to calculate projected score: %w", err)
 	}
 
-	score := (totalWeightedScore / totalMaxPossibleScore) * 100
-	return score, nil
+	return current, projected, nil
 }
@@ -118,6 +118,41 @@ func TestCalculateScore(t *testing.T) {
 		}
 	})
 
+	t.Run("custom max rating", func(t *testing.T) {
+		service := NewTicketScoreService(WithMaxRating(10))
+		categories := []models.RatingCategory{
+			{ID: 1, Weight: 1},
+		}
+
+		score, err := service.CalculateScore([]models.Rating{{Rating: 5, RatingCategoryID: 1}}, categories)
+		if err != nil {
+			t.Errorf("Expected no error, got %v", err)
+		}
+
+		expected := 50.0 // 5 / 10 * 100 = 50
+		if score != expected {
+			t.Errorf("Expected score %f, got %f", expected, score)
+		}
+
+		if _, err := service.CalculateScore([]models.Rating{{Rating: 11, RatingCategoryID: 1}}, categories); err == nil {
+			t.Error("Expected error for rating above the custom max rating")
+		}
+	})
+
+	t.Run("non-positive max rating is ignored", func(t *testing.T) {
+		for _, maxRating := range []int{0, -1} {
+			service := NewTicketScoreService(WithMaxRating(maxRating))
+			if service.MaxRating() != defaultMaxRating {
+				t.Errorf("WithMaxRating(%d): expected max rating %d, got %d", maxRating, defaultMaxRating, service.MaxRating())
+			}
+
+			score, err := service.CalculateScore([]models.Rating{{Rating: 5, RatingCategoryID: 1}}, []models.RatingCategory{{ID: 1, Weight: 1}})
+			if err != nil || score != 100 {
+				t.Errorf("WithMaxRating(%d): expected score 100, got %f, %v", maxRating, score, err)
+			}
+		}
+	})
+
 	t.Run("rating out of range - negative", func(t *testing.T) {
 		ratings := []models.Rating{
 			{Rating: -1, RatingCategoryID: 1},
@@ -181,3 +216,122 @@ func TestCalculateScore(t *testing.T) {
 		}
 	})
 }
+
+func TestSimulateScore(t *testing.T) {
+	service := NewTicketScoreService()
+
+	categories := []models.RatingCategory{
+		{ID: 1, Weight: 1},
+		{ID: 2, Weight: 2},
+	}
+	existing := []models.Rating{
+		{Rating: 3, RatingCategoryID: 1},
+		{Rating: 3, RatingCategoryID: 2},
+	}
+
+	t.Run("perfect rating increases the score", func(t *testing.T) {
+		current, projected, err := service.SimulateScore(existing, models.Rating{Rating: 5, RatingCategoryID: 2}, categories)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		if current != 60.0 {
+			t.Errorf("Expected current score %f, got %f", 60.0, current)
+		}
+		if projected <= current {
+			t.Errorf("Expected projected score above %f, got %f", current, projected)
+		}
+	})
+
+	t.Run("low rating decreases the score", func(t *testing.T) {
+		current, projected, err := service.SimulateScore(existing, models.Rating{Rating: 1, RatingCategoryID: 1}, categories)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		if projected >= current {
+			t.Errorf("Expected projected score below %f, got %f", current, projected)
+		}
+	})
+
+	t.Run("no existing ratings matches CalculateScore", func(t *testing.T) {
+		hypothetical := models.Rating{Rating: 4, RatingCategoryID: 1}
+
+		current, projected, err := service.SimulateScore(nil, hypothetical, categories)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		expected, err := service.CalculateScore([]models.Rating{hypothetical}, categories)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		if current != 0 {
+			t.Errorf("Expected current score 0, got %f", current)
+		}
+		if projected != expected {
+			t.Errorf("Expected projected score %f, got %f", expected, projected)
+		}
+	})
+
+	t.Run("existing slice is not modified", func(t *testing.T) {
+		input := make([]models.Rating, len(existing), len(existing)+1)
+		copy(input, existing)
+
+		if _, _, err := service.SimulateScore(input, models.Rating{Rating: 5, RatingCategoryID: 1}, categories); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		if extended := input[:cap(input)]; extended[len(input)].Rating != 0 {
+			t.Error("Expected existing backing array to be left untouched")
+		}
+	})
+
+	t.Run("unknown category in hypothetical rating", func(t *testing.T) {
+		_, _, err := service.SimulateScore(existing, models.Rating{Rating: 5, RatingCategoryID: 99}, categories)
+		if err == nil {
+			t.Error("Expected error for unknown category")
+		}
+	})
+}
+
+func TestCalculateScoreResult(t *testing.T) {
+	service := NewTicketScoreService()
+
+	ratings := []models.Rating{
+		{Rating: 4, RatingCategoryID: 1},
+		{Rating: 2, RatingCategoryID: 2},
+	}
+	categories := []models.RatingCategory{
+		{ID: 1, Weight: 3},
+		{ID: 2, Weight: 1},
+	}
+
+	result, err := service.CalculateScoreResult(ratings, categories)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if result.WeightedSum != 14 { // 4*3 + 2*1
+		t.Errorf("Expected weighted sum 14, got %f", result.WeightedSum)
+	}
+	if result.MaxSum != 20 { // 3*5 + 1*5
+		t.Errorf("Expected max sum 20, got %f", result.MaxSum)
+	}
+	if result.WeightedSum/result.MaxSum*100 != result.Score {
+		t.Errorf("Expected score %f to equal weighted sum / max sum * 100", result.Score)
+	}
+
+	score, err := service.CalculateScore(ratings, categories)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if score != result.Score {
+		t.Errorf("Expected CalculateScore to return %f, got %f", result.Score, score)
+	}
+
+	if _, err := service.CalculateScoreResult(nil, categories); err == nil {
+		t.Error("Expected error for empty ratings")
+	}
+}
@@ -2,10 +2,13 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"sort"
 	"sync"
 	"time"
 
+	"ticket-score-service/internal/concurrency"
 	"ticket-score-service/internal/models"
 	"ticket-score-service/internal/utils"
 )
@@ -20,13 +23,175 @@ type TicketCategoryScore struct {
 type TicketScore struct {
 	TicketID   int                   `json:"ticketId"`
 	Categories []TicketCategoryScore `json:"categories"`
+	Violations []string              `json:"violations"`
 }
 
+// ReviewerTicketScores holds the scores of all tickets rated by a single reviewer
+type ReviewerTicketScores struct {
+	ReviewerID int           `json:"reviewerId"`
+	Scores     []TicketScore `json:"scores"`
+}
+
+// TicketCategoryComparison holds one category's scores for two tickets side by side
+type TicketCategoryComparison struct {
+	CategoryName string `json:"categoryName"`
+	Score1       string `json:"score1"`
+	Score2       string `json:"score2"`
+	Difference   string `json:"difference"`
+}
+
+// TicketComparison holds the category scores of two tickets side by side
+type TicketComparison struct {
+	Ticket1             int                        `json:"ticket1"`
+	Ticket2             int                        `json:"ticket2"`
+	CategoryComparisons []TicketCategoryComparison `json:"categoryComparisons"`
+}
+
+// RatingEvent is a single rating in a ticket's timeline, with the ticket's score once it was given
+type RatingEvent struct {
+	Timestamp    time.Time `json:"timestamp"`
+	ReviewerID   int       `json:"reviewerId"`
+	CategoryName string    `json:"categoryName"`
+	Rating       int       `json:"rating"`
+	RunningScore string    `json:"runningScore"`
+}
+
+// RatingTimeline holds a ticket's ratings in the order they were given
+type RatingTimeline struct {
+	TicketID int           `json:"ticketId"`
+	Events   []RatingEvent `json:"events"`
+}
+
+// ConvergencePoint is a ticket's score over its first RatingCount ratings
+type ConvergencePoint struct {
+	RatingCount     int    `json:"ratingCount"`
+	CumulativeScore string `json:"cumulativeScore"`
+}
+
+// ScoreConvergence shows how a ticket's score settles as ratings accumulate
+type ScoreConvergence struct {
+	TicketID  int                `json:"ticketId"`
+	Snapshots []ConvergencePoint `json:"snapshots"`
+}
+
+// DayScorecard holds a ticket's scores from the ratings of a single day
+type DayScorecard struct {
+	Date         string                `json:"date"`
+	Categories   []TicketCategoryScore `json:"categories"`
+	OverallScore string                `json:"overallScore"`
+}
+
+// ScorecardTimeSeries holds a ticket's scorecard for every day of a date range
+type ScorecardTimeSeries struct {
+	TicketID int            `json:"ticketId"`
+	Days     []DayScorecard `json:"days"`
+}
+
+// ExplanationStep shows how one rating contributes to a ticket's score
+type ExplanationStep struct {
+	CategoryName    string  `json:"categoryName"`
+	Rating          int     `json:"rating"`
+	Weight          float64 `json:"weight"`
+	Contribution    float64 `json:"contribution"`
+	MaxContribution float64 `json:"maxContribution"`
+}
+
+// ScoreExplanation breaks a ticket's score down into the contribution of each rating
+type ScoreExplanation struct {
+	TicketID         int               `json:"ticketId"`
+	FinalScore       string            `json:"finalScore"`
+	TotalWeightedSum float64           `json:"totalWeightedSum"`
+	TotalMaxSum      float64           `json:"totalMaxSum"`
+	Steps            []ExplanationStep `json:"steps"`
+}
+
+// CategoryDelta shows how a category's score changed with its most recent rating
+type CategoryDelta struct {
+	CategoryName string `json:"categoryName"`
+	ScoreBefore  string `json:"scoreBefore"`
+	ScoreAfter   string `json:"scoreAfter"`
+	Delta        string `json:"delta"`
+}
+
+// ScorecardDelta holds the category score changes caused by a ticket's latest ratings
+type ScorecardDelta struct {
+	TicketID       int             `json:"ticketId"`
+	CategoryDeltas []CategoryDelta `json:"categoryDeltas"`
+}
+
+// TicketMetrics holds the raw statistics behind a ticket's score
+type TicketMetrics struct {
+	TicketID       int     `json:"ticketId"`
+	TotalRatings   int     `json:"totalRatings"`
+	WeightedSum    float64 `json:"weightedSum"`
+	MaxPossibleSum float64 `json:"maxPossibleSum"`
+	ScoreFloat     float64 `json:"scoreFloat"`
+}
+
+// TicketRatingStat holds the raw rating count and average of a ticket
+type TicketRatingStat struct {
+	TicketID      int     `json:"ticketId"`
+	RatingCount   int     `json:"ratingCount"`
+	AverageRating float64 `json:"averageRating"`
+}
+
+// ErrInvalidTicketID is returned when a requested ticket ID isn't positive
+var ErrInvalidTicketID = errors.New("invalid ticket ID")
+
+// MaxMultipleTicketScores is the largest number of tickets GetMultipleTicketScores scores at once
+const MaxMultipleTicketScores = 1000
+
+// MaxTicketGroupSize is the largest number of tickets GetCategoryScoreForTicketGroup scores together
+const MaxTicketGroupSize = 500
+
+// ticketScoreBatchSize is the number of tickets whose ratings are fetched in one query
+const ticketScoreBatchSize = 999
+
+// DefaultMaxCategoryConcurrency is the default number of categories scored concurrently
+const DefaultMaxCategoryConcurrency = 5
+
 // TicketScoresService handles ticket score calculations
 type TicketScoresService struct {
-	categoryRepo    CategoryRepository
-	ratingsRepo     RatingsRepository
-	ticketScoreServ ScoreCalculator
+	categoryRepo      CategoryRepository
+	ratingsRepo       RatingsRepository
+	ticketScoreServ   ScoreCalculator
+	categorySemaphore chan struct{}
+	globalLimiter     *concurrency.GlobalConcurrencyLimiter
+	ruleEngine        *RuleEngine
+	scorePrecision    int
+}
+
+// TicketScoresOption configures a TicketScoresService
+type TicketScoresOption func(*TicketScoresService)
+
+// WithMaxCategoryConcurrency limits how many category scores are calculated at once across all
+// tickets. Defaults to DefaultMaxCategoryConcurrency; values below 1 allow one.
+func WithMaxCategoryConcurrency(maxCategoryConcurrency int) TicketScoresOption {
+	return func(s *TicketScoresService) {
+		s.categorySemaphore = make(chan struct{}, max(maxCategoryConcurrency, 1))
+	}
+}
+
+// WithTicketScoresConcurrencyLimiter shares a global goroutine limit with other services, on top
+// of the per-service category limit
+func WithTicketScoresConcurrencyLimiter(limiter *concurrency.GlobalConcurrencyLimiter) TicketScoresOption {
+	return func(s *TicketScoresService) {
+		s.globalLimiter = limiter
+	}
+}
+
+// WithRuleEngine sets the scoring rules ticket scores are checked against. Without it no rules apply.
+func WithRuleEngine(ruleEngine *RuleEngine) TicketScoresOption {
+	return func(s *TicketScoresService) {
+		s.ruleEngine = ruleEngine
+	}
+}
+
+// WithTicketScoresPrecision sets the number of decimal places in formatted scores. Defaults to 0.
+func WithTicketScoresPrecision(decimals int) TicketScoresOption {
+	return func(s *TicketScoresService) {
+		s.scorePrecision = decimals
+	}
 }
 
 // NewTicketScoresService creates a new ticket scores service instance
@@ -34,12 +199,24 @@ func NewTicketScoresService(
 	categoryRepo CategoryRepository,
 	ratingsRepo RatingsRepository,
 	ticketScoreServ ScoreCalculator,
+	opts ...TicketScoresOption,
 ) *TicketScoresService {
-	return &TicketScoresService{
-		categoryRepo:    categoryRepo,
-		ratingsRepo:     ratingsRepo,
-		ticketScoreServ: ticketScoreServ,
+	s := &TicketScoresService{
+		categoryRepo:      categoryRepo,
+		ratingsRepo:       ratingsRepo,
+		ticketScoreServ:   ticketScoreServ,
+		categorySemaphore: make(chan struct{}, DefaultMaxCategoryConcurrency),
+		ruleEngine:        NewRuleEngine(nil),
+	}
+	for _, opt := range opts {
+		opt(s)
 	}
+	return s
+}
+
+// formatScore formats a score with the configured precision
+func (s *TicketScoresService) formatScore(score float64) string {
+	return utils.FormatScoreWithPrecision(score, s.scorePrecision)
 }
 
 // GetTicketScores gets scores for all tickets within a date range, streaming results
@@ -51,46 +228,221 @@ func (s *TicketScoresService) GetTicketScores(ctx context.Context, startDate, en
 		defer close(resultChan)
 		defer close(errorChan)
 
-		// Get distinct ticket IDs from ratings table
-		ticketIDs, err := s.ratingsRepo.GetDistinctTicketIDsByDateRange(ctx, startDate, endDate)
+		err := s.forEachTicketRatings(ctx, startDate, endDate, func(ticketID int, categories []models.RatingCategory, ratingsByCategory map[int][]models.Rating) bool {
+			select {
+			case resultChan <- s.scoreTicketRatings(ticketID, categories, ratingsByCategory):
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		})
 		if err != nil {
-			errorChan <- fmt.Errorf("failed to get ticket IDs: %w", err)
+			errorChan <- err
+		}
+	}()
+
+	return resultChan, errorChan
+}
+
+// forEachTicketRatings calls fn with the ratings of every ticket rated within a date range, grouped
+// by category ID, fetching the ratings of each batch of tickets in one query. It stops early when fn
+// returns false.
+func (s *TicketScoresService) forEachTicketRatings(
+	ctx context.Context,
+	startDate, endDate time.Time,
+	fn func(ticketID int, categories []models.RatingCategory, ratingsByCategory map[int][]models.Rating) bool,
+) error {
+	ticketIDs, err := s.ratingsRepo.GetDistinctTicketIDsByDateRange(ctx, startDate, endDate)
+	if err != nil {
+		return logError(ctx, fmt.Errorf("failed to get ticket IDs: %w", err))
+	}
+
+	categories, err := s.categoryRepo.GetAll(ctx)
+	if err != nil {
+		return logError(ctx, fmt.Errorf("failed to get categories: %w", err))
+	}
+
+	categoryIDs := make([]int, len(categories))
+	for i, category := range categories {
+		categoryIDs[i] = category.ID
+	}
+
+	for start := 0; start < len(ticketIDs); start += ticketScoreBatchSize {
+		batch := ticketIDs[start:min(start+ticketScoreBatchSize, len(ticketIDs))]
+
+		ratings, err := s.getTicketRatings(ctx, batch, categoryIDs)
+		if err != nil {
+			return fmt.Errorf("failed to get ratings: %w", err)
+		}
+
+		for _, ticketID := range batch {
+			if !fn(ticketID, categories, ratings[ticketID]) {
+				return nil
+			}
+		}
+	}
+
+	return nil
+}
+
+// GetMultipleTicketScores scores the given tickets from their ratings created within a date range,
+// returning one score per ticket ID in request order. Tickets without ratings get "N/A" scores.
+func (s *TicketScoresService) GetMultipleTicketScores(ctx context.Context, ticketIDs []int, startDate, endDate time.Time) ([]TicketScore, error) {
+	if len(ticketIDs) > MaxMultipleTicketScores {
+		return nil, fmt.Errorf("%w: at most %d tickets can be scored at once, got %d", ErrInvalidTicketID, MaxMultipleTicketScores, len(ticketIDs))
+	}
+	for _, ticketID := range ticketIDs {
+		if ticketID <= 0 {
+			return nil, fmt.Errorf("%w: %d", ErrInvalidTicketID, ticketID)
+		}
+	}
+
+	categories, err := s.categoryRepo.GetAll(ctx)
+	if err != nil {
+		return nil, logError(ctx, fmt.Errorf("failed to get categories: %w", err))
+	}
+
+	categoryIDs := make([]int, len(categories))
+	for i, category := range categories {
+		categoryIDs[i] = category.ID
+	}
+
+	// Whole days, as elsewhere: ratings from the start of startDate to the end of endDate count
+	from := time.Date(startDate.Year(), startDate.Month(), startDate.Day(), 0, 0, 0, 0, startDate.Location())
+	to := time.Date(endDate.Year(), endDate.Month(), endDate.Day(), 0, 0, 0, 0, endDate.Location()).AddDate(0, 0, 1)
+
+	scores := make([]TicketScore, 0, len(ticketIDs))
+	for start := 0; start < len(ticketIDs); start += ticketScoreBatchSize {
+		batch := ticketIDs[start:min(start+ticketScoreBatchSize, len(ticketIDs))]
+
+		ratings, err := s.getTicketRatings(ctx, batch, categoryIDs)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get ratings: %w", err)
+		}
+
+		for _, ticketID := range batch {
+			ratingsByCategory := make(map[int][]models.Rating, len(ratings[ticketID]))
+			for categoryID, categoryRatings := range ratings[ticketID] {
+				for _, rating := range categoryRatings {
+					if !rating.CreatedAt.Before(from) && rating.CreatedAt.Before(to) {
+						ratingsByCategory[categoryID] = append(ratingsByCategory[categoryID], rating)
+					}
+				}
+			}
+			scores = append(scores, s.scoreTicketRatings(ticketID, categories, ratingsByCategory))
+		}
+	}
+
+	return scores, nil
+}
+
+// GetCategoryScoreForTicketGroup scores the ratings of a group of tickets together, one score per
+// category in category order. Only the given categories are scored; all categories are used when none
+// are given. Categories without ratings in the group get an "N/A" score.
+func (s *TicketScoresService) GetCategoryScoreForTicketGroup(ctx context.Context, ticketIDs []int, categories []models.RatingCategory) ([]TicketCategoryScore, error) {
+	if len(ticketIDs) > MaxTicketGroupSize {
+		return nil, fmt.Errorf("%w: at most %d tickets can be grouped, got %d", ErrInvalidTicketID, MaxTicketGroupSize, len(ticketIDs))
+	}
+	for _, ticketID := range ticketIDs {
+		if ticketID <= 0 {
+			return nil, fmt.Errorf("%w: %d", ErrInvalidTicketID, ticketID)
+		}
+	}
+
+	if len(categories) == 0 {
+		allCategories, err := s.categoryRepo.GetAll(ctx)
+		if err != nil {
+			return nil, logError(ctx, fmt.Errorf("failed to get categories: %w", err))
+		}
+		categories = allCategories
+	}
+
+	// A ticket listed twice must not have its ratings counted twice
+	seen := make(map[int]bool, len(ticketIDs))
+	uniqueTicketIDs := make([]int, 0, len(ticketIDs))
+	for _, ticketID := range ticketIDs {
+		if !seen[ticketID] {
+			seen[ticketID] = true
+			uniqueTicketIDs = append(uniqueTicketIDs, ticketID)
+		}
+	}
+
+	categoryIDs := make([]int, len(categories))
+	for i, category := range categories {
+		categoryIDs[i] = category.ID
+	}
+
+	ratings, err := s.getTicketRatings(ctx, uniqueTicketIDs, categoryIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get ratings: %w", err)
+	}
+
+	scores := make([]TicketCategoryScore, 0, len(categories))
+	for _, category := range categories {
+		var groupRatings []models.Rating
+		for _, ticketID := range uniqueTicketIDs {
+			groupRatings = append(groupRatings, ratings[ticketID][category.ID]...)
+		}
+
+		scores = append(scores, TicketCategoryScore{
+			CategoryName: category.Name,
+			Score:        s.scoreInCategory(groupRatings, category),
+		})
+	}
+
+	return scores, nil
+}
+
+// GetTicketScoresGroupedByReviewer gets scores for the tickets each reviewer rated within a date range,
+// streaming one result per reviewer
+func (s *TicketScoresService) GetTicketScoresGroupedByReviewer(ctx context.Context, startDate, endDate time.Time) (<-chan ReviewerTicketScores, <-chan error) {
+	resultChan := make(chan ReviewerTicketScores, 100)
+	errorChan := make(chan error, 1)
+
+	go func() {
+		defer close(resultChan)
+		defer close(errorChan)
+
+		// Get distinct reviewer IDs from ratings table
+		reviewerIDs, err := s.ratingsRepo.GetDistinctReviewerIDsByDateRange(ctx, startDate, endDate)
+		if err != nil {
+			errorChan <- logError(ctx, fmt.Errorf("failed to get reviewer IDs: %w", err))
 			return
 		}
 
 		// Get all categories
 		categories, err := s.categoryRepo.GetAll(ctx)
 		if err != nil {
-			errorChan <- fmt.Errorf("failed to get categories: %w", err)
+			errorChan <- logError(ctx, fmt.Errorf("failed to get categories: %w", err))
 			return
 		}
 
-		// Process tickets concurrently
+		// Process reviewers concurrently
 		semaphore := make(chan struct{}, 10) // Limit concurrent goroutines
 		var wg sync.WaitGroup
 
-		for _, ticketID := range ticketIDs {
+		for _, reviewerID := range reviewerIDs {
 			wg.Add(1)
-			go func(tID int) {
+			go func(rID int) {
 				defer wg.Done()
 				semaphore <- struct{}{}        // Acquire
 				defer func() { <-semaphore }() // Release
 
-				ticketScore, err := s.calculateTicketScore(ctx, tID, categories)
+				reviewerScores, err := s.calculateReviewerTicketScores(ctx, rID, startDate, endDate, categories)
 				if err != nil {
 					select {
-					case errorChan <- fmt.Errorf("failed to calculate score for ticket %d: %w", tID, err):
+					case errorChan <- fmt.Errorf("failed to calculate scores for reviewer %d: %w", rID, err):
 					case <-ctx.Done():
 					}
 					return
 				}
 
 				select {
-				case resultChan <- ticketScore:
+				case resultChan <- reviewerScores:
 				case <-ctx.Done():
 					return
 				}
-			}(ticketID)
+			}(reviewerID)
 		}
 
 		wg.Wait()
@@ -99,6 +451,60 @@ func (s *TicketScoresService) GetTicketScores(ctx context.Context, startDate, en
 	return resultChan, errorChan
 }
 
+// calculateReviewerTicketScores scores every ticket a reviewer rated within a date range, in ticket
+// ID order, from that reviewer's ratings in the range only
+func (s *TicketScoresService) calculateReviewerTicketScores(ctx context.Context, reviewerID int, startDate, endDate time.Time, categories []models.RatingCategory) (ReviewerTicketScores, error) {
+	reviewerScores := ReviewerTicketScores{ReviewerID: reviewerID}
+
+	ratings, err := s.ratingsRepo.GetByReviewerIDAndDateRange(ctx, reviewerID, startDate, endDate)
+	if err != nil {
+		return reviewerScores, logError(ctx, fmt.Errorf("failed to get ratings: %w", err))
+	}
+
+	ratingsByTicket := make(map[int]map[int][]models.Rating)
+	for _, rating := range ratings {
+		if ratingsByTicket[rating.TicketID] == nil {
+			ratingsByTicket[rating.TicketID] = make(map[int][]models.Rating)
+		}
+		ratingsByTicket[rating.TicketID][rating.RatingCategoryID] = append(ratingsByTicket[rating.TicketID][rating.RatingCategoryID], rating)
+	}
+
+	ticketIDs := make([]int, 0, len(ratingsByTicket))
+	for ticketID := range ratingsByTicket {
+		ticketIDs = append(ticketIDs, ticketID)
+	}
+	sort.Ints(ticketIDs)
+
+	reviewerScores.Scores = make([]TicketScore, 0, len(ticketIDs))
+	for _, ticketID := range ticketIDs {
+		reviewerScores.Scores = append(reviewerScores.Scores, s.scoreTicketRatings(ticketID, categories, ratingsByTicket[ticketID]))
+	}
+
+	return reviewerScores, nil
+}
+
+// acquireCategorySlot blocks until both a category slot and a global slot are free,
+// returning false if ctx is done first
+func (s *TicketScoresService) acquireCategorySlot(ctx context.Context) bool {
+	select {
+	case s.categorySemaphore <- struct{}{}:
+	case <-ctx.Done():
+		return false
+	}
+
+	if err := s.globalLimiter.Acquire(ctx); err != nil {
+		<-s.categorySemaphore
+		return false
+	}
+	return true
+}
+
+// releaseCategorySlot frees the slots taken by acquireCategorySlot
+func (s *TicketScoresService) releaseCategorySlot() {
+	s.globalLimiter.Release()
+	<-s.categorySemaphore
+}
+
 // calculateTicketScore calculates scores for all categories for a single ticket
 func (s *TicketScoresService) calculateTicketScore(ctx context.Context, ticketID int, categories []models.RatingCategory) (TicketScore, error) {
 	ticketScore := TicketScore{
@@ -110,24 +516,39 @@ func (s *TicketScoresService) calculateTicketScore(ctx context.Context, ticketID
 	type categoryResult struct {
 		categoryName string
 		score        string
+		ratings      []models.Rating
 		err          error
 	}
 
 	resultChan := make(chan categoryResult, len(categories))
 	var wg sync.WaitGroup
 
-	// Calculate scores for each category concurrently
+	// Calculate scores for each category concurrently, bounded by the category semaphore
 	for _, category := range categories {
+		if !s.acquireCategorySlot(ctx) {
+			resultChan <- categoryResult{
+				categoryName: category.Name,
+				score:        "N/A",
+				err:          ctx.Err(),
+			}
+			break
+		}
+
 		wg.Add(1)
 		go func(cat models.RatingCategory) {
 			defer wg.Done()
+			defer s.releaseCategorySlot()
 
 			ratings, err := s.ratingsRepo.GetByTicketIDAndCategoryID(ctx, ticketID, cat.ID)
+			// The caller has stopped collecting results
+			if ctx.Err() != nil {
+				return
+			}
 			if err != nil {
 				resultChan <- categoryResult{
 					categoryName: cat.Name,
 					score:        "N/A",
-					err:          err,
+					err:          logError(ctx, err),
 				}
 				return
 			}
@@ -140,13 +561,14 @@ func (s *TicketScoresService) calculateTicketScore(ctx context.Context, ticketID
 				if err != nil {
 					score = "N/A"
 				} else {
-					score = utils.FormatScore(calculatedScore)
+					score = s.formatScore(calculatedScore)
 				}
 			}
 
 			resultChan <- categoryResult{
-				categoryName: cat.Name,
+				categoryName: s.categoryName(ctx, cat),
 				score:        score,
+				ratings:      ratings,
 				err:          nil,
 			}
 		}(category)
@@ -158,17 +580,538 @@ func (s *TicketScoresService) calculateTicketScore(ctx context.Context, ticketID
 		close(resultChan)
 	}()
 
-	// Collect results
-	for result := range resultChan {
-		if result.err != nil {
-			return ticketScore, fmt.Errorf("failed to calculate score for category %s: %w", result.categoryName, result.err)
+	// Collect results until every category is scored or the context is canceled
+	var ratings []models.Rating
+collect:
+	for {
+		select {
+		case result, ok := <-resultChan:
+			if !ok {
+				break collect
+			}
+			if result.err != nil {
+				return ticketScore, fmt.Errorf("failed to calculate score for category %s: %w", result.categoryName, result.err)
+			}
+
+			ticketScore.Categories = append(ticketScore.Categories, TicketCategoryScore{
+				CategoryName: result.categoryName,
+				Score:        result.score,
+			})
+			ratings = append(ratings, result.ratings...)
+		case <-ctx.Done():
+			return ticketScore, ctx.Err()
+		}
+	}
+	// Goroutines canceled after their query send no result, so the scores may be incomplete
+	if err := ctx.Err(); err != nil {
+		return ticketScore, err
+	}
+	ticketScore.Violations = s.ruleViolations(ratings)
+
+	return ticketScore, nil
+}
+
+// getTicketRatings fetches the ratings of a batch of tickets while holding a global slot
+func (s *TicketScoresService) getTicketRatings(ctx context.Context, ticketIDs, categoryIDs []int) (map[int]map[int][]models.Rating, error) {
+	if err := s.globalLimiter.Acquire(ctx); err != nil {
+		return nil, err
+	}
+	defer s.globalLimiter.Release()
+
+	ratings, err := s.ratingsRepo.GetRatingsByTicketIDsAndCategoryIDs(ctx, ticketIDs, categoryIDs)
+	if err != nil {
+		return nil, logError(ctx, err)
+	}
+	return ratings, nil
+}
+
+// scoreTicketRatings scores a ticket in every category, in category order, from its ratings grouped by
+// category ID
+func (s *TicketScoresService) scoreTicketRatings(ticketID int, categories []models.RatingCategory, ratingsByCategory map[int][]models.Rating) TicketScore {
+	ticketScore := TicketScore{
+		TicketID:   ticketID,
+		Categories: make([]TicketCategoryScore, 0, len(categories)),
+	}
+
+	var ticketRatings []models.Rating
+	for _, category := range categories {
+		ticketRatings = append(ticketRatings, ratingsByCategory[category.ID]...)
+
+		score := "N/A"
+		if ratings := ratingsByCategory[category.ID]; len(ratings) > 0 {
+			calculatedScore, err := s.ticketScoreServ.CalculateScore(ratings, []models.RatingCategory{category})
+			if err == nil {
+				score = s.formatScore(calculatedScore)
+			}
 		}
 
 		ticketScore.Categories = append(ticketScore.Categories, TicketCategoryScore{
-			CategoryName: result.categoryName,
-			Score:        result.score,
+			CategoryName: category.Name,
+			Score:        score,
 		})
 	}
+	ticketScore.Violations = s.ruleViolations(ticketRatings)
 
-	return ticketScore, nil
+	return ticketScore
+}
+
+// ruleViolations describes the scoring rules a ticket's ratings break, checking the ratings in ID
+// order so the result doesn't depend on the order they were fetched in
+func (s *TicketScoresService) ruleViolations(ratings []models.Rating) []string {
+	sorted := make([]models.Rating, len(ratings))
+	copy(sorted, ratings)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].ID < sorted[j].ID
+	})
+
+	var violations []string
+	for _, violation := range s.ruleEngine.Validate(sorted) {
+		violations = append(violations, violation.String())
+	}
+	return violations
+}
+
+// sortChronologically sorts ratings by creation time, and ratings created at the same time by ID
+func sortChronologically(ratings []models.Rating) {
+	sort.SliceStable(ratings, func(i, j int) bool {
+		if !ratings[i].CreatedAt.Equal(ratings[j].CreatedAt) {
+			return ratings[i].CreatedAt.Before(ratings[j].CreatedAt)
+		}
+		return ratings[i].ID < ratings[j].ID
+	})
+}
+
+// categoryName looks up the current name of a category, falling back to the name it was listed with
+func (s *TicketScoresService) categoryName(ctx context.Context, category models.RatingCategory) string {
+	current, err := s.categoryRepo.GetByID(ctx, category.ID)
+	if err != nil {
+		return category.Name
+	}
+	return current.Name
+}
+
+// CompareTwoTickets compares the category scores of two tickets, in category order.
+// Each difference is score2 - score1, or "N/A" if either ticket has no ratings in the category.
+func (s *TicketScoresService) CompareTwoTickets(ctx context.Context, ticketID1, ticketID2 int) (*TicketComparison, error) {
+	categories, err := s.categoryRepo.GetAll(ctx)
+	if err != nil {
+		return nil, logError(ctx, fmt.Errorf("failed to get categories: %w", err))
+	}
+
+	ticketScore1, err := s.calculateTicketScore(ctx, ticketID1, categories)
+	if err != nil {
+		return nil, fmt.Errorf("failed to calculate score for ticket %d: %w", ticketID1, err)
+	}
+
+	ticketScore2, err := s.calculateTicketScore(ctx, ticketID2, categories)
+	if err != nil {
+		return nil, fmt.Errorf("failed to calculate score for ticket %d: %w", ticketID2, err)
+	}
+
+	// calculateTicketScore returns categories in completion order
+	scores1 := make(map[string]string, len(ticketScore1.Categories))
+	for _, categoryScore := range ticketScore1.Categories {
+		scores1[categoryScore.CategoryName] = categoryScore.Score
+	}
+	scores2 := make(map[string]string, len(ticketScore2.Categories))
+	for _, categoryScore := range ticketScore2.Categories {
+		scores2[categoryScore.CategoryName] = categoryScore.Score
+	}
+
+	comparison := &TicketComparison{
+		Ticket1:             ticketID1,
+		Ticket2:             ticketID2,
+		CategoryComparisons: make([]TicketCategoryComparison, 0, len(categories)),
+	}
+	for _, category := range categories {
+		// Named like the ticket scores, which may have a newer name than categories
+		name := s.categoryName(ctx, category)
+		score1, score2 := scores1[name], scores2[name]
+		comparison.CategoryComparisons = append(comparison.CategoryComparisons, TicketCategoryComparison{
+			CategoryName: name,
+			Score1:       score1,
+			Score2:       score2,
+			Difference:   scoreDifference(score2, score1, s.scorePrecision),
+		})
+	}
+
+	return comparison, nil
+}
+
+// GetTicketRatingTimeline gets a ticket's ratings in chronological order. Each event's running score
+// is the ticket's score over that rating and every earlier one. Ratings in unknown categories are
+// listed without a category name and left out of the running score.
+func (s *TicketScoresService) GetTicketRatingTimeline(ctx context.Context, ticketID int) (*RatingTimeline, error) {
+	categories, err := s.categoryRepo.GetAll(ctx)
+	if err != nil {
+		return nil, logError(ctx, fmt.Errorf("failed to get categories: %w", err))
+	}
+	categoryNames := make(map[int]string, len(categories))
+	for _, category := range categories {
+		categoryNames[category.ID] = category.Name
+	}
+
+	ratings, err := s.ratingsRepo.GetByTicketID(ctx, ticketID)
+	if err != nil {
+		return nil, logError(ctx, fmt.Errorf("failed to get ratings: %w", err))
+	}
+	sortChronologically(ratings)
+
+	timeline := &RatingTimeline{
+		TicketID: ticketID,
+		Events:   make([]RatingEvent, 0, len(ratings)),
+	}
+	var scored []models.Rating
+	runningScore := "N/A"
+	for _, rating := range ratings {
+		categoryName, known := categoryNames[rating.RatingCategoryID]
+		if known {
+			scored = append(scored, rating)
+			score, err := s.ticketScoreServ.CalculateScore(scored, categories)
+			if err != nil {
+				return nil, fmt.Errorf("failed to calculate running score for ticket %d: %w", ticketID, err)
+			}
+			runningScore = s.formatScore(score)
+		}
+
+		timeline.Events = append(timeline.Events, RatingEvent{
+			Timestamp:    rating.CreatedAt,
+			ReviewerID:   rating.ReviewerID,
+			CategoryName: categoryName,
+			Rating:       rating.Rating,
+			RunningScore: runningScore,
+		})
+	}
+
+	return timeline, nil
+}
+
+// GetScoreConvergence calculates a ticket's score over its first N ratings in chronological order,
+// for every N from 1 to its number of ratings. Ratings in unknown categories are left out.
+func (s *TicketScoresService) GetScoreConvergence(ctx context.Context, ticketID int) (*ScoreConvergence, error) {
+	categories, err := s.categoryRepo.GetAll(ctx)
+	if err != nil {
+		return nil, logError(ctx, fmt.Errorf("failed to get categories: %w", err))
+	}
+	knownCategories := make(map[int]bool, len(categories))
+	for _, category := range categories {
+		knownCategories[category.ID] = true
+	}
+
+	ratings, err := s.ratingsRepo.GetByTicketID(ctx, ticketID)
+	if err != nil {
+		return nil, logError(ctx, fmt.Errorf("failed to get ratings: %w", err))
+	}
+	sortChronologically(ratings)
+
+	convergence := &ScoreConvergence{
+		TicketID:  ticketID,
+		Snapshots: make([]ConvergencePoint, 0, len(ratings)),
+	}
+	var scored []models.Rating
+	for _, rating := range ratings {
+		if !knownCategories[rating.RatingCategoryID] {
+			continue
+		}
+		scored = append(scored, rating)
+
+		score, err := s.ticketScoreServ.CalculateScore(scored, categories)
+		if err != nil {
+			return nil, fmt.Errorf("failed to calculate score over %d ratings of ticket %d: %w", len(scored), ticketID, err)
+		}
+		convergence.Snapshots = append(convergence.Snapshots, ConvergencePoint{
+			RatingCount:     len(scored),
+			CumulativeScore: s.formatScore(score),
+		})
+	}
+
+	return convergence, nil
+}
+
+// GetScoreForTicketAtTime calculates a ticket's score from the ratings it had at asOf, ignoring
+// ratings in unknown categories. A ticket without ratings by then scores "N/A".
+func (s *TicketScoresService) GetScoreForTicketAtTime(ctx context.Context, ticketID int, asOf time.Time) (string, error) {
+	categories, err := s.categoryRepo.GetAll(ctx)
+	if err != nil {
+		return "", logError(ctx, fmt.Errorf("failed to get categories: %w", err))
+	}
+	knownCategories := make(map[int]bool, len(categories))
+	for _, category := range categories {
+		knownCategories[category.ID] = true
+	}
+
+	ratings, err := s.ratingsRepo.GetByTicketIDBeforeTime(ctx, ticketID, asOf)
+	if err != nil {
+		return "", logError(ctx, fmt.Errorf("failed to get ratings: %w", err))
+	}
+
+	var scorable []models.Rating
+	for _, rating := range ratings {
+		if knownCategories[rating.RatingCategoryID] {
+			scorable = append(scorable, rating)
+		}
+	}
+	if len(scorable) == 0 {
+		return "N/A", nil
+	}
+
+	score, err := s.ticketScoreServ.CalculateScore(scorable, categories)
+	if err != nil {
+		return "", fmt.Errorf("failed to calculate score for ticket %d: %w", ticketID, err)
+	}
+
+	return s.formatScore(score), nil
+}
+
+// GetLatestScorecardDelta compares each category's score over all of a ticket's ratings with its
+// score without the category's most recent rating, in category order. Categories with fewer than
+// two ratings have nothing to compare and are left out, as are ratings in unknown categories.
+func (s *TicketScoresService) GetLatestScorecardDelta(ctx context.Context, ticketID int) (*ScorecardDelta, error) {
+	categories, err := s.categoryRepo.GetAll(ctx)
+	if err != nil {
+		return nil, logError(ctx, fmt.Errorf("failed to get categories: %w", err))
+	}
+
+	ratings, err := s.ratingsRepo.GetByTicketID(ctx, ticketID)
+	if err != nil {
+		return nil, logError(ctx, fmt.Errorf("failed to get ratings: %w", err))
+	}
+	sortChronologically(ratings)
+
+	ratingsByCategory := make(map[int][]models.Rating)
+	for _, rating := range ratings {
+		ratingsByCategory[rating.RatingCategoryID] = append(ratingsByCategory[rating.RatingCategoryID], rating)
+	}
+
+	delta := &ScorecardDelta{
+		TicketID:       ticketID,
+		CategoryDeltas: []CategoryDelta{},
+	}
+	for _, category := range categories {
+		categoryRatings := ratingsByCategory[category.ID]
+		if len(categoryRatings) < 2 {
+			continue
+		}
+
+		scoreBefore := s.scoreInCategory(categoryRatings[:len(categoryRatings)-1], category)
+		scoreAfter := s.scoreInCategory(categoryRatings, category)
+		delta.CategoryDeltas = append(delta.CategoryDeltas, CategoryDelta{
+			CategoryName: category.Name,
+			ScoreBefore:  scoreBefore,
+			ScoreAfter:   scoreAfter,
+			Delta:        scoreDifference(scoreAfter, scoreBefore, s.scorePrecision),
+		})
+	}
+
+	return delta, nil
+}
+
+// GetScoreExplanation breaks a ticket's score down into one step per rating, in chronological order.
+// Each step contributes rating × weight out of a possible max rating × weight, see WithMaxRating. Ratings in unknown categories
+// are left out; a ticket without ratings scores "N/A".
+func (s *TicketScoresService) GetScoreExplanation(ctx context.Context, ticketID int) (*ScoreExplanation, error) {
+	categories, err := s.categoryRepo.GetAll(ctx)
+	if err != nil {
+		return nil, logError(ctx, fmt.Errorf("failed to get categories: %w", err))
+	}
+	categoriesByID := make(map[int]models.RatingCategory, len(categories))
+	for _, category := range categories {
+		categoriesByID[category.ID] = category
+	}
+
+	ratings, err := s.ratingsRepo.GetByTicketID(ctx, ticketID)
+	if err != nil {
+		return nil, logError(ctx, fmt.Errorf("failed to get ratings: %w", err))
+	}
+	sortChronologically(ratings)
+
+	explanation := &ScoreExplanation{
+		TicketID:   ticketID,
+		FinalScore: "N/A",
+		Steps:      make([]ExplanationStep, 0, len(ratings)),
+	}
+	var scored []models.Rating
+	for _, rating := range ratings {
+		category, known := categoriesByID[rating.RatingCategoryID]
+		if !known {
+			continue
+		}
+		scored = append(scored, rating)
+
+		explanation.Steps = append(explanation.Steps, ExplanationStep{
+			CategoryName:    category.Name,
+			Rating:          rating.Rating,
+			Weight:          category.Weight,
+			Contribution:    float64(rating.Rating) * category.Weight,
+			MaxContribution: float64(s.ticketScoreServ.MaxRating()) * category.Weight,
+		})
+	}
+	if len(scored) == 0 {
+		return explanation, nil
+	}
+
+	result, err := s.ticketScoreServ.CalculateScoreResult(scored, categories)
+	if err != nil {
+		return nil, fmt.Errorf("failed to calculate score for ticket %d: %w", ticketID, err)
+	}
+
+	explanation.FinalScore = s.formatScore(result.Score)
+	explanation.TotalWeightedSum = result.WeightedSum
+	explanation.TotalMaxSum = result.MaxSum
+
+	return explanation, nil
+}
+
+// GetScorecardTimeSeries gets a ticket's scores for every day of a date range, each from the
+// ratings of that day only. Days without ratings have no categories and an "N/A" overall score.
+func (s *TicketScoresService) GetScorecardTimeSeries(ctx context.Context, ticketID int, startDate, endDate time.Time) (*ScorecardTimeSeries, error) {
+	categories, err := s.categoryRepo.GetAll(ctx)
+	if err != nil {
+		return nil, logError(ctx, fmt.Errorf("failed to get categories: %w", err))
+	}
+	knownCategories := make(map[int]bool, len(categories))
+	for _, category := range categories {
+		knownCategories[category.ID] = true
+	}
+
+	ratings, err := s.ratingsRepo.GetByTicketID(ctx, ticketID)
+	if err != nil {
+		return nil, logError(ctx, fmt.Errorf("failed to get ratings: %w", err))
+	}
+
+	// Ratings in unknown categories cannot be scored
+	var scorable []models.Rating
+	for _, rating := range ratings {
+		if knownCategories[rating.RatingCategoryID] {
+			scorable = append(scorable, rating)
+		}
+	}
+	ratingsByDate := groupRatingsByDate(scorable, startDate.Location())
+
+	series := &ScorecardTimeSeries{TicketID: ticketID}
+	for currentDate := startDate; !currentDate.After(endDate); currentDate = currentDate.AddDate(0, 0, 1) {
+		dateStr := currentDate.Format("2006-01-02")
+		dailyRatings := ratingsByDate[dateStr]
+
+		day := DayScorecard{
+			Date:         dateStr,
+			Categories:   []TicketCategoryScore{},
+			OverallScore: "N/A",
+		}
+		if len(dailyRatings) > 0 {
+			for _, category := range categories {
+				day.Categories = append(day.Categories, TicketCategoryScore{
+					CategoryName: category.Name,
+					Score:        s.scoreInCategory(dailyRatings, category),
+				})
+			}
+
+			score, err := s.ticketScoreServ.CalculateScore(dailyRatings, categories)
+			if err != nil {
+				return nil, fmt.Errorf("failed to calculate score for ticket %d on %s: %w", ticketID, dateStr, err)
+			}
+			day.OverallScore = s.formatScore(score)
+		}
+
+		series.Days = append(series.Days, day)
+	}
+
+	return series, nil
+}
+
+// scoreInCategory formats the score of the ratings in a category, or "N/A" if there are none
+func (s *TicketScoresService) scoreInCategory(ratings []models.Rating, category models.RatingCategory) string {
+	var categoryRatings []models.Rating
+	for _, rating := range ratings {
+		if rating.RatingCategoryID == category.ID {
+			categoryRatings = append(categoryRatings, rating)
+		}
+	}
+	if len(categoryRatings) == 0 {
+		return "N/A"
+	}
+
+	score, err := s.ticketScoreServ.CalculateScore(categoryRatings, []models.RatingCategory{category})
+	if err != nil {
+		return "N/A"
+	}
+
+	return s.formatScore(score)
+}
+
+// GetTicketMetrics calculates the raw score statistics for a ticket.
+// Only ratings in the given categories are counted; all categories are used when none are given.
+func (s *TicketScoresService) GetTicketMetrics(ctx context.Context, ticketID int, categories []models.RatingCategory) (*TicketMetrics, error) {
+	if len(categories) == 0 {
+		allCategories, err := s.categoryRepo.GetAll(ctx)
+		if err != nil {
+			return nil, logError(ctx, fmt.Errorf("failed to get categories: %w", err))
+		}
+		categories = allCategories
+	}
+
+	ratings, err := s.ratingsRepo.GetByTicketID(ctx, ticketID)
+	if err != nil {
+		return nil, logError(ctx, fmt.Errorf("failed to get ratings: %w", err))
+	}
+
+	categoryIDs := make(map[int]bool, len(categories))
+	for _, category := range categories {
+		categoryIDs[category.ID] = true
+	}
+
+	var categoryRatings []models.Rating
+	for _, rating := range ratings {
+		if categoryIDs[rating.RatingCategoryID] {
+			categoryRatings = append(categoryRatings, rating)
+		}
+	}
+
+	metrics := &TicketMetrics{
+		TicketID:     ticketID,
+		TotalRatings: len(categoryRatings),
+	}
+	if len(categoryRatings) == 0 {
+		return metrics, nil
+	}
+
+	result, err := s.ticketScoreServ.CalculateScoreResult(categoryRatings, categories)
+	if err != nil {
+		return nil, fmt.Errorf("failed to calculate score for ticket %d: %w", ticketID, err)
+	}
+
+	metrics.WeightedSum = result.WeightedSum
+	metrics.MaxPossibleSum = result.MaxSum
+	metrics.ScoreFloat = result.Score
+
+	return metrics, nil
+}
+
+// GetTicketRatingStats gets the rating count and unweighted average rating of every ticket rated in a date range,
+// ordered by ticket ID
+func (s *TicketScoresService) GetTicketRatingStats(ctx context.Context, startDate, endDate time.Time) ([]TicketRatingStat, error) {
+	counts, err := s.ratingsRepo.GetRatingCountPerTicket(ctx, startDate, endDate)
+	if err != nil {
+		return nil, logError(ctx, fmt.Errorf("failed to get rating counts: %w", err))
+	}
+
+	averages, err := s.ratingsRepo.GetAverageRatingPerTicket(ctx, startDate, endDate)
+	if err != nil {
+		return nil, logError(ctx, fmt.Errorf("failed to get average ratings: %w", err))
+	}
+
+	stats := make([]TicketRatingStat, 0, len(counts))
+	for ticketID, count := range counts {
+		stats = append(stats, TicketRatingStat{
+			TicketID:      ticketID,
+			RatingCount:   count,
+			AverageRating: averages[ticketID],
+		})
+	}
+
+	sort.Slice(stats, func(i, j int) bool {
+		return stats[i].TicketID < stats[j].TicketID
+	})
+
+	return stats, nil
 }
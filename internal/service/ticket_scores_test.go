@@ -4,6 +4,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math"
+	"reflect"
+	"sort"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -23,6 +28,18 @@ func (m *mockScoreCalculator) CalculateScore(ratings []models.Rating, categories
 	return 0, nil
 }
 
+func (m *mockScoreCalculator) CalculateScoreResult(ratings []models.Rating, categories []models.RatingCategory) (*ScoreResult, error) {
+	score, err := m.CalculateScore(ratings, categories)
+	if err != nil {
+		return nil, err
+	}
+	return &ScoreResult{Score: score}, nil
+}
+
+func (m *mockScoreCalculator) MaxRating() int {
+	return defaultMaxRating
+}
+
 func TestGetTicketScores(t *testing.T) {
 	startDate := time.Date(2019, 10, 1, 0, 0, 0, 0, time.UTC)
 	endDate := time.Date(2019, 10, 3, 0, 0, 0, 0, time.UTC)
@@ -199,6 +216,236 @@ func TestGetTicketScores(t *testing.T) {
 	}
 }
 
+// collectTicketScores drains a GetTicketScores stream, returning the scores by ticket ID and the first error
+func collectTicketScores(resultChan <-chan TicketScore, errorChan <-chan error) (map[int]TicketScore, error) {
+	scores := make(map[int]TicketScore)
+	for ticketScore := range resultChan {
+		scores[ticketScore.TicketID] = ticketScore
+	}
+	return scores, <-errorChan
+}
+
+func TestGetTicketScores_MatchesPerCategoryQueries(t *testing.T) {
+	startDate := time.Date(2019, 10, 1, 0, 0, 0, 0, time.UTC)
+	endDate := startDate.AddDate(0, 0, 29)
+	categories := generateCategories(5)
+
+	ratingsRepo := &mocks.MockRatingsRepo{Ratings: generateDailyRatings(startDate, 30, 5)}
+	service := NewTicketScoresService(&mockCategoryRepo{categories: categories}, ratingsRepo, NewTicketScoreService())
+
+	scores, err := collectTicketScores(service.GetTicketScores(context.Background(), startDate, endDate))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	ticketIDs, err := ratingsRepo.GetDistinctTicketIDsByDateRange(context.Background(), startDate, endDate)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(scores) != len(ticketIDs) {
+		t.Fatalf("Expected %d tickets, got %d", len(ticketIDs), len(scores))
+	}
+
+	for _, ticketID := range ticketIDs {
+		expected, err := service.calculateTicketScore(context.Background(), ticketID, categories)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		sort.Slice(expected.Categories, func(i, j int) bool {
+			return expected.Categories[i].CategoryName < expected.Categories[j].CategoryName
+		})
+
+		if !reflect.DeepEqual(scores[ticketID], expected) {
+			t.Errorf("Ticket %d differs from per-category approach:\n got: %v\nwant: %v", ticketID, scores[ticketID], expected)
+		}
+	}
+
+	t.Run("repository error", func(t *testing.T) {
+		ratingsRepo := &bulkErrorRatingsRepo{MockRatingsRepo: ratingsRepo}
+		service := NewTicketScoresService(&mockCategoryRepo{categories: categories}, ratingsRepo, NewTicketScoreService())
+
+		if _, err := collectTicketScores(service.GetTicketScores(context.Background(), startDate, endDate)); err == nil {
+			t.Error("Expected error but got none")
+		}
+	})
+}
+
+// bulkErrorRatingsRepo fails only the bulk ratings query
+type bulkErrorRatingsRepo struct {
+	*mocks.MockRatingsRepo
+}
+
+func (r *bulkErrorRatingsRepo) GetRatingsByTicketIDsAndCategoryIDs(ctx context.Context, ticketIDs []int, categoryIDs []int) (map[int]map[int][]models.Rating, error) {
+	return nil, errors.New("database error")
+}
+
+func TestGetTicketScores_RuleViolations(t *testing.T) {
+	startDate := time.Date(2019, 10, 1, 0, 0, 0, 0, time.UTC)
+	createdAt := startDate.Add(time.Hour)
+	categories := []models.RatingCategory{
+		{ID: 1, Name: "Spelling", Weight: 1},
+		{ID: 2, Name: "Grammar", Weight: 1},
+	}
+	ratingsData := map[string][]models.Rating{
+		"1-2019-10-01": {
+			{ID: 1, TicketID: 1, RatingCategoryID: 1, Rating: 5, CreatedAt: createdAt},
+			{ID: 3, TicketID: 2, RatingCategoryID: 1, Rating: 5, CreatedAt: createdAt},
+		},
+		"2-2019-10-01": {
+			{ID: 2, TicketID: 1, RatingCategoryID: 2, Rating: 1, CreatedAt: createdAt},
+			{ID: 4, TicketID: 2, RatingCategoryID: 2, Rating: 4, CreatedAt: createdAt},
+		},
+	}
+
+	service := NewTicketScoresService(&mockCategoryRepo{categories: categories}, &mocks.MockRatingsRepo{Ratings: ratingsData}, NewTicketScoreService(),
+		WithRuleEngine(NewRuleEngine([]models.ScoringRule{
+			{ID: 1, IfCategoryID: 2, IfMaxRating: 1, ThenCategoryID: 1, ThenMaxRating: 3},
+		})),
+	)
+
+	scores, err := collectTicketScores(service.GetTicketScores(context.Background(), startDate, startDate))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expected := []string{"category 1 rated 5, above 3, while category 2 is rated 1"}
+	if !reflect.DeepEqual(scores[1].Violations, expected) {
+		t.Errorf("Expected violations %v for ticket 1, got %v", expected, scores[1].Violations)
+	}
+	if len(scores[2].Violations) != 0 {
+		t.Errorf("Expected no violations for ticket 2, got %v", scores[2].Violations)
+	}
+
+	ticketScore, err := service.calculateTicketScore(context.Background(), 1, categories)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(ticketScore.Violations, expected) {
+		t.Errorf("Expected violations %v from calculateTicketScore, got %v", expected, ticketScore.Violations)
+	}
+}
+
+func TestGetMultipleTicketScores(t *testing.T) {
+	startDate := time.Date(2019, 10, 1, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2019, 10, 2, 0, 0, 0, 0, time.UTC)
+	categories := []models.RatingCategory{
+		{ID: 1, Name: "Spelling", Weight: 1},
+		{ID: 2, Name: "Grammar", Weight: 1},
+	}
+	ratingsData := map[string][]models.Rating{
+		"1-2019-10-01": {
+			{ID: 1, TicketID: 1, RatingCategoryID: 1, Rating: 5, CreatedAt: startDate.Add(time.Hour)},
+			{ID: 2, TicketID: 3, RatingCategoryID: 1, Rating: 2, CreatedAt: startDate.Add(2 * time.Hour)},
+		},
+		"2-2019-10-02": {
+			{ID: 3, TicketID: 1, RatingCategoryID: 2, Rating: 4, CreatedAt: endDate.Add(23 * time.Hour)},
+		},
+		"2-2019-10-05": {
+			{ID: 4, TicketID: 5, RatingCategoryID: 2, Rating: 1, CreatedAt: endDate.AddDate(0, 0, 3)}, // after range
+		},
+	}
+
+	service := NewTicketScoresService(&mockCategoryRepo{categories: categories}, &mocks.MockRatingsRepo{Ratings: ratingsData}, NewTicketScoreService())
+
+	scores, err := service.GetMultipleTicketScores(context.Background(), []int{5, 1, 4, 3, 2}, startDate, endDate)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	unrated := []TicketCategoryScore{{CategoryName: "Spelling", Score: "N/A"}, {CategoryName: "Grammar", Score: "N/A"}}
+	expected := []TicketScore{
+		{TicketID: 5, Categories: unrated},
+		{TicketID: 1, Categories: []TicketCategoryScore{{CategoryName: "Spelling", Score: "100%"}, {CategoryName: "Grammar", Score: "80%"}}},
+		{TicketID: 4, Categories: unrated},
+		{TicketID: 3, Categories: []TicketCategoryScore{{CategoryName: "Spelling", Score: "40%"}, {CategoryName: "Grammar", Score: "N/A"}}},
+		{TicketID: 2, Categories: unrated},
+	}
+	if !reflect.DeepEqual(scores, expected) {
+		t.Errorf("Expected %+v, got %+v", expected, scores)
+	}
+
+	invalidTests := []struct {
+		name      string
+		ticketIDs []int
+	}{
+		{name: "zero ticket ID", ticketIDs: []int{1, 0}},
+		{name: "negative ticket ID", ticketIDs: []int{-3}},
+		{name: "too many tickets", ticketIDs: make([]int, MaxMultipleTicketScores+1)},
+	}
+	for _, tt := range invalidTests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := service.GetMultipleTicketScores(context.Background(), tt.ticketIDs, startDate, endDate); !errors.Is(err, ErrInvalidTicketID) {
+				t.Errorf("Expected ErrInvalidTicketID, got %v", err)
+			}
+		})
+	}
+}
+
+func TestGetCategoryScoreForTicketGroup(t *testing.T) {
+	day := time.Date(2019, 10, 1, 1, 0, 0, 0, time.UTC)
+	categories := []models.RatingCategory{
+		{ID: 1, Name: "Spelling", Weight: 1},
+		{ID: 2, Name: "Grammar", Weight: 1},
+	}
+	ratingsData := map[string][]models.Rating{
+		"all": {
+			{ID: 1, TicketID: 1, RatingCategoryID: 1, Rating: 5, CreatedAt: day}, // ticket 1: Spelling 100%, Grammar 20%
+			{ID: 2, TicketID: 1, RatingCategoryID: 2, Rating: 1, CreatedAt: day},
+			{ID: 3, TicketID: 2, RatingCategoryID: 1, Rating: 2, CreatedAt: day}, // ticket 2: Spelling 40%
+			{ID: 4, TicketID: 3, RatingCategoryID: 1, Rating: 3, CreatedAt: day}, // ticket 3: Spelling 60%, Grammar 100%
+			{ID: 5, TicketID: 3, RatingCategoryID: 2, Rating: 5, CreatedAt: day},
+			{ID: 6, TicketID: 4, RatingCategoryID: 1, Rating: 0, CreatedAt: day}, // not in the group
+		},
+	}
+
+	service := NewTicketScoresService(&mockCategoryRepo{categories: categories}, &mocks.MockRatingsRepo{Ratings: ratingsData}, NewTicketScoreService())
+
+	tests := []struct {
+		name       string
+		ticketIDs  []int
+		categories []models.RatingCategory
+		expected   []TicketCategoryScore
+	}{
+		{
+			name:      "all categories",
+			ticketIDs: []int{1, 2, 3},
+			expected:  []TicketCategoryScore{{CategoryName: "Spelling", Score: "67%"}, {CategoryName: "Grammar", Score: "60%"}},
+		},
+		{
+			name:       "given categories",
+			ticketIDs:  []int{1, 2, 3},
+			categories: categories[1:],
+			expected:   []TicketCategoryScore{{CategoryName: "Grammar", Score: "60%"}},
+		},
+		{
+			name:      "repeated ticket counted once",
+			ticketIDs: []int{1, 2, 3, 3},
+			expected:  []TicketCategoryScore{{CategoryName: "Spelling", Score: "67%"}, {CategoryName: "Grammar", Score: "60%"}},
+		},
+		{
+			name:      "unrated tickets",
+			ticketIDs: []int{7, 8},
+			expected:  []TicketCategoryScore{{CategoryName: "Spelling", Score: "N/A"}, {CategoryName: "Grammar", Score: "N/A"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scores, err := service.GetCategoryScoreForTicketGroup(context.Background(), tt.ticketIDs, tt.categories)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(scores, tt.expected) {
+				t.Errorf("Expected %+v, got %+v", tt.expected, scores)
+			}
+		})
+	}
+
+	if _, err := service.GetCategoryScoreForTicketGroup(context.Background(), make([]int, MaxTicketGroupSize+1), nil); !errors.Is(err, ErrInvalidTicketID) {
+		t.Errorf("Expected ErrInvalidTicketID for an oversized group, got %v", err)
+	}
+}
+
 func TestCalculateTicketScore(t *testing.T) {
 	categories := []models.RatingCategory{
 		{ID: 1, Name: "Spelling", Weight: 10},
@@ -413,3 +660,978 @@ func TestTicketScoresService_ConcurrentProcessing(t *testing.T) {
 		}
 	}
 }
+
+func TestGetTicketMetrics(t *testing.T) {
+	categories := []models.RatingCategory{
+		{ID: 1, Name: "Spelling", Weight: 2},
+		{ID: 2, Name: "Grammar", Weight: 1},
+	}
+	ratingsData := map[string][]models.Rating{
+		"1-2019-10-01": {
+			{ID: 1, TicketID: 1, RatingCategoryID: 1, Rating: 4},
+			{ID: 2, TicketID: 2, RatingCategoryID: 1, Rating: 1},
+		},
+		"2-2019-10-01": {
+			{ID: 3, TicketID: 1, RatingCategoryID: 2, Rating: 3},
+		},
+	}
+
+	tests := []struct {
+		name                 string
+		ticketID             int
+		categories           []models.RatingCategory
+		ratingsErr           error
+		expectedTotalRatings int
+		expectedWeightedSum  float64
+		expectedMaxSum       float64
+		expectError          bool
+	}{
+		{
+			name:                 "all categories",
+			ticketID:             1,
+			expectedTotalRatings: 2,
+			expectedWeightedSum:  11, // 4*2 + 3*1
+			expectedMaxSum:       15, // 5*2 + 5*1
+		},
+		{
+			name:                 "category subset",
+			ticketID:             1,
+			categories:           categories[1:],
+			expectedTotalRatings: 1,
+			expectedWeightedSum:  3,
+			expectedMaxSum:       5,
+		},
+		{
+			name:                 "ticket without ratings",
+			ticketID:             99,
+			expectedTotalRatings: 0,
+		},
+		{
+			name:        "repository error",
+			ticketID:    1,
+			ratingsErr:  errors.New("database error"),
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRatingsRepo := &mocks.MockRatingsRepo{Ratings: ratingsData, Err: tt.ratingsErr}
+			service := NewTicketScoresService(&mockCategoryRepo{categories: categories}, mockRatingsRepo, NewTicketScoreService())
+
+			metrics, err := service.GetTicketMetrics(context.Background(), tt.ticketID, tt.categories)
+			if tt.expectError {
+				if err == nil {
+					t.Error("Expected error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if metrics.TicketID != tt.ticketID {
+				t.Errorf("Expected ticket ID %d, got %d", tt.ticketID, metrics.TicketID)
+			}
+			if metrics.TotalRatings != tt.expectedTotalRatings {
+				t.Errorf("Expected %d ratings, got %d", tt.expectedTotalRatings, metrics.TotalRatings)
+			}
+			if metrics.WeightedSum != tt.expectedWeightedSum {
+				t.Errorf("Expected weighted sum %f, got %f", tt.expectedWeightedSum, metrics.WeightedSum)
+			}
+			if metrics.MaxPossibleSum != tt.expectedMaxSum {
+				t.Errorf("Expected max possible sum %f, got %f", tt.expectedMaxSum, metrics.MaxPossibleSum)
+			}
+			if metrics.MaxPossibleSum > 0 && metrics.WeightedSum/metrics.MaxPossibleSum*100 != metrics.ScoreFloat {
+				t.Errorf("Expected score %f to equal weighted sum / max possible sum * 100", metrics.ScoreFloat)
+			}
+		})
+	}
+}
+
+func TestCompareTwoTickets(t *testing.T) {
+	categories := []models.RatingCategory{
+		{ID: 1, Name: "Spelling", Weight: 1},
+		{ID: 2, Name: "Grammar", Weight: 1},
+	}
+	ratingsData := map[string][]models.Rating{
+		"1-2019-10-01": {
+			{ID: 1, TicketID: 1, RatingCategoryID: 1, Rating: 3},
+			{ID: 2, TicketID: 2, RatingCategoryID: 1, Rating: 4},
+			{ID: 3, TicketID: 3, RatingCategoryID: 1, Rating: 5},
+			{ID: 4, TicketID: 4, RatingCategoryID: 1, Rating: 5},
+		},
+		"2-2019-10-01": {
+			{ID: 5, TicketID: 1, RatingCategoryID: 2, Rating: 2},
+			{ID: 6, TicketID: 3, RatingCategoryID: 2, Rating: 5},
+			{ID: 7, TicketID: 4, RatingCategoryID: 2, Rating: 5},
+		},
+	}
+
+	tests := []struct {
+		name      string
+		ticketID1 int
+		ticketID2 int
+		expected  []TicketCategoryComparison
+	}{
+		{
+			name:      "same ticket",
+			ticketID1: 1,
+			ticketID2: 1,
+			expected: []TicketCategoryComparison{
+				{CategoryName: "Spelling", Score1: "60%", Score2: "60%", Difference: "0%"},
+				{CategoryName: "Grammar", Score1: "40%", Score2: "40%", Difference: "0%"},
+			},
+		},
+		{
+			name:      "ticket with an unrated category",
+			ticketID1: 1,
+			ticketID2: 2,
+			expected: []TicketCategoryComparison{
+				{CategoryName: "Spelling", Score1: "60%", Score2: "80%", Difference: "+20%"},
+				{CategoryName: "Grammar", Score1: "40%", Score2: "N/A", Difference: "N/A"},
+			},
+		},
+		{
+			name:      "lower second ticket",
+			ticketID1: 3,
+			ticketID2: 1,
+			expected: []TicketCategoryComparison{
+				{CategoryName: "Spelling", Score1: "100%", Score2: "60%", Difference: "-40%"},
+				{CategoryName: "Grammar", Score1: "100%", Score2: "40%", Difference: "-60%"},
+			},
+		},
+		{
+			name:      "both tickets perfect",
+			ticketID1: 3,
+			ticketID2: 4,
+			expected: []TicketCategoryComparison{
+				{CategoryName: "Spelling", Score1: "100%", Score2: "100%", Difference: "0%"},
+				{CategoryName: "Grammar", Score1: "100%", Score2: "100%", Difference: "0%"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRatingsRepo := &mocks.MockRatingsRepo{Ratings: ratingsData}
+			service := NewTicketScoresService(&mockCategoryRepo{categories: categories}, mockRatingsRepo, NewTicketScoreService())
+
+			comparison, err := service.CompareTwoTickets(context.Background(), tt.ticketID1, tt.ticketID2)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if comparison.Ticket1 != tt.ticketID1 || comparison.Ticket2 != tt.ticketID2 {
+				t.Errorf("Expected tickets %d and %d, got %d and %d", tt.ticketID1, tt.ticketID2, comparison.Ticket1, comparison.Ticket2)
+			}
+			if len(comparison.CategoryComparisons) != len(tt.expected) {
+				t.Fatalf("Expected %d categories, got %d", len(tt.expected), len(comparison.CategoryComparisons))
+			}
+			for i, expected := range tt.expected {
+				if comparison.CategoryComparisons[i] != expected {
+					t.Errorf("Expected %+v, got %+v", expected, comparison.CategoryComparisons[i])
+				}
+			}
+		})
+	}
+
+	t.Run("repository error", func(t *testing.T) {
+		mockRatingsRepo := &mocks.MockRatingsRepo{Err: errors.New("database error")}
+		service := NewTicketScoresService(&mockCategoryRepo{categories: categories}, mockRatingsRepo, NewTicketScoreService())
+
+		if _, err := service.CompareTwoTickets(context.Background(), 1, 2); err == nil {
+			t.Error("Expected error but got none")
+		}
+	})
+}
+
+func TestGetTicketRatingTimeline(t *testing.T) {
+	start := time.Date(2019, 10, 1, 0, 0, 0, 0, time.UTC)
+	categories := []models.RatingCategory{
+		{ID: 1, Name: "Spelling", Weight: 1},
+		{ID: 2, Name: "Grammar", Weight: 1},
+	}
+
+	t.Run("perfect ratings never lower the running score", func(t *testing.T) {
+		ratingsData := map[string][]models.Rating{
+			"1-2019-10-01": {
+				{ID: 1, TicketID: 1, ReviewerID: 10, RatingCategoryID: 1, Rating: 5, CreatedAt: start.Add(3 * time.Hour)},
+				{ID: 2, TicketID: 1, ReviewerID: 11, RatingCategoryID: 1, Rating: 5, CreatedAt: start.Add(1 * time.Hour)},
+			},
+			"2-2019-10-01": {
+				{ID: 3, TicketID: 1, ReviewerID: 10, RatingCategoryID: 2, Rating: 5, CreatedAt: start.Add(2 * time.Hour)},
+				{ID: 4, TicketID: 2, ReviewerID: 10, RatingCategoryID: 2, Rating: 1, CreatedAt: start.Add(2 * time.Hour)},
+			},
+		}
+		service := NewTicketScoresService(&mockCategoryRepo{categories: categories}, &mocks.MockRatingsRepo{Ratings: ratingsData}, NewTicketScoreService())
+
+		timeline, err := service.GetTicketRatingTimeline(context.Background(), 1)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if len(timeline.Events) != 3 {
+			t.Fatalf("Expected 3 events, got %d", len(timeline.Events))
+		}
+
+		previous := 0.0
+		for i, event := range timeline.Events {
+			if i > 0 && event.Timestamp.Before(timeline.Events[i-1].Timestamp) {
+				t.Errorf("Event %d at %v is before event %d at %v", i, event.Timestamp, i-1, timeline.Events[i-1].Timestamp)
+			}
+			score, ok := parseScore(event.RunningScore)
+			if !ok {
+				t.Fatalf("Event %d has unparseable running score %q", i, event.RunningScore)
+			}
+			if score < previous {
+				t.Errorf("Running score decreased from %v to %v at event %d", previous, score, i)
+			}
+			previous = score
+		}
+	})
+
+	t.Run("running score covers all earlier ratings", func(t *testing.T) {
+		ratingsData := map[string][]models.Rating{
+			"1-2019-10-01": {
+				{ID: 1, TicketID: 1, ReviewerID: 10, RatingCategoryID: 1, Rating: 5, CreatedAt: start.Add(2 * time.Hour)},
+				{ID: 2, TicketID: 1, ReviewerID: 11, RatingCategoryID: 1, Rating: 1, CreatedAt: start.Add(3 * time.Hour)},
+			},
+			"2-2019-10-01": {
+				{ID: 3, TicketID: 1, ReviewerID: 12, RatingCategoryID: 2, Rating: 3, CreatedAt: start.Add(1 * time.Hour)},
+			},
+			"9-2019-10-01": {
+				{ID: 4, TicketID: 1, ReviewerID: 13, RatingCategoryID: 9, Rating: 0, CreatedAt: start.Add(4 * time.Hour)},
+			},
+		}
+		service := NewTicketScoresService(&mockCategoryRepo{categories: categories}, &mocks.MockRatingsRepo{Ratings: ratingsData}, NewTicketScoreService())
+
+		timeline, err := service.GetTicketRatingTimeline(context.Background(), 1)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		expected := []RatingEvent{
+			{Timestamp: start.Add(1 * time.Hour), ReviewerID: 12, CategoryName: "Grammar", Rating: 3, RunningScore: "60%"},
+			{Timestamp: start.Add(2 * time.Hour), ReviewerID: 10, CategoryName: "Spelling", Rating: 5, RunningScore: "80%"},
+			{Timestamp: start.Add(3 * time.Hour), ReviewerID: 11, CategoryName: "Spelling", Rating: 1, RunningScore: "60%"},
+			{Timestamp: start.Add(4 * time.Hour), ReviewerID: 13, CategoryName: "", Rating: 0, RunningScore: "60%"},
+		}
+		if timeline.TicketID != 1 {
+			t.Errorf("Expected ticket 1, got %d", timeline.TicketID)
+		}
+		if len(timeline.Events) != len(expected) {
+			t.Fatalf("Expected %d events, got %d", len(expected), len(timeline.Events))
+		}
+		for i, event := range expected {
+			if timeline.Events[i] != event {
+				t.Errorf("Event %d: expected %+v, got %+v", i, event, timeline.Events[i])
+			}
+		}
+	})
+
+	t.Run("ticket without ratings", func(t *testing.T) {
+		service := NewTicketScoresService(&mockCategoryRepo{categories: categories}, &mocks.MockRatingsRepo{}, NewTicketScoreService())
+
+		timeline, err := service.GetTicketRatingTimeline(context.Background(), 1)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if len(timeline.Events) != 0 {
+			t.Errorf("Expected no events, got %d", len(timeline.Events))
+		}
+	})
+
+	t.Run("repository error", func(t *testing.T) {
+		service := NewTicketScoresService(&mockCategoryRepo{categories: categories}, &mocks.MockRatingsRepo{Err: errors.New("database error")}, NewTicketScoreService())
+
+		if _, err := service.GetTicketRatingTimeline(context.Background(), 1); err == nil {
+			t.Error("Expected error but got none")
+		}
+	})
+}
+
+func TestGetScoreForTicketAtTime(t *testing.T) {
+	start := time.Date(2019, 10, 1, 0, 0, 0, 0, time.UTC)
+	categories := []models.RatingCategory{
+		{ID: 1, Name: "Spelling", Weight: 1},
+		{ID: 2, Name: "Grammar", Weight: 1},
+	}
+	ratingsData := map[string][]models.Rating{
+		"1-2019-10-01": {
+			{ID: 1, TicketID: 1, RatingCategoryID: 1, Rating: 5, CreatedAt: start.Add(1 * time.Hour)},
+			{ID: 2, TicketID: 1, RatingCategoryID: 1, Rating: 1, CreatedAt: start.Add(26 * time.Hour)},
+		},
+		"2-2019-10-01": {
+			{ID: 3, TicketID: 1, RatingCategoryID: 2, Rating: 3, CreatedAt: start.Add(2 * time.Hour)},
+			{ID: 4, TicketID: 1, RatingCategoryID: 2, Rating: 1, CreatedAt: start.Add(27 * time.Hour)},
+			{ID: 5, TicketID: 2, RatingCategoryID: 2, Rating: 0, CreatedAt: start.Add(2 * time.Hour)},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		asOf     time.Time
+		expected string
+	}{
+		{name: "before any rating", asOf: start, expected: "N/A"},
+		{name: "two of four ratings", asOf: start.Add(2 * time.Hour), expected: "80%"}, // (5 + 3) / 10
+		{name: "all four ratings", asOf: start.Add(48 * time.Hour), expected: "50%"},   // (5 + 1 + 3 + 1) / 20
+		{name: "cutoff at a rating includes it", asOf: start.Add(1 * time.Hour), expected: "100%"},
+	}
+
+	service := NewTicketScoresService(&mockCategoryRepo{categories: categories}, &mocks.MockRatingsRepo{Ratings: ratingsData}, NewTicketScoreService())
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			score, err := service.GetScoreForTicketAtTime(context.Background(), 1, tt.asOf)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if score != tt.expected {
+				t.Errorf("Expected score %s, got %s", tt.expected, score)
+			}
+		})
+	}
+
+	t.Run("historical score differs from current score", func(t *testing.T) {
+		historical, err := service.GetScoreForTicketAtTime(context.Background(), 1, start.Add(2*time.Hour))
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		current, err := service.GetScoreForTicketAtTime(context.Background(), 1, time.Now())
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if historical == current {
+			t.Errorf("Expected the score with 2 of 4 ratings to differ from the current score, both are %s", current)
+		}
+	})
+
+	t.Run("repository error", func(t *testing.T) {
+		service := NewTicketScoresService(&mockCategoryRepo{categories: categories}, &mocks.MockRatingsRepo{Err: errors.New("database error")}, NewTicketScoreService())
+
+		if _, err := service.GetScoreForTicketAtTime(context.Background(), 1, start); err == nil {
+			t.Error("Expected error but got none")
+		}
+	})
+}
+
+func TestGetScoreExplanation(t *testing.T) {
+	createdAt := time.Date(2019, 10, 1, 10, 0, 0, 0, time.UTC)
+	categories := []models.RatingCategory{
+		{ID: 1, Name: "Spelling", Weight: 1},
+		{ID: 2, Name: "Grammar", Weight: 0.7},
+	}
+	ratingsData := map[string][]models.Rating{
+		"1-2019-10-01": {
+			{ID: 1, TicketID: 1, RatingCategoryID: 1, Rating: 4, CreatedAt: createdAt},
+			{ID: 3, TicketID: 1, RatingCategoryID: 1, Rating: 2, CreatedAt: createdAt.Add(2 * time.Hour)},
+		},
+		"2-2019-10-01": {
+			{ID: 2, TicketID: 1, RatingCategoryID: 2, Rating: 5, CreatedAt: createdAt.Add(time.Hour)},
+		},
+		"9-2019-10-01": {
+			{ID: 4, TicketID: 1, RatingCategoryID: 9, Rating: 0, CreatedAt: createdAt}, // unknown category
+		},
+	}
+
+	service := NewTicketScoresService(&mockCategoryRepo{categories: categories}, &mocks.MockRatingsRepo{Ratings: ratingsData}, NewTicketScoreService())
+
+	explanation, err := service.GetScoreExplanation(context.Background(), 1)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expectedSteps := []ExplanationStep{
+		{CategoryName: "Spelling", Rating: 4, Weight: 1, Contribution: 4, MaxContribution: 5},
+		{CategoryName: "Grammar", Rating: 5, Weight: 0.7, Contribution: 3.5, MaxContribution: 3.5},
+		{CategoryName: "Spelling", Rating: 2, Weight: 1, Contribution: 2, MaxContribution: 5},
+	}
+	if !reflect.DeepEqual(explanation.Steps, expectedSteps) {
+		t.Errorf("Expected steps %+v, got %+v", expectedSteps, explanation.Steps)
+	}
+
+	var contributions, maxContributions float64
+	for _, step := range explanation.Steps {
+		contributions += step.Contribution
+		maxContributions += step.MaxContribution
+	}
+	if math.Abs(contributions-explanation.TotalWeightedSum) > 1e-9 {
+		t.Errorf("Expected contributions to sum to %f, got %f", explanation.TotalWeightedSum, contributions)
+	}
+	if math.Abs(maxContributions-explanation.TotalMaxSum) > 1e-9 {
+		t.Errorf("Expected maximum contributions to sum to %f, got %f", explanation.TotalMaxSum, maxContributions)
+	}
+	if explanation.FinalScore != "70%" { // 9.5 / 13.5
+		t.Errorf("Expected final score 70%%, got %s", explanation.FinalScore)
+	}
+
+	t.Run("ticket without ratings", func(t *testing.T) {
+		explanation, err := service.GetScoreExplanation(context.Background(), 99)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if explanation.FinalScore != "N/A" || len(explanation.Steps) != 0 {
+			t.Errorf("Expected an N/A score without steps, got %+v", explanation)
+		}
+	})
+
+	t.Run("repository error", func(t *testing.T) {
+		service := NewTicketScoresService(&mockCategoryRepo{categories: categories}, &mocks.MockRatingsRepo{Err: errors.New("database error")}, NewTicketScoreService())
+
+		if _, err := service.GetScoreExplanation(context.Background(), 1); err == nil {
+			t.Error("Expected error but got none")
+		}
+	})
+}
+
+func TestGetScorecardTimeSeries(t *testing.T) {
+	startDate := time.Date(2019, 10, 1, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2019, 10, 3, 0, 0, 0, 0, time.UTC)
+	categories := []models.RatingCategory{
+		{ID: 1, Name: "Spelling", Weight: 1},
+		{ID: 2, Name: "Grammar", Weight: 1},
+	}
+	ratingsData := map[string][]models.Rating{
+		"1-2019-10-01": {
+			{ID: 1, TicketID: 1, RatingCategoryID: 1, Rating: 5, CreatedAt: startDate.Add(1 * time.Hour)},
+		},
+		"2-2019-10-01": {
+			{ID: 2, TicketID: 1, RatingCategoryID: 2, Rating: 3, CreatedAt: startDate.Add(2 * time.Hour)},
+			{ID: 3, TicketID: 2, RatingCategoryID: 2, Rating: 1, CreatedAt: startDate.Add(2 * time.Hour)},
+		},
+		"1-2019-10-03": {
+			{ID: 4, TicketID: 1, RatingCategoryID: 1, Rating: 2, CreatedAt: startDate.Add(50 * time.Hour)},
+		},
+		"1-2019-10-04": {
+			{ID: 5, TicketID: 1, RatingCategoryID: 1, Rating: 0, CreatedAt: startDate.Add(73 * time.Hour)}, // after range
+		},
+	}
+
+	service := NewTicketScoresService(&mockCategoryRepo{categories: categories}, &mocks.MockRatingsRepo{Ratings: ratingsData}, NewTicketScoreService())
+
+	series, err := service.GetScorecardTimeSeries(context.Background(), 1, startDate, endDate)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expected := []DayScorecard{
+		{
+			Date: "2019-10-01",
+			Categories: []TicketCategoryScore{
+				{CategoryName: "Spelling", Score: "100%"},
+				{CategoryName: "Grammar", Score: "60%"},
+			},
+			OverallScore: "80%",
+		},
+		{Date: "2019-10-02", Categories: []TicketCategoryScore{}, OverallScore: "N/A"},
+		{
+			Date: "2019-10-03",
+			Categories: []TicketCategoryScore{
+				{CategoryName: "Spelling", Score: "40%"},
+				{CategoryName: "Grammar", Score: "N/A"},
+			},
+			OverallScore: "40%",
+		},
+	}
+
+	if series.TicketID != 1 {
+		t.Errorf("Expected ticket 1, got %d", series.TicketID)
+	}
+	if !reflect.DeepEqual(series.Days, expected) {
+		t.Errorf("Expected %+v, got %+v", expected, series.Days)
+	}
+
+	t.Run("repository error", func(t *testing.T) {
+		service := NewTicketScoresService(&mockCategoryRepo{categories: categories}, &mocks.MockRatingsRepo{Err: errors.New("database error")}, NewTicketScoreService())
+
+		if _, err := service.GetScorecardTimeSeries(context.Background(), 1, startDate, endDate); err == nil {
+			t.Error("Expected error but got none")
+		}
+	})
+}
+
+func TestGetTicketRatingStats(t *testing.T) {
+	startDate := time.Date(2019, 10, 1, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2019, 10, 3, 0, 0, 0, 0, time.UTC)
+	createdAt := startDate.Add(1 * time.Hour)
+
+	ratingsData := map[string][]models.Rating{
+		"1-2019-10-01": {
+			{ID: 1, TicketID: 2, RatingCategoryID: 1, Rating: 4, CreatedAt: createdAt},
+			{ID: 2, TicketID: 1, RatingCategoryID: 1, Rating: 5, CreatedAt: createdAt},
+			{ID: 3, TicketID: 1, RatingCategoryID: 1, Rating: 2, CreatedAt: createdAt},
+		},
+	}
+
+	service := NewTicketScoresService(&mockCategoryRepo{}, &mocks.MockRatingsRepo{Ratings: ratingsData}, &mockScoreCalculator{})
+
+	stats, err := service.GetTicketRatingStats(context.Background(), startDate, endDate)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expected := []TicketRatingStat{
+		{TicketID: 1, RatingCount: 2, AverageRating: 3.5},
+		{TicketID: 2, RatingCount: 1, AverageRating: 4},
+	}
+	if len(stats) != len(expected) {
+		t.Fatalf("Expected %d stats, got %d", len(expected), len(stats))
+	}
+	for i, stat := range stats {
+		if stat != expected[i] {
+			t.Errorf("Expected %+v at position %d, got %+v", expected[i], i, stat)
+		}
+	}
+
+	errorService := NewTicketScoresService(&mockCategoryRepo{}, &mocks.MockRatingsRepo{CountErr: errors.New("database error")}, &mockScoreCalculator{})
+	if _, err := errorService.GetTicketRatingStats(context.Background(), startDate, endDate); err == nil {
+		t.Error("Expected error but got none")
+	}
+}
+
+func TestGetTicketScoresGroupedByReviewer(t *testing.T) {
+	startDate := time.Date(2019, 10, 1, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2019, 10, 3, 0, 0, 0, 0, time.UTC)
+	createdAt := startDate.Add(1 * time.Hour)
+
+	categories := []models.RatingCategory{{ID: 1, Name: "Spelling", Weight: 1}}
+
+	tests := []struct {
+		name            string
+		ratingsData     map[string][]models.Rating
+		ratingsRepoErr  error
+		categoryRepoErr error
+		expected        map[int][]int // reviewer ID -> ticket IDs
+		expectedError   bool
+	}{
+		{
+			name: "one message per reviewer",
+			ratingsData: map[string][]models.Rating{
+				"1-2019-10-01": {
+					{ID: 1, TicketID: 1, RatingCategoryID: 1, ReviewerID: 10, Rating: 4, CreatedAt: createdAt},
+					{ID: 2, TicketID: 2, RatingCategoryID: 1, ReviewerID: 10, Rating: 5, CreatedAt: createdAt},
+					{ID: 3, TicketID: 2, RatingCategoryID: 1, ReviewerID: 20, Rating: 3, CreatedAt: createdAt},
+					{ID: 4, TicketID: 3, RatingCategoryID: 1, ReviewerID: 30, Rating: 2, CreatedAt: createdAt},
+					{ID: 5, TicketID: 4, RatingCategoryID: 1, ReviewerID: 30, Rating: 2, CreatedAt: endDate.AddDate(0, 0, 1)}, // after range
+				},
+			},
+			expected: map[int][]int{10: {1, 2}, 20: {2}, 30: {3}},
+		},
+		{
+			name:        "no reviewers found",
+			ratingsData: map[string][]models.Rating{},
+			expected:    map[int][]int{},
+		},
+		{
+			name:           "error getting reviewer IDs",
+			ratingsRepoErr: errors.New("database error"),
+			expectedError:  true,
+		},
+		{
+			name:            "error getting categories",
+			categoryRepoErr: errors.New("category fetch error"),
+			expectedError:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := NewTicketScoresService(
+				&mockCategoryRepo{categories: categories, err: tt.categoryRepoErr},
+				&mocks.MockRatingsRepo{Ratings: tt.ratingsData, Err: tt.ratingsRepoErr},
+				&mockScoreCalculator{calculateFunc: func(ratings []models.Rating, categories []models.RatingCategory) (float64, error) {
+					return 100, nil
+				}},
+			)
+
+			resultChan, errorChan := service.GetTicketScoresGroupedByReviewer(context.Background(), startDate, endDate)
+
+			results := make(map[int][]int)
+			messages := 0
+			for result := range resultChan {
+				messages++
+				var ticketIDs []int
+				for _, score := range result.Scores {
+					ticketIDs = append(ticketIDs, score.TicketID)
+				}
+				results[result.ReviewerID] = ticketIDs
+			}
+			err := <-errorChan
+
+			if tt.expectedError {
+				if err == nil {
+					t.Error("Expected error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if messages != len(tt.expected) {
+				t.Fatalf("Expected %d messages, got %d", len(tt.expected), messages)
+			}
+			for reviewerID, expectedTicketIDs := range tt.expected {
+				ticketIDs, ok := results[reviewerID]
+				if !ok {
+					t.Errorf("Expected a message for reviewer %d", reviewerID)
+					continue
+				}
+				if len(ticketIDs) != len(expectedTicketIDs) {
+					t.Errorf("Expected tickets %v for reviewer %d, got %v", expectedTicketIDs, reviewerID, ticketIDs)
+					continue
+				}
+				for i := range expectedTicketIDs {
+					if ticketIDs[i] != expectedTicketIDs[i] {
+						t.Errorf("Expected tickets %v for reviewer %d, got %v", expectedTicketIDs, reviewerID, ticketIDs)
+						break
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestGetTicketScoresGroupedByReviewer_ScoresOnlyTheReviewersRatings(t *testing.T) {
+	startDate := time.Date(2019, 10, 1, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2019, 10, 3, 0, 0, 0, 0, time.UTC)
+	createdAt := startDate.Add(1 * time.Hour)
+
+	service := NewTicketScoresService(
+		&mockCategoryRepo{categories: []models.RatingCategory{{ID: 1, Name: "Spelling", Weight: 1}}},
+		&mocks.MockRatingsRepo{Ratings: map[string][]models.Rating{
+			"1-2019-10-01": {
+				{ID: 1, TicketID: 1, RatingCategoryID: 1, ReviewerID: 10, Rating: 5, CreatedAt: createdAt},
+				{ID: 2, TicketID: 1, RatingCategoryID: 1, ReviewerID: 20, Rating: 1, CreatedAt: createdAt},
+				{ID: 3, TicketID: 1, RatingCategoryID: 1, ReviewerID: 10, Rating: 0, CreatedAt: endDate.AddDate(0, 0, 1)}, // after range
+			},
+		}},
+		NewTicketScoreService(),
+	)
+
+	resultChan, errorChan := service.GetTicketScoresGroupedByReviewer(context.Background(), startDate, endDate)
+
+	scores := make(map[int]string)
+	for result := range resultChan {
+		if len(result.Scores) != 1 || len(result.Scores[0].Categories) != 1 {
+			t.Fatalf("Expected one ticket with one category for reviewer %d, got %+v", result.ReviewerID, result.Scores)
+		}
+		scores[result.ReviewerID] = result.Scores[0].Categories[0].Score
+	}
+	if err := <-errorChan; err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expected := map[int]string{10: "100%", 20: "20%"}
+	for reviewerID, score := range expected {
+		if scores[reviewerID] != score {
+			t.Errorf("Expected reviewer %d to score ticket 1 at %s, got %q", reviewerID, score, scores[reviewerID])
+		}
+	}
+}
+
+// concurrencyTrackingRepo records the peak number of concurrent per-category rating queries
+type concurrencyTrackingRepo struct {
+	*mocks.MockRatingsRepo
+	delay   time.Duration
+	current atomic.Int32
+	peak    atomic.Int32
+}
+
+func (r *concurrencyTrackingRepo) GetByTicketIDAndCategoryID(ctx context.Context, ticketID, categoryID int) ([]models.Rating, error) {
+	current := r.current.Add(1)
+	defer r.current.Add(-1)
+
+	for {
+		peak := r.peak.Load()
+		if current <= peak || r.peak.CompareAndSwap(peak, current) {
+			break
+		}
+	}
+
+	time.Sleep(r.delay)
+	return r.MockRatingsRepo.GetByTicketIDAndCategoryID(ctx, ticketID, categoryID)
+}
+
+// generateCategories creates count categories with IDs starting at 1
+func generateCategories(count int) []models.RatingCategory {
+	categories := make([]models.RatingCategory, count)
+	for i := range categories {
+		categories[i] = models.RatingCategory{ID: i + 1, Name: fmt.Sprintf("Category %d", i+1), Weight: 1}
+	}
+	return categories
+}
+
+func TestCalculateTicketScore_CategoryConcurrencyLimit(t *testing.T) {
+	categories := generateCategories(20)
+
+	ratingsData := make(map[string][]models.Rating)
+	for _, category := range categories {
+		key := fmt.Sprintf("%d-2019-10-01", category.ID)
+		ratingsData[key] = []models.Rating{{ID: category.ID, TicketID: 1, RatingCategoryID: category.ID, Rating: 4}}
+	}
+
+	repo := &concurrencyTrackingRepo{MockRatingsRepo: &mocks.MockRatingsRepo{Ratings: ratingsData}, delay: time.Millisecond}
+	service := NewTicketScoresService(&mockCategoryRepo{categories: categories}, repo, NewTicketScoreService(), WithMaxCategoryConcurrency(2))
+
+	ticketScore, err := service.calculateTicketScore(context.Background(), 1, categories)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if len(ticketScore.Categories) != len(categories) {
+		t.Errorf("Expected %d category scores, got %d", len(categories), len(ticketScore.Categories))
+	}
+	for _, category := range ticketScore.Categories {
+		if category.Score != "80%" {
+			t.Errorf("Expected score 80%% for %s, got %s", category.CategoryName, category.Score)
+		}
+	}
+
+	if peak := repo.peak.Load(); peak > 2 {
+		t.Errorf("Expected at most 2 concurrent category queries, got %d", peak)
+	}
+}
+
+func TestCalculateTicketScore_CanceledContext(t *testing.T) {
+	categories := generateCategories(5)
+	service := NewTicketScoresService(&mockCategoryRepo{categories: categories}, &mocks.MockRatingsRepo{}, NewTicketScoreService(), WithMaxCategoryConcurrency(1))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if _, err := service.calculateTicketScore(ctx, 1, categories); !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected context.Canceled, got %v", err)
+	}
+}
+
+// blockingRatingsRepo holds every per-category query until release is closed
+type blockingRatingsRepo struct {
+	*mocks.MockRatingsRepo
+	started chan struct{}
+	release chan struct{}
+	done    sync.WaitGroup
+}
+
+func (r *blockingRatingsRepo) GetByTicketIDAndCategoryID(ctx context.Context, ticketID, categoryID int) ([]models.Rating, error) {
+	r.done.Add(1)
+	defer r.done.Done()
+
+	r.started <- struct{}{}
+	<-r.release
+	return r.MockRatingsRepo.GetByTicketIDAndCategoryID(ctx, ticketID, categoryID)
+}
+
+func TestCalculateTicketScore_CanceledDuringQueries(t *testing.T) {
+	categories := generateCategories(5)
+	repo := &blockingRatingsRepo{
+		MockRatingsRepo: &mocks.MockRatingsRepo{},
+		started:         make(chan struct{}, len(categories)),
+		release:         make(chan struct{}),
+	}
+	service := NewTicketScoresService(&mockCategoryRepo{categories: categories}, repo, NewTicketScoreService(), WithMaxCategoryConcurrency(len(categories)))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	errChan := make(chan error, 1)
+	go func() {
+		_, err := service.calculateTicketScore(ctx, 1, categories)
+		errChan <- err
+	}()
+
+	for range categories {
+		<-repo.started
+	}
+	cancel()
+
+	// Returns while the queries are still running
+	select {
+	case err := <-errChan:
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("Expected context.Canceled, got %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("calculateTicketScore did not return after cancellation")
+	}
+
+	close(repo.release)
+	repo.done.Wait()
+}
+
+func BenchmarkCalculateTicketScore_CategoryConcurrency(b *testing.B) {
+	categories := generateCategories(50)
+
+	for _, concurrency := range []int{1, 5, 50} {
+		b.Run(fmt.Sprintf("concurrency=%d", concurrency), func(b *testing.B) {
+			// Simulates the latency of a database round trip per category
+			repo := &concurrencyTrackingRepo{MockRatingsRepo: &mocks.MockRatingsRepo{}, delay: 100 * time.Microsecond}
+			service := NewTicketScoresService(&mockCategoryRepo{categories: categories}, repo, NewTicketScoreService(), WithMaxCategoryConcurrency(concurrency))
+
+			for i := 0; i < b.N; i++ {
+				if _, err := service.calculateTicketScore(context.Background(), 1, categories); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkGetTicketScores(b *testing.B) {
+	startDate := time.Date(2019, 10, 1, 0, 0, 0, 0, time.UTC)
+	categories := generateCategories(5)
+
+	// 500 tickets, each rated once in every category
+	ratings := make(map[string][]models.Rating)
+	for ticketID := 1; ticketID <= 500; ticketID++ {
+		for _, category := range categories {
+			key := fmt.Sprintf("%d-2019-10-01", category.ID)
+			ratings[key] = append(ratings[key], models.Rating{
+				ID:               (ticketID-1)*len(categories) + category.ID,
+				Rating:           (ticketID + category.ID) % 6,
+				TicketID:         ticketID,
+				RatingCategoryID: category.ID,
+				CreatedAt:        startDate.Add(time.Hour),
+			})
+		}
+	}
+
+	ratingsRepo := newBenchmarkRatingsRepo(b, ratings)
+	service := NewTicketScoresService(&mockCategoryRepo{categories: categories}, ratingsRepo, NewTicketScoreService())
+
+	b.Run("per-category", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			for ticketID := 1; ticketID <= 500; ticketID++ {
+				if _, err := service.calculateTicketScore(context.Background(), ticketID, categories); err != nil {
+					b.Fatal(err)
+				}
+			}
+		}
+	})
+
+	b.Run("bulk", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			if _, err := collectTicketScores(service.GetTicketScores(context.Background(), startDate, startDate)); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
+
+func TestGetLatestScorecardDelta(t *testing.T) {
+	start := time.Date(2019, 10, 1, 0, 0, 0, 0, time.UTC)
+	categories := []models.RatingCategory{
+		{ID: 1, Name: "Spelling", Weight: 1},
+		{ID: 2, Name: "Grammar", Weight: 1},
+		{ID: 3, Name: "GDPR", Weight: 1},
+	}
+	ratingsData := map[string][]models.Rating{
+		"1-2019-10-01": {
+			{ID: 1, TicketID: 1, RatingCategoryID: 1, Rating: 4, CreatedAt: start.Add(3 * time.Hour)},
+			{ID: 2, TicketID: 1, RatingCategoryID: 1, Rating: 2, CreatedAt: start.Add(1 * time.Hour)},
+			{ID: 3, TicketID: 2, RatingCategoryID: 1, Rating: 1, CreatedAt: start.Add(4 * time.Hour)},
+		},
+		// Rated once, so nothing to compare
+		"2-2019-10-01": {{ID: 4, TicketID: 1, RatingCategoryID: 2, Rating: 5, CreatedAt: start.Add(2 * time.Hour)}},
+		"3-2019-10-01": {
+			{ID: 5, TicketID: 1, RatingCategoryID: 3, Rating: 5, CreatedAt: start.Add(1 * time.Hour)},
+			{ID: 6, TicketID: 1, RatingCategoryID: 3, Rating: 1, CreatedAt: start.Add(5 * time.Hour)},
+		},
+	}
+	service := NewTicketScoresService(&mockCategoryRepo{categories: categories}, &mocks.MockRatingsRepo{Ratings: ratingsData}, NewTicketScoreService())
+
+	delta, err := service.GetLatestScorecardDelta(context.Background(), 1)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expected := &ScorecardDelta{
+		TicketID: 1,
+		CategoryDeltas: []CategoryDelta{
+			{CategoryName: "Spelling", ScoreBefore: "40%", ScoreAfter: "60%", Delta: "+20%"},
+			{CategoryName: "GDPR", ScoreBefore: "100%", ScoreAfter: "60%", Delta: "-40%"},
+		},
+	}
+	if !reflect.DeepEqual(delta, expected) {
+		t.Errorf("Expected %+v, got %+v", expected, delta)
+	}
+
+	t.Run("ticket without repeat ratings", func(t *testing.T) {
+		delta, err := service.GetLatestScorecardDelta(context.Background(), 2)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if len(delta.CategoryDeltas) != 0 {
+			t.Errorf("Expected no category deltas, got %+v", delta.CategoryDeltas)
+		}
+	})
+}
+
+func TestGetScoreConvergence(t *testing.T) {
+	start := time.Date(2019, 10, 1, 0, 0, 0, 0, time.UTC)
+	categories := []models.RatingCategory{
+		{ID: 1, Name: "Spelling", Weight: 1},
+		{ID: 2, Name: "Grammar", Weight: 0.5},
+	}
+	// Rated 1 to 5 in chronological order across both categories, stored out of order
+	ticketRatings := []models.Rating{
+		{ID: 1, TicketID: 1, RatingCategoryID: 1, Rating: 5, CreatedAt: start.Add(5 * time.Hour)},
+		{ID: 2, TicketID: 1, RatingCategoryID: 2, Rating: 1, CreatedAt: start.Add(1 * time.Hour)},
+		{ID: 3, TicketID: 1, RatingCategoryID: 1, Rating: 2, CreatedAt: start.Add(2 * time.Hour)},
+		{ID: 4, TicketID: 1, RatingCategoryID: 2, Rating: 3, CreatedAt: start.Add(3 * time.Hour)},
+		{ID: 5, TicketID: 1, RatingCategoryID: 1, Rating: 4, CreatedAt: start.Add(4 * time.Hour)},
+	}
+	ratingsData := map[string][]models.Rating{
+		"1-2019-10-01": {ticketRatings[0], ticketRatings[2], ticketRatings[4]},
+		"2-2019-10-01": {ticketRatings[1], ticketRatings[3], {ID: 6, TicketID: 2, RatingCategoryID: 2, Rating: 5, CreatedAt: start}},
+	}
+	calculator := NewTicketScoreService()
+	service := NewTicketScoresService(&mockCategoryRepo{categories: categories}, &mocks.MockRatingsRepo{Ratings: ratingsData}, calculator)
+
+	convergence, err := service.GetScoreConvergence(context.Background(), 1)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if convergence.TicketID != 1 || len(convergence.Snapshots) != 5 {
+		t.Fatalf("Expected 5 snapshots for ticket 1, got %+v", convergence)
+	}
+
+	expectedScore := func(ratings ...models.Rating) string {
+		score, err := calculator.CalculateScore(ratings, categories)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		return service.formatScore(score)
+	}
+	if first := convergence.Snapshots[0]; first.RatingCount != 1 || first.CumulativeScore != expectedScore(ticketRatings[1]) {
+		t.Errorf("Expected the first snapshot to score the earliest rating alone, got %+v", first)
+	}
+	if last := convergence.Snapshots[4]; last.RatingCount != 5 || last.CumulativeScore != expectedScore(ticketRatings...) {
+		t.Errorf("Expected the last snapshot to score every rating, got %+v", last)
+	}
+
+	// Each rating is above the score so far, so the score rises with every one
+	previous := -1.0
+	for i, snapshot := range convergence.Snapshots {
+		if snapshot.RatingCount != i+1 {
+			t.Errorf("Expected snapshot %d to count %d ratings, got %d", i, i+1, snapshot.RatingCount)
+		}
+		score, ok := parseScore(snapshot.CumulativeScore)
+		if !ok {
+			t.Fatalf("Snapshot %d has unparseable score %q", i, snapshot.CumulativeScore)
+		}
+		if score <= previous {
+			t.Errorf("Expected the score to rise at snapshot %d, went from %v to %v", i, previous, score)
+		}
+		previous = score
+	}
+
+	t.Run("ticket without ratings", func(t *testing.T) {
+		convergence, err := service.GetScoreConvergence(context.Background(), 3)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if len(convergence.Snapshots) != 0 {
+			t.Errorf("Expected no snapshots, got %+v", convergence.Snapshots)
+		}
+	})
+}
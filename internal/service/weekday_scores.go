@@ -0,0 +1,52 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// WeekdayScore is a category's score over all ratings created on one day of the week
+type WeekdayScore struct {
+	Weekday     string `json:"weekday"`
+	Score       string `json:"score"`
+	RatingCount int    `json:"ratingCount"`
+}
+
+// WeekdayBreakdown holds a category's score for each day of the week, Sunday first
+type WeekdayBreakdown struct {
+	CategoryName string         `json:"categoryName"`
+	Weekdays     []WeekdayScore `json:"weekdays"`
+}
+
+// GetCategoryScoreByWeekday calculates a category's score for each day of the week (UTC) over a date
+// range. All seven weekdays are present, Sunday first; weekdays without ratings get an "N/A" score.
+func (s *RatingAnalyticsService) GetCategoryScoreByWeekday(ctx context.Context, categoryID int, startDate, endDate time.Time) (*WeekdayBreakdown, error) {
+	category, err := findCategory(ctx, s.categoryRepo, categoryID)
+	if err != nil {
+		return nil, err
+	}
+
+	weekdayRatings, err := s.ratingsRepo.GetRatingsByWeekdayAndCategoryID(ctx, categoryID, startDate, endDate)
+	if err != nil {
+		return nil, logError(ctx, fmt.Errorf("failed to get ratings by weekday: %w", err))
+	}
+
+	breakdown := &WeekdayBreakdown{
+		CategoryName: category.Name,
+		Weekdays:     make([]WeekdayScore, 7),
+	}
+	for weekday := range breakdown.Weekdays {
+		breakdown.Weekdays[weekday] = WeekdayScore{
+			Weekday: time.Weekday(weekday).String(),
+			Score:   "N/A",
+		}
+	}
+
+	for _, ratings := range weekdayRatings {
+		breakdown.Weekdays[ratings.Weekday].Score = s.formatScore(ratingToPercent(ratings.AverageRating, s.ticketScoreServ.MaxRating()))
+		breakdown.Weekdays[ratings.Weekday].RatingCount = ratings.Count
+	}
+
+	return breakdown, nil
+}
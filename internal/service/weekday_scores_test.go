@@ -0,0 +1,83 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"ticket-score-service/internal/mocks"
+	"ticket-score-service/internal/models"
+)
+
+func TestGetCategoryScoreByWeekday(t *testing.T) {
+	// 2024-01-01 is a Monday
+	monday := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	categories := []models.RatingCategory{
+		{ID: 1, Name: "Spelling", Weight: 2},
+		{ID: 2, Name: "Grammar", Weight: 1},
+	}
+	ratings := map[string][]models.Rating{
+		"all": {
+			{ID: 1, RatingCategoryID: 1, Rating: 5, CreatedAt: monday.Add(9 * time.Hour)},
+			{ID: 2, RatingCategoryID: 1, Rating: 3, CreatedAt: monday.Add(7*24*time.Hour + 10*time.Hour)}, // next Monday
+			{ID: 3, RatingCategoryID: 1, Rating: 2, CreatedAt: monday.Add(4*24*time.Hour + 12*time.Hour)}, // Friday
+			{ID: 4, RatingCategoryID: 2, Rating: 1, CreatedAt: monday.Add(2*24*time.Hour + 12*time.Hour)}, // other category
+			{ID: 5, RatingCategoryID: 1, Rating: 1, CreatedAt: monday.Add(-24 * time.Hour)},               // before range
+		},
+	}
+
+	allNA := func() []WeekdayScore {
+		return []WeekdayScore{
+			{Weekday: "Sunday", Score: "N/A"},
+			{Weekday: "Monday", Score: "N/A"},
+			{Weekday: "Tuesday", Score: "N/A"},
+			{Weekday: "Wednesday", Score: "N/A"},
+			{Weekday: "Thursday", Score: "N/A"},
+			{Weekday: "Friday", Score: "N/A"},
+			{Weekday: "Saturday", Score: "N/A"},
+		}
+	}
+	withData := allNA()
+	withData[1] = WeekdayScore{Weekday: "Monday", Score: "80%", RatingCount: 2}
+	withData[5] = WeekdayScore{Weekday: "Friday", Score: "40%", RatingCount: 1}
+
+	tests := []struct {
+		name     string
+		ratings  map[string][]models.Rating
+		expected []WeekdayScore
+	}{
+		{name: "weekdays with and without ratings", ratings: ratings, expected: withData},
+		{name: "no ratings", ratings: nil, expected: allNA()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := NewRatingAnalyticsService(&mockCategoryRepo{categories: categories}, &mocks.MockRatingsRepo{Ratings: tt.ratings}, NewTicketScoreService())
+
+			breakdown, err := service.GetCategoryScoreByWeekday(context.Background(), 1, monday, monday.AddDate(0, 0, 13))
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if breakdown.CategoryName != "Spelling" {
+				t.Errorf("Expected category Spelling, got %s", breakdown.CategoryName)
+			}
+			if len(breakdown.Weekdays) != 7 {
+				t.Fatalf("Expected 7 weekdays, got %d", len(breakdown.Weekdays))
+			}
+			if !reflect.DeepEqual(breakdown.Weekdays, tt.expected) {
+				t.Errorf("Expected %+v, got %+v", tt.expected, breakdown.Weekdays)
+			}
+		})
+	}
+
+	t.Run("unknown category", func(t *testing.T) {
+		service := NewRatingAnalyticsService(&mockCategoryRepo{categories: categories}, &mocks.MockRatingsRepo{}, NewTicketScoreService())
+
+		if _, err := service.GetCategoryScoreByWeekday(context.Background(), 99, monday, monday); !errors.Is(err, ErrCategoryNotFound) {
+			t.Errorf("Expected ErrCategoryNotFound, got %v", err)
+		}
+	})
+}
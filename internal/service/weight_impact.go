@@ -0,0 +1,97 @@
+package service
+
+import (
+	"context"
+	"math"
+	"sort"
+	"time"
+
+	"ticket-score-service/internal/models"
+)
+
+// WeightImpact shows how the overall score changes when a category's weight is doubled or halved
+type WeightImpact struct {
+	CategoryName       string  `json:"category_name"`
+	CurrentScore       string  `json:"current_score"`
+	DoubledWeightScore string  `json:"doubled_weight_score"`
+	HalvedWeightScore  string  `json:"halved_weight_score"`
+	Sensitivity        float64 `json:"sensitivity"`
+}
+
+// GetCategoryWeightImpact recalculates the overall score of all ratings in a date range with
+// each category's weight doubled and halved in turn. Sensitivity is the difference between the
+// two scores in percentage points. Impacts are sorted by sensitivity, most sensitive first.
+func (s *RatingAnalyticsService) GetCategoryWeightImpact(ctx context.Context, startDate, endDate time.Time) ([]WeightImpact, error) {
+	categories, err := s.categoryRepo.GetAll(ctx)
+	if err != nil {
+		return nil, logError(ctx, err)
+	}
+
+	var ratings []models.Rating
+	for _, category := range categories {
+		categoryRatings, err := s.ratingsRepo.GetByCategoryIDAndDateRange(ctx, category.ID, startDate, endDate)
+		if err != nil {
+			return nil, logError(ctx, err)
+		}
+		ratings = append(ratings, categoryRatings...)
+	}
+
+	currentScore := s.overallScore(ratings, categories)
+
+	impacts := make([]WeightImpact, 0, len(categories))
+	for i, category := range categories {
+		doubledScore := s.overallScore(ratings, withWeight(categories, i, category.Weight*2))
+		halvedScore := s.overallScore(ratings, withWeight(categories, i, category.Weight/2))
+
+		impacts = append(impacts, WeightImpact{
+			CategoryName:       category.Name,
+			CurrentScore:       currentScore,
+			DoubledWeightScore: doubledScore,
+			HalvedWeightScore:  halvedScore,
+			Sensitivity:        s.sensitivity(doubledScore, halvedScore),
+		})
+	}
+
+	sort.SliceStable(impacts, func(i, j int) bool {
+		return impacts[i].Sensitivity > impacts[j].Sensitivity
+	})
+
+	return impacts, nil
+}
+
+// overallScore formats the weighted score of ratings across categories, or "N/A" when it
+// cannot be calculated
+func (s *RatingAnalyticsService) overallScore(ratings []models.Rating, categories []models.RatingCategory) string {
+	if len(ratings) == 0 {
+		return "N/A"
+	}
+
+	score, err := s.ticketScoreServ.CalculateScore(ratings, categories)
+	if err != nil {
+		return "N/A"
+	}
+
+	return s.formatScore(score)
+}
+
+// sensitivity is the difference between two formatted scores in percentage points, or 0 when
+// either is "N/A"
+func (s *RatingAnalyticsService) sensitivity(score1, score2 string) float64 {
+	value1, ok1 := parseScore(score1)
+	value2, ok2 := parseScore(score2)
+	if !ok1 || !ok2 {
+		return 0
+	}
+
+	// Round away float noise from subtracting the parsed scores
+	scale := math.Pow(10, float64(s.scorePrecision))
+	return math.Round(math.Abs(value1-value2)*scale) / scale
+}
+
+// withWeight copies categories with the weight of the category at index replaced
+func withWeight(categories []models.RatingCategory, index int, weight float64) []models.RatingCategory {
+	result := make([]models.RatingCategory, len(categories))
+	copy(result, categories)
+	result[index].Weight = weight
+	return result
+}
@@ -0,0 +1,97 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"ticket-score-service/internal/mocks"
+	"ticket-score-service/internal/models"
+)
+
+func TestGetCategoryWeightImpact(t *testing.T) {
+	startDate := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2024, 1, 7, 0, 0, 0, 0, time.UTC)
+	createdAt := startDate.Add(time.Hour)
+
+	ratings := map[string][]models.Rating{
+		"1-2024-01-01": {{ID: 1, RatingCategoryID: 1, Rating: 5, CreatedAt: createdAt}},
+		"2-2024-01-01": {{ID: 2, RatingCategoryID: 2, Rating: 0, CreatedAt: createdAt}},
+		"3-2024-01-01": {{ID: 3, RatingCategoryID: 3, Rating: 1, CreatedAt: createdAt}},
+	}
+
+	tests := []struct {
+		name       string
+		categories []models.RatingCategory
+		ratings    map[string][]models.Rating
+		expected   []WeightImpact
+	}{
+		{
+			name: "sorted by sensitivity",
+			categories: []models.RatingCategory{
+				{ID: 1, Name: "Spelling", Weight: 1},
+				{ID: 2, Name: "Grammar", Weight: 1},
+				{ID: 3, Name: "Tone", Weight: 0},
+			},
+			ratings: ratings,
+			expected: []WeightImpact{
+				// Current: 5 / 10; Spelling doubled: 10 / 15, halved: 2.5 / 7.5
+				{CategoryName: "Spelling", CurrentScore: "50%", DoubledWeightScore: "67%", HalvedWeightScore: "33%", Sensitivity: 34},
+				// Grammar doubled: 5 / 15, halved: 5 / 7.5
+				{CategoryName: "Grammar", CurrentScore: "50%", DoubledWeightScore: "33%", HalvedWeightScore: "67%", Sensitivity: 34},
+				{CategoryName: "Tone", CurrentScore: "50%", DoubledWeightScore: "50%", HalvedWeightScore: "50%", Sensitivity: 0},
+			},
+		},
+		{
+			name: "zero weight category is listed last",
+			categories: []models.RatingCategory{
+				{ID: 3, Name: "Tone", Weight: 0},
+				{ID: 1, Name: "Spelling", Weight: 2},
+				{ID: 2, Name: "Grammar", Weight: 1},
+			},
+			ratings: ratings,
+			expected: []WeightImpact{
+				// Current: 10 / 15; Spelling doubled: 20 / 25, halved: 5 / 10
+				{CategoryName: "Spelling", CurrentScore: "67%", DoubledWeightScore: "80%", HalvedWeightScore: "50%", Sensitivity: 30},
+				// Grammar doubled: 10 / 20, halved: 10 / 12.5
+				{CategoryName: "Grammar", CurrentScore: "67%", DoubledWeightScore: "50%", HalvedWeightScore: "80%", Sensitivity: 30},
+				{CategoryName: "Tone", CurrentScore: "67%", DoubledWeightScore: "67%", HalvedWeightScore: "67%", Sensitivity: 0},
+			},
+		},
+		{
+			name: "no ratings",
+			categories: []models.RatingCategory{
+				{ID: 1, Name: "Spelling", Weight: 1},
+			},
+			ratings: nil,
+			expected: []WeightImpact{
+				{CategoryName: "Spelling", CurrentScore: "N/A", DoubledWeightScore: "N/A", HalvedWeightScore: "N/A", Sensitivity: 0},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := NewRatingAnalyticsService(&mockCategoryRepo{categories: tt.categories}, &mocks.MockRatingsRepo{Ratings: tt.ratings}, NewTicketScoreService())
+
+			impacts, err := service.GetCategoryWeightImpact(context.Background(), startDate, endDate)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(impacts, tt.expected) {
+				t.Errorf("Expected %+v, got %+v", tt.expected, impacts)
+			}
+		})
+	}
+
+	t.Run("repository error", func(t *testing.T) {
+		categories := []models.RatingCategory{{ID: 1, Name: "Spelling", Weight: 1}}
+		service := NewRatingAnalyticsService(&mockCategoryRepo{categories: categories}, &mocks.MockRatingsRepo{Err: errors.New("database error")}, NewTicketScoreService())
+
+		if _, err := service.GetCategoryWeightImpact(context.Background(), startDate, endDate); err == nil {
+			t.Error("Expected error but got none")
+		}
+	})
+}
@@ -0,0 +1,72 @@
+package swagger
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"path/filepath"
+	"strings"
+
+	httpSwagger "github.com/swaggo/http-swagger"
+)
+
+// DefaultSpecDir is the directory the OpenAPI specs are generated into by `make openapi`
+const DefaultSpecDir = "./openapi/v2"
+
+const (
+	specPrefix = "/openapi/v2/"
+	uiPrefix   = "/swagger/"
+)
+
+// specURL describes a single spec entry in the Swagger UI definition selector
+type specURL struct {
+	URL  string `json:"url"`
+	Name string `json:"name"`
+}
+
+// NewHandler creates an HTTP handler that serves the generated specs under /openapi/v2/
+// and the Swagger UI under /swagger/
+func NewHandler(specDir string) (http.Handler, error) {
+	specs, err := filepath.Glob(filepath.Join(specDir, "*.swagger.json"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list swagger specs: %w", err)
+	}
+	if len(specs) == 0 {
+		return nil, fmt.Errorf("no swagger specs found in %s", specDir)
+	}
+
+	urls := make([]specURL, len(specs))
+	for i, spec := range specs {
+		name := filepath.Base(spec)
+		urls[i] = specURL{
+			URL:  specPrefix + name,
+			Name: strings.TrimSuffix(name, ".swagger.json"),
+		}
+	}
+
+	urlsJSON, err := json.Marshal(urls)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode swagger spec urls: %w", err)
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle(specPrefix, http.StripPrefix(specPrefix, http.FileServer(http.Dir(specDir))))
+	mux.Handle(uiPrefix, httpSwagger.Handler(
+		httpSwagger.URL(urls[0].URL),
+		httpSwagger.UIConfig(map[string]string{"urls": string(urlsJSON)}),
+	))
+
+	return mux, nil
+}
+
+// Serve starts an HTTP server with the Swagger UI on the given port
+func Serve(port, specDir string) error {
+	handler, err := NewHandler(specDir)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("Swagger UI listening on port %s", port)
+	return http.ListenAndServe(":"+port, handler)
+}
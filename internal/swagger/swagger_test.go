@@ -0,0 +1,124 @@
+package swagger
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+const (
+	protoDir = "../../proto"
+	specDir  = "../../openapi/v2"
+)
+
+type swaggerSpec struct {
+	Swagger string `json:"swagger"`
+	Info    struct {
+		Title   string `json:"title"`
+		Version string `json:"version"`
+	} `json:"info"`
+	Paths map[string]map[string]struct {
+		OperationID string `json:"operationId"`
+	} `json:"paths"`
+}
+
+var (
+	serviceRegexp = regexp.MustCompile(`(?m)^service (\w+)`)
+	rpcRegexp     = regexp.MustCompile(`(?m)^\s*rpc (\w+)\(`)
+)
+
+func TestGeneratedSpecs(t *testing.T) {
+	protoFiles, err := filepath.Glob(filepath.Join(protoDir, "*.proto"))
+	if err != nil {
+		t.Fatalf("Failed to list proto files: %v", err)
+	}
+	if len(protoFiles) == 0 {
+		t.Fatal("No proto files found")
+	}
+
+	for _, protoFile := range protoFiles {
+		name := strings.TrimSuffix(filepath.Base(protoFile), ".proto")
+
+		t.Run(name, func(t *testing.T) {
+			protoSource, err := os.ReadFile(protoFile)
+			if err != nil {
+				t.Fatalf("Failed to read proto file: %v", err)
+			}
+
+			data, err := os.ReadFile(filepath.Join(specDir, name+".swagger.json"))
+			if err != nil {
+				t.Fatalf("Missing generated spec for %s, run `make openapi`: %v", name, err)
+			}
+
+			var spec swaggerSpec
+			if err := json.Unmarshal(data, &spec); err != nil {
+				t.Fatalf("Failed to parse spec: %v", err)
+			}
+
+			if spec.Swagger != "2.0" {
+				t.Errorf("Expected swagger version 2.0, got %q", spec.Swagger)
+			}
+			if spec.Info.Title == "" {
+				t.Error("Expected info.title to be set")
+			}
+			if len(spec.Paths) == 0 {
+				t.Fatal("Expected at least one path")
+			}
+
+			// Every RPC in the proto must be exposed as an operation
+			operations := make(map[string]bool)
+			for path, methods := range spec.Paths {
+				if len(methods) == 0 {
+					t.Errorf("Path %s has no operations", path)
+				}
+				for _, operation := range methods {
+					operations[operation.OperationID] = true
+				}
+			}
+
+			serviceMatch := serviceRegexp.FindSubmatch(protoSource)
+			if serviceMatch == nil {
+				t.Fatal("No service definition found in proto file")
+			}
+			serviceName := string(serviceMatch[1])
+
+			for _, rpcMatch := range rpcRegexp.FindAllSubmatch(protoSource, -1) {
+				operationID := serviceName + "_" + string(rpcMatch[1])
+				if !operations[operationID] {
+					t.Errorf("Missing path for RPC %s", operationID)
+				}
+			}
+		})
+	}
+}
+
+func TestNewHandler(t *testing.T) {
+	handler, err := NewHandler(specDir)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/openapi/v2/overall_quality.swagger.json", nil)
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Errorf("Expected status %d, got %d", http.StatusOK, rec.Code)
+	}
+
+	var spec swaggerSpec
+	if err := json.Unmarshal(rec.Body.Bytes(), &spec); err != nil {
+		t.Errorf("Failed to parse served spec: %v", err)
+	}
+}
+
+func TestNewHandler_NoSpecs(t *testing.T) {
+	if _, err := NewHandler(t.TempDir()); err == nil {
+		t.Error("Expected error for empty spec directory, got none")
+	}
+}
@@ -0,0 +1,105 @@
+package testutil
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"regexp"
+	"sync/atomic"
+	"testing"
+
+	"ticket-score-service/internal/database"
+	"ticket-score-service/internal/models"
+	"ticket-score-service/internal/repository"
+)
+
+// baseSchema mirrors the tables of the production database that are not created by migrations
+const baseSchema = `
+CREATE TABLE IF NOT EXISTS rating_categories (id INTEGER PRIMARY KEY, name TEXT NOT NULL, weight REAL NOT NULL);
+CREATE TABLE IF NOT EXISTS tickets (id INTEGER PRIMARY KEY, subject TEXT, created_at DATETIME);
+CREATE TABLE IF NOT EXISTS users (id INTEGER PRIMARY KEY, name TEXT);
+CREATE TABLE IF NOT EXISTS ratings (
+	id INTEGER PRIMARY KEY,
+	rating INTEGER NOT NULL,
+	ticket_id INTEGER,
+	rating_category_id INTEGER,
+	reviewer_id INTEGER,
+	reviewee_id INTEGER,
+	created_at DATETIME
+);`
+
+var (
+	dbCounter       atomic.Int64
+	unsafeNameChars = regexp.MustCompile(`[^A-Za-z0-9_]`)
+)
+
+// createBaseSchema creates the tables the service reads from
+func createBaseSchema(ctx context.Context, conn *sql.DB) error {
+	if _, err := conn.ExecContext(ctx, baseSchema); err != nil {
+		return fmt.Errorf("failed to create base schema: %w", err)
+	}
+	return nil
+}
+
+// NewTestDB opens a shared-cache in-memory SQLite database with the full schema applied.
+// Each call gets its own database so tests stay isolated; it is closed when the test ends.
+func NewTestDB(t testing.TB) *database.DB {
+	t.Helper()
+
+	// Connections in the pool share the database as long as they use the same name
+	name := fmt.Sprintf("%s_%d", unsafeNameChars.ReplaceAllString(t.Name(), "_"), dbCounter.Add(1))
+	db, err := database.New(fmt.Sprintf("file:%s?mode=memory&cache=shared&_busy_timeout=5000", name))
+	if err != nil {
+		t.Fatalf("Failed to open test database: %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+
+	if err := db.Migrate(context.Background(), createBaseSchema, repository.CreateWeeklyScoreSnapshotsTable, repository.CreateScoringRulesTable, database.CreateSnapshotLocksTable); err != nil {
+		t.Fatalf("Failed to migrate test database: %v", err)
+	}
+
+	return db
+}
+
+// SeedTestData inserts ratings and categories in a single transaction
+func SeedTestData(t testing.TB, db *database.DB, ratings []models.Rating, categories []models.RatingCategory) {
+	t.Helper()
+
+	err := db.WithTransaction(context.Background(), func(tx *sql.Tx) error {
+		for _, category := range categories {
+			if _, err := tx.Exec(`INSERT INTO rating_categories (id, name, weight) VALUES (?, ?, ?)`,
+				category.ID, category.Name, category.Weight); err != nil {
+				return fmt.Errorf("failed to insert category %d: %w", category.ID, err)
+			}
+		}
+		for _, rating := range ratings {
+			if _, err := tx.Exec(`INSERT INTO ratings (id, rating, ticket_id, rating_category_id, reviewer_id, reviewee_id, created_at)
+				VALUES (?, ?, ?, ?, ?, ?, ?)`,
+				rating.ID, rating.Rating, rating.TicketID, rating.RatingCategoryID, rating.ReviewerID, rating.RevieweeID, rating.CreatedAt); err != nil {
+				return fmt.Errorf("failed to insert rating %d: %w", rating.ID, err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to seed test data: %v", err)
+	}
+}
+
+// SeedTickets inserts tickets in a single transaction
+func SeedTickets(t testing.TB, db *database.DB, tickets []models.Ticket) {
+	t.Helper()
+
+	err := db.WithTransaction(context.Background(), func(tx *sql.Tx) error {
+		for _, ticket := range tickets {
+			if _, err := tx.Exec(`INSERT INTO tickets (id, subject, created_at) VALUES (?, ?, ?)`,
+				ticket.ID, ticket.Subject, ticket.CreatedAt); err != nil {
+				return fmt.Errorf("failed to insert ticket %d: %w", ticket.ID, err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to seed tickets: %v", err)
+	}
+}
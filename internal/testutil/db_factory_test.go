@@ -0,0 +1,74 @@
+package testutil
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"ticket-score-service/internal/models"
+)
+
+func TestNewTestDB(t *testing.T) {
+	db := NewTestDB(t)
+
+	if err := db.GetConnection().PingContext(context.Background()); err != nil {
+		t.Fatalf("Expected a working connection, got %v", err)
+	}
+
+	for _, table := range []string{"rating_categories", "tickets", "users", "ratings", "weekly_score_snapshots"} {
+		var name string
+		err := db.QueryRowContext(context.Background(),
+			"SELECT name FROM sqlite_master WHERE type = 'table' AND name = ?", table).Scan(&name)
+		if err != nil {
+			t.Errorf("Expected table %s to exist: %v", table, err)
+		}
+	}
+}
+
+func TestNewTestDB_Isolated(t *testing.T) {
+	first := NewTestDB(t)
+	second := NewTestDB(t)
+
+	SeedTestData(t, first, nil, []models.RatingCategory{{ID: 1, Name: "Spelling", Weight: 1}})
+
+	var count int
+	if err := second.QueryRowContext(context.Background(), "SELECT COUNT(*) FROM rating_categories").Scan(&count); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if count != 0 {
+		t.Errorf("Expected separate databases, found %d categories in the second", count)
+	}
+}
+
+func TestSeedTestData(t *testing.T) {
+	db := NewTestDB(t)
+
+	day := time.Date(2019, 10, 1, 0, 0, 0, 0, time.UTC)
+	SeedTestData(t, db,
+		[]models.Rating{
+			{ID: 1, Rating: 5, TicketID: 1, RatingCategoryID: 1, CreatedAt: day},
+			{ID: 2, Rating: 3, TicketID: 2, RatingCategoryID: 2, CreatedAt: day},
+		},
+		[]models.RatingCategory{{ID: 1, Name: "Spelling", Weight: 1}, {ID: 2, Name: "Grammar", Weight: 0.7}},
+	)
+
+	tests := []struct {
+		table    string
+		expected int
+	}{
+		{"ratings", 2},
+		{"rating_categories", 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.table, func(t *testing.T) {
+			var count int
+			if err := db.QueryRowContext(context.Background(), "SELECT COUNT(*) FROM "+tt.table).Scan(&count); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if count != tt.expected {
+				t.Errorf("Expected %d rows, got %d", tt.expected, count)
+			}
+		})
+	}
+}
@@ -0,0 +1,76 @@
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// DateLayout is the date format accepted in requests
+const DateLayout = "2006-01-02"
+
+var (
+	// ErrMissingDates is returned when the start or end date is empty
+	ErrMissingDates = errors.New("start_date and end_date are required")
+	// ErrStartAfterEnd is returned when the start date is after the end date
+	ErrStartAfterEnd = errors.New("start_date must be before or equal to end_date")
+)
+
+// DateRange is an inclusive range of days
+type DateRange struct {
+	Start time.Time
+	End   time.Time
+}
+
+// ParseDateRange parses and validates start and end dates in YYYY-MM-DD format as UTC
+func ParseDateRange(startStr, endStr string) (DateRange, error) {
+	return ParseDateRangeWithTimezone(startStr, endStr, "")
+}
+
+// ParseDateRangeWithTimezone parses and validates start and end dates in YYYY-MM-DD format
+// in the given IANA timezone. An empty timezone means UTC.
+func ParseDateRangeWithTimezone(startStr, endStr, tz string) (DateRange, error) {
+	if startStr == "" || endStr == "" {
+		return DateRange{}, ErrMissingDates
+	}
+
+	loc := time.UTC
+	if tz != "" {
+		var err error
+		loc, err = time.LoadLocation(tz)
+		if err != nil {
+			return DateRange{}, fmt.Errorf("invalid timezone %q: %w", tz, err)
+		}
+	}
+
+	start, err := time.ParseInLocation(DateLayout, startStr, loc)
+	if err != nil {
+		return DateRange{}, fmt.Errorf("invalid start_date format, expected YYYY-MM-DD: %w", err)
+	}
+
+	end, err := time.ParseInLocation(DateLayout, endStr, loc)
+	if err != nil {
+		return DateRange{}, fmt.Errorf("invalid end_date format, expected YYYY-MM-DD: %w", err)
+	}
+
+	if start.After(end) {
+		return DateRange{}, ErrStartAfterEnd
+	}
+
+	return DateRange{Start: start, End: end}, nil
+}
+
+// Days returns the number of days in the range, counting both ends
+func (r DateRange) Days() int {
+	startDay := time.Date(r.Start.Year(), r.Start.Month(), r.Start.Day(), 0, 0, 0, 0, time.UTC)
+	endDay := time.Date(r.End.Year(), r.End.Month(), r.End.Day(), 0, 0, 0, 0, time.UTC)
+	return int(endDay.Sub(startDay).Hours()/24) + 1
+}
+
+// ValidateMaxDays checks that the range spans at most maxDays days
+func (r DateRange) ValidateMaxDays(maxDays int) error {
+	if days := r.Days(); days > maxDays {
+		return fmt.Errorf("date range of %d days exceeds the maximum of %d days", days, maxDays)
+	}
+	return nil
+}
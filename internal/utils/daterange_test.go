@@ -0,0 +1,145 @@
+package utils
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestParseDateRange(t *testing.T) {
+	tests := []struct {
+		name          string
+		startDate     string
+		endDate       string
+		expectedStart time.Time
+		expectedEnd   time.Time
+		expectedErr   error
+		expectError   bool
+	}{
+		{
+			name:          "valid range",
+			startDate:     "2024-01-01",
+			endDate:       "2024-01-07",
+			expectedStart: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+			expectedEnd:   time.Date(2024, 1, 7, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:          "same day",
+			startDate:     "2024-01-01",
+			endDate:       "2024-01-01",
+			expectedStart: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+			expectedEnd:   time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{name: "missing start_date", endDate: "2024-01-07", expectedErr: ErrMissingDates},
+		{name: "missing end_date", startDate: "2024-01-01", expectedErr: ErrMissingDates},
+		{name: "missing both dates", expectedErr: ErrMissingDates},
+		{name: "invalid start_date format", startDate: "invalid-date", endDate: "2024-01-07", expectError: true},
+		{name: "invalid end_date format", startDate: "2024-01-01", endDate: "invalid-date", expectError: true},
+		{name: "start_date in wrong layout", startDate: "01/01/2024", endDate: "2024-01-07", expectError: true},
+		{name: "start_date with time", startDate: "2024-01-01T00:00:00Z", endDate: "2024-01-07", expectError: true},
+		{name: "start_date invalid month", startDate: "2024-13-01", endDate: "2024-12-31", expectError: true},
+		{name: "end_date invalid day", startDate: "2024-01-01", endDate: "2024-02-30", expectError: true},
+		{name: "start_date after end_date", startDate: "2024-01-07", endDate: "2024-01-01", expectedErr: ErrStartAfterEnd},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dateRange, err := ParseDateRange(tt.startDate, tt.endDate)
+
+			if tt.expectedErr != nil {
+				if !errors.Is(err, tt.expectedErr) {
+					t.Errorf("Expected error %v, got %v", tt.expectedErr, err)
+				}
+				return
+			}
+			if tt.expectError {
+				if err == nil {
+					t.Error("Expected error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if !dateRange.Start.Equal(tt.expectedStart) {
+				t.Errorf("Expected start %v, got %v", tt.expectedStart, dateRange.Start)
+			}
+			if !dateRange.End.Equal(tt.expectedEnd) {
+				t.Errorf("Expected end %v, got %v", tt.expectedEnd, dateRange.End)
+			}
+		})
+	}
+}
+
+func TestParseDateRangeWithTimezone(t *testing.T) {
+	t.Run("dates are parsed in the given timezone", func(t *testing.T) {
+		dateRange, err := ParseDateRangeWithTimezone("2024-01-01", "2024-01-07", "America/New_York")
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		if dateRange.Start.Location().String() != "America/New_York" {
+			t.Errorf("Expected America/New_York location, got %s", dateRange.Start.Location())
+		}
+
+		expected := time.Date(2024, 1, 1, 5, 0, 0, 0, time.UTC)
+		if !dateRange.Start.Equal(expected) {
+			t.Errorf("Expected start %v, got %v", expected, dateRange.Start.UTC())
+		}
+	})
+
+	t.Run("empty timezone is UTC", func(t *testing.T) {
+		dateRange, err := ParseDateRangeWithTimezone("2024-01-01", "2024-01-07", "")
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if dateRange.Start.Location() != time.UTC {
+			t.Errorf("Expected UTC, got %s", dateRange.Start.Location())
+		}
+	})
+
+	t.Run("invalid timezone", func(t *testing.T) {
+		if _, err := ParseDateRangeWithTimezone("2024-01-01", "2024-01-07", "Mars/Olympus_Mons"); err == nil {
+			t.Error("Expected error but got none")
+		}
+	})
+
+	t.Run("missing dates are checked first", func(t *testing.T) {
+		if _, err := ParseDateRangeWithTimezone("", "2024-01-07", "Mars/Olympus_Mons"); !errors.Is(err, ErrMissingDates) {
+			t.Errorf("Expected ErrMissingDates, got %v", err)
+		}
+	})
+}
+
+func TestDateRange_ValidateMaxDays(t *testing.T) {
+	tests := []struct {
+		name        string
+		startDate   string
+		endDate     string
+		maxDays     int
+		expectError bool
+	}{
+		{name: "single day", startDate: "2024-01-01", endDate: "2024-01-01", maxDays: 1},
+		{name: "exactly the maximum", startDate: "2024-01-01", endDate: "2024-01-31", maxDays: 31},
+		{name: "one day over the maximum", startDate: "2024-01-01", endDate: "2024-02-01", maxDays: 31, expectError: true},
+		{name: "across DST change", startDate: "2024-03-01", endDate: "2024-03-31", maxDays: 31},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dateRange, err := ParseDateRangeWithTimezone(tt.startDate, tt.endDate, "Europe/Berlin")
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			err = dateRange.ValidateMaxDays(tt.maxDays)
+			if tt.expectError && err == nil {
+				t.Error("Expected error but got none")
+			}
+			if !tt.expectError && err != nil {
+				t.Errorf("Unexpected error: %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,75 @@
+package utils
+
+import "math"
+
+// Trend directions found by the Mann-Kendall test
+const (
+	TrendUpward   = "upward"
+	TrendDownward = "downward"
+	TrendNone     = "no trend"
+)
+
+// mannKendallCriticalZ is the two-sided critical value of Z at the 5% significance level
+const mannKendallCriticalZ = 1.96
+
+// MannKendallResult is the outcome of a Mann-Kendall trend test
+type MannKendallResult struct {
+	S         int
+	VarianceS float64
+	ZScore    float64
+	PValue    float64
+	Trend     string
+}
+
+// MannKendall tests values, in time order, for a monotonic trend. S counts the later values above
+// each value minus those below it, its variance is corrected for tied values, and Z applies a
+// continuity correction. PValue is two-sided; the trend is upward or downward when |Z| > 1.96.
+// With fewer than three values or no variation there is no trend and PValue is 1.
+func MannKendall(values []float64) MannKendallResult {
+	n := len(values)
+	result := MannKendallResult{PValue: 1, Trend: TrendNone}
+	if n < 3 {
+		return result
+	}
+
+	for i := 0; i < n-1; i++ {
+		for j := i + 1; j < n; j++ {
+			switch {
+			case values[j] > values[i]:
+				result.S++
+			case values[j] < values[i]:
+				result.S--
+			}
+		}
+	}
+
+	ties := make(map[float64]int)
+	for _, value := range values {
+		ties[value]++
+	}
+	variance := float64(n*(n-1)*(2*n+5)) / 18
+	for _, t := range ties {
+		variance -= float64(t*(t-1)*(2*t+5)) / 18
+	}
+	result.VarianceS = variance
+	if variance <= 0 {
+		return result
+	}
+
+	switch {
+	case result.S > 0:
+		result.ZScore = float64(result.S-1) / math.Sqrt(variance)
+	case result.S < 0:
+		result.ZScore = float64(result.S+1) / math.Sqrt(variance)
+	}
+	result.PValue = math.Erfc(math.Abs(result.ZScore) / math.Sqrt2)
+
+	switch {
+	case result.ZScore > mannKendallCriticalZ:
+		result.Trend = TrendUpward
+	case result.ZScore < -mannKendallCriticalZ:
+		result.Trend = TrendDownward
+	}
+
+	return result
+}
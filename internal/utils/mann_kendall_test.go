@@ -0,0 +1,76 @@
+package utils
+
+import (
+	"math"
+	"testing"
+)
+
+func TestMannKendall(t *testing.T) {
+	tests := []struct {
+		name              string
+		values            []float64
+		expectedS         int
+		expectedVarianceS float64
+		expectedZScore    float64
+		expectedTrend     string
+	}{
+		{
+			name:              "strictly increasing",
+			values:            []float64{10, 20, 30, 40, 50, 60, 70, 80, 90, 100},
+			expectedS:         45,
+			expectedVarianceS: 125,
+			expectedZScore:    44 / math.Sqrt(125),
+			expectedTrend:     TrendUpward,
+		},
+		{
+			name:              "strictly decreasing",
+			values:            []float64{100, 90, 80, 70, 60, 50, 40, 30, 20, 10},
+			expectedS:         -45,
+			expectedVarianceS: 125,
+			expectedZScore:    -44 / math.Sqrt(125),
+			expectedTrend:     TrendDownward,
+		},
+		{
+			// Variance of S is (5*4*15 - 2*1*9) / 18 with 60 tied twice; S = 1 corrects to Z = 0
+			name:              "noisy with ties",
+			values:            []float64{60, 80, 60, 70, 65},
+			expectedS:         1,
+			expectedVarianceS: 282.0 / 18,
+			expectedTrend:     TrendNone,
+		},
+		{
+			name:          "constant",
+			values:        []float64{50, 50, 50, 50},
+			expectedTrend: TrendNone,
+		},
+		{
+			name:          "too few values",
+			values:        []float64{10, 90},
+			expectedTrend: TrendNone,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := MannKendall(tt.values)
+
+			if result.S != tt.expectedS {
+				t.Errorf("Expected S %d, got %d", tt.expectedS, result.S)
+			}
+			if math.Abs(result.VarianceS-tt.expectedVarianceS) > 0.0001 {
+				t.Errorf("Expected variance of S %.4f, got %.4f", tt.expectedVarianceS, result.VarianceS)
+			}
+			if math.Abs(result.ZScore-tt.expectedZScore) > 0.0001 {
+				t.Errorf("Expected Z %.4f, got %.4f", tt.expectedZScore, result.ZScore)
+			}
+			if result.Trend != tt.expectedTrend {
+				t.Errorf("Expected trend %q, got %q", tt.expectedTrend, result.Trend)
+			}
+
+			expectedPValue := math.Erfc(math.Abs(tt.expectedZScore) / math.Sqrt2)
+			if math.Abs(result.PValue-expectedPValue) > 0.0001 {
+				t.Errorf("Expected p-value %.4f, got %.4f", expectedPValue, result.PValue)
+			}
+		})
+	}
+}
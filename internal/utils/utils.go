@@ -5,12 +5,20 @@ import (
 	"time"
 )
 
-// FormatScore formats a float score as a percentage string
+// FormatScore formats a float score as a percentage string rounded to a whole number
 func FormatScore(score float64) string {
+	return FormatScoreWithPrecision(score, 0)
+}
+
+// FormatScoreWithPrecision formats a float score as a percentage string with the given number of decimal places
+func FormatScoreWithPrecision(score float64, decimals int) string {
 	if score == 0 {
 		return "0%"
 	}
-	return fmt.Sprintf("%.0f%%", score)
+	if decimals < 0 {
+		decimals = 0
+	}
+	return fmt.Sprintf("%.*f%%", decimals, score)
 }
 
 // FormatDateRange formats a date range for display
@@ -53,6 +53,31 @@ func TestFormatScore(t *testing.T) {
 	}
 }
 
+func TestFormatScoreWithPrecision(t *testing.T) {
+	tests := []struct {
+		name     string
+		score    float64
+		decimals int
+		expected string
+	}{
+		{name: "no decimals", score: 85.333, decimals: 0, expected: "85%"},
+		{name: "one decimal", score: 85.333, decimals: 1, expected: "85.3%"},
+		{name: "two decimals", score: 85.333, decimals: 2, expected: "85.33%"},
+		{name: "rounds up", score: 85.666, decimals: 1, expected: "85.7%"},
+		{name: "zero score", score: 0, decimals: 2, expected: "0%"},
+		{name: "negative decimals", score: 85.333, decimals: -1, expected: "85%"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := FormatScoreWithPrecision(tt.score, tt.decimals)
+			if result != tt.expected {
+				t.Errorf("FormatScoreWithPrecision(%.3f, %d) = %s, expected %s", tt.score, tt.decimals, result, tt.expected)
+			}
+		})
+	}
+}
+
 func TestFormatDateRange(t *testing.T) {
 	tests := []struct {
 		name      string